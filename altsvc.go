@@ -0,0 +1,386 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows the Transport to record
+// the alternative services (RFC 7838) that https servers advertise using the
+// Alt-Svc header and to send subsequent requests to them.
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// AltSvc is an alternative service of an origin, as advertised by the
+// Alt-Svc header (RFC 7838).
+type AltSvc struct {
+	// Protocol is the ALPN protocol ID of the alternative, e.g., "h3".
+	Protocol string
+
+	// Host is the host of the alternative. When empty, the alternative
+	// is on the same host as the origin.
+	Host string
+
+	// Port is the port of the alternative.
+	Port int
+
+	// Expires is when the alternative is no longer fresh.
+	Expires time.Time
+
+	// Persist is whether the alternative remains valid when the network
+	// configuration of the client changes.
+	Persist bool
+}
+
+// AltSvcStore persists the entries of an AltSvcCache, e.g., on disk, such
+// that they survive restarts. Its methods may be called concurrently.
+type AltSvcStore interface {
+	// LoadAltSvc returns the alternatives of origin, if any, including
+	// those that are no longer fresh.
+	LoadAltSvc(origin string) []AltSvc
+
+	// StoreAltSvc stores the alternatives of origin, replacing previous
+	// ones, or deletes them when alts is empty.
+	StoreAltSvc(origin string, alts []AltSvc)
+}
+
+// AltSvcCache contains the alternative services of origins. The origins are
+// strings like "https://example.com:443", whose port is always present. The
+// zero value is an empty in-memory cache ready to use. Its methods may be
+// called concurrently.
+type AltSvcCache struct {
+	// Store, if not nil, persists the cache entries. We load the entries
+	// of an origin from Store the first time we need them, and we also
+	// remember when an origin has no entries, so we load it only once.
+	Store AltSvcStore
+
+	mu      sync.Mutex
+	entries map[string][]AltSvc // by origin, empty when none and Store is not nil
+}
+
+// Get returns the fresh alternatives of origin, in order of preference.
+func (c *AltSvcCache) Get(origin string) []AltSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	alts, found := c.entries[origin]
+	if !found && c.Store != nil {
+		alts = c.Store.LoadAltSvc(origin)
+	}
+	var fresh []AltSvc
+	now := time.Now()
+	for _, alt := range alts {
+		if now.Before(alt.Expires) {
+			fresh = append(fresh, alt)
+		}
+	}
+	if len(fresh) != len(alts) || !found {
+		c.setLocked(origin, fresh, len(fresh) != len(alts))
+	}
+	return append([]AltSvc(nil), fresh...)
+}
+
+// Set replaces the alternatives of origin, or deletes them when alts is
+// empty, as needed when receiving an Alt-Svc header.
+func (c *AltSvcCache) Set(origin string, alts []AltSvc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(origin, append([]AltSvc(nil), alts...), true)
+}
+
+// Forget deletes the given alternative of origin, e.g., because it failed.
+func (c *AltSvcCache) Forget(origin string, alt AltSvc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var alts []AltSvc
+	for _, entry := range c.entries[origin] {
+		if entry.Protocol != alt.Protocol || entry.Host != alt.Host || entry.Port != alt.Port {
+			alts = append(alts, entry)
+		}
+	}
+	c.setLocked(origin, alts, true)
+}
+
+// setLocked sets the in-memory entries of origin and, if persist is
+// true, the entries in the store. Without a store, we do not need to
+// remember the origins without entries, so we delete them instead of
+// growing the map with each origin we see. The caller must hold c.mu.
+func (c *AltSvcCache) setLocked(origin string, alts []AltSvc, persist bool) {
+	if len(alts) == 0 && c.Store == nil {
+		delete(c.entries, origin)
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string][]AltSvc)
+	}
+	c.entries[origin] = alts
+	if persist && c.Store != nil {
+		c.Store.StoreAltSvc(origin, alts)
+	}
+}
+
+// defaultAltSvcMaxAge is the default freshness lifetime of alternatives.
+const defaultAltSvcMaxAge = 24 * time.Hour
+
+// parseAltSvc parses the values of the Alt-Svc header received at now
+// and returns the valid alternatives it contains and whether the value
+// is "clear", meaning that we should forget all the alternatives.
+func parseAltSvc(values []string, now time.Time) (alts []AltSvc, clear bool) {
+	for _, value := range values {
+		for _, entry := range splitQuoted(value, ',') {
+			params := splitQuoted(entry, ';')
+			if strings.TrimSpace(params[0]) == "clear" {
+				return nil, true
+			}
+			name, value, ok := parseAltSvcParam(params[0])
+			if !ok {
+				continue
+			}
+			protocol, err := url.PathUnescape(name)
+			if err != nil || protocol == "" {
+				continue
+			}
+			host, port, err := net.SplitHostPort(value)
+			if err != nil {
+				continue
+			}
+			portnum, err := strconv.Atoi(port)
+			if err != nil || portnum <= 0 || portnum > 65535 {
+				continue
+			}
+			alt := AltSvc{Protocol: protocol, Host: host, Port: portnum, Expires: now.Add(defaultAltSvcMaxAge)}
+			for _, param := range params[1:] {
+				name, value, ok := parseAltSvcParam(param)
+				if !ok {
+					continue
+				}
+				switch name {
+				case "ma":
+					if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+						alt.Expires = now.Add(time.Duration(seconds) * time.Second)
+					}
+				case "persist":
+					alt.Persist = value == "1"
+				}
+			}
+			alts = append(alts, alt)
+		}
+	}
+	return alts, false
+}
+
+// parseAltSvcParam parses a `token "=" ( token / quoted-string )` element
+// of an Alt-Svc value and returns the token and the unquoted value.
+func parseAltSvcParam(s string) (name, value string, ok bool) {
+	name, value, ok = strings.Cut(strings.TrimSpace(s), "=")
+	if !ok || name == "" || strings.IndexFunc(name, isNotToken) >= 0 {
+		return "", "", false
+	}
+	if !strings.HasPrefix(value, `"`) {
+		return name, value, value != "" && strings.IndexFunc(value, isNotToken) < 0
+	}
+	if len(value) < 2 || !strings.HasSuffix(value, `"`) {
+		return "", "", false
+	}
+	var b strings.Builder
+	for i := 1; i < len(value)-1; i++ {
+		if value[i] == '\\' && i+1 < len(value)-1 {
+			i++ // quoted-pair
+		}
+		b.WriteByte(value[i])
+	}
+	return name, b.String(), true
+}
+
+// splitQuoted splits s around each instance of sep that is not part of
+// a quoted-string.
+func splitQuoted(s string, sep byte) []string {
+	var parts []string
+	start, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quoted && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && s[i] == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// altSvcOrigin returns the AltSvcCache origin of the given URL.
+func altSvcOrigin(u *url.URL) string {
+	return u.Scheme + "://" + canonicalAddr(u)
+}
+
+// altSvcCache returns the AltSvcCache to use, which is the one created
+// on demand when AltSvcCache is nil and HTTP3 is not nil, or nil.
+func (t *Transport) altSvcCache() *AltSvcCache {
+	if t.AltSvcCache != nil {
+		return t.AltSvcCache
+	}
+	if t.HTTP3 == nil {
+		return nil
+	}
+	t.altSvcMu.Lock()
+	defer t.altSvcMu.Unlock()
+	if t.altSvc == nil {
+		t.altSvc = &AltSvcCache{}
+	}
+	return t.altSvc
+}
+
+// recordAltSvc records or clears the alternatives that the server of req
+// advertises in resp.
+func (t *Transport) recordAltSvc(req *Request, resp *Response) {
+	cache := t.altSvcCache()
+	if cache == nil || req.URL.Scheme != "https" {
+		return
+	}
+	values := resp.Header["Alt-Svc"]
+	if len(values) == 0 {
+		return
+	}
+	alts, clear := parseAltSvc(values, time.Now())
+	if len(alts) == 0 && !clear {
+		return
+	}
+	cache.Set(altSvcOrigin(req.URL), alts)
+}
+
+// altSvcRoute is an alternative along with the address to dial.
+type altSvcRoute struct {
+	alt  AltSvc
+	addr string
+}
+
+// traceInfo returns the httptrace information about r.
+func (r altSvcRoute) traceInfo() httptrace.AltSvcInfo {
+	return httptrace.AltSvcInfo{Protocol: r.alt.Protocol, Addr: r.addr}
+}
+
+// skipAltSvcKey is the context key marking requests for the origin.
+type skipAltSvcKey struct{}
+
+// altSvcRouteFor returns the first supported alternative to which we should
+// send req, if any. We support "h3", when HTTP3 is configured, along with
+// "h2" and "http/1.1", which we use over TCP.
+func (t *Transport) altSvcRouteFor(req *Request) (altSvcRoute, bool) {
+	cache := t.altSvcCache()
+	if cache == nil || req.URL.Scheme != "https" {
+		return altSvcRoute{}, false
+	}
+	ctx := req.Context()
+	if ctx.Value(skipAltSvcKey{}) != nil {
+		return altSvcRoute{}, false
+	}
+	alts := cache.Get(altSvcOrigin(req.URL))
+	if len(alts) == 0 || t.usesProxy(req) {
+		return altSvcRoute{}, false
+	}
+	originAddr := ConnOverridesFromContext(ctx).DialAddr
+	if originAddr == "" {
+		originAddr = canonicalAddr(req.URL)
+	}
+	for _, alt := range alts {
+		switch alt.Protocol {
+		case "h3":
+			if t.HTTP3 == nil || t.HTTP3.RoundTripper == nil {
+				continue
+			}
+		case "h2", "http/1.1":
+		default:
+			continue
+		}
+		host := alt.Host
+		if host == "" {
+			// The alternative is on the host we would have connected to.
+			host, _, _ = net.SplitHostPort(originAddr)
+		}
+		addr := net.JoinHostPort(host, strconv.Itoa(alt.Port))
+		if alt.Protocol != "h3" && addr == originAddr {
+			continue // that is the origin itself
+		}
+		return altSvcRoute{alt: alt, addr: addr}, true
+	}
+	return altSvcRoute{}, false
+}
+
+// usesProxy returns whether we would send req through a proxy.
+func (t *Transport) usesProxy(req *Request) bool {
+	if t.ProxyChain != nil {
+		proxies, err := t.ProxyChain(req)
+		return err != nil || len(proxies) > 0
+	}
+	if t.Proxy != nil {
+		proxyURL, err := t.Proxy(req)
+		return err != nil || proxyURL != nil
+	}
+	return false
+}
+
+// roundTripAltSvc sends req using the given alternative, falling back to
+// the origin when the alternative fails.
+func (t *Transport) roundTripAltSvc(req *Request, route altSvcRoute) (*Response, error) {
+	if route.alt.Protocol == "h3" && t.HTTP3.Race && (req.Body == nil || req.Body == NoBody) {
+		return t.raceHTTP3(req, route)
+	}
+	resp, err := t.sendAltSvc(req, req.Context(), route)
+	if err == nil {
+		return resp, nil
+	}
+	if req.Context().Err() != nil {
+		return nil, err
+	}
+	t.forgetAltSvc(req, route)
+	originReq, rerr := rewindBody(req)
+	if rerr != nil {
+		return nil, err
+	}
+	return t.sendOrigin(originReq, req.Context())
+}
+
+// sendAltSvc sends req using ctx and the given alternative. We keep the
+// TLS server name and the Host header of the origin.
+func (t *Transport) sendAltSvc(req *Request, ctx context.Context, route altSvcRoute) (*Response, error) {
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.AltSvcStart != nil {
+		trace.AltSvcStart(route.traceInfo())
+	}
+	overrides := ConnOverridesFromContext(ctx)
+	overrides.DialAddr = route.addr
+	ctx = WithConnOverrides(context.WithValue(ctx, skipAltSvcKey{}, true), overrides)
+	var (
+		resp *Response
+		err  error
+	)
+	if route.alt.Protocol == "h3" {
+		resp, err = t.HTTP3.RoundTripper.RoundTrip(req.WithContext(ctx))
+		if err == nil {
+			t.recordAltSvc(req, resp)
+		}
+	} else {
+		resp, err = t.roundTrip(req.WithContext(ctx))
+	}
+	if trace != nil && trace.AltSvcDone != nil {
+		trace.AltSvcDone(route.traceInfo(), err)
+	}
+	return resp, err
+}
+
+// sendOrigin sends req using ctx to the origin.
+func (t *Transport) sendOrigin(req *Request, ctx context.Context) (*Response, error) {
+	return t.roundTrip(req.WithContext(context.WithValue(ctx, skipAltSvcKey{}, true)))
+}
+
+// forgetAltSvc forgets the alternative of req's origin that failed.
+func (t *Transport) forgetAltSvc(req *Request, route altSvcRoute) {
+	t.altSvcCache().Forget(altSvcOrigin(req.URL), route.alt)
+}
@@ -0,0 +1,242 @@
+package http_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// memAltSvcStore is an in-memory AltSvcStore.
+type memAltSvcStore struct {
+	mu      sync.Mutex
+	entries map[string][]AltSvc
+	loads   int
+}
+
+func (s *memAltSvcStore) LoadAltSvc(origin string) []AltSvc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loads++
+	return s.entries[origin]
+}
+
+func (s *memAltSvcStore) StoreAltSvc(origin string, alts []AltSvc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(alts) == 0 {
+		delete(s.entries, origin)
+		return
+	}
+	s.entries[origin] = alts
+}
+
+func TestAltSvc(t *testing.T) {
+	// Both servers advertise the value of altSvc and return their name
+	// along with the Host header of the request.
+	var altSvc atomic.Value
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			if v, _ := altSvc.Load().([]string); len(v) > 0 {
+				w.Header()["Alt-Svc"] = v
+			}
+			io.WriteString(w, name+" "+r.Host)
+		}))
+	}
+	srv := newServer("origin")
+	defer srv.Close()
+	altSrv := newServer("alternative")
+	defer altSrv.Close()
+	srvURL, _ := url.Parse(srv.URL)
+	altURL, _ := url.Parse(altSrv.URL)
+	_, altPort, _ := net.SplitHostPort(altURL.Host)
+	origin := "https://" + srvURL.Host
+
+	// newTransport returns a Transport trusting the servers.
+	newTransport := func(t *testing.T, cache *AltSvcCache) *Transport {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		txp.AltSvcCache = cache
+		t.Cleanup(txp.CloseIdleConnections)
+		return txp
+	}
+
+	// get sends a GET request to the origin and returns the body, while
+	// recording the alternatives used according to httptrace.
+	get := func(t *testing.T, txp *Transport) (string, []string) {
+		t.Helper()
+		var (
+			mu     sync.Mutex
+			events []string
+		)
+		ctx := httptrace.WithClientTrace(context.Background(), &httptrace.ClientTrace{
+			AltSvcStart: func(info httptrace.AltSvcInfo) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, "start "+info.Protocol+" "+info.Addr)
+			},
+			AltSvcDone: func(info httptrace.AltSvcInfo, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, "done "+info.Protocol+" "+info.Addr+" "+strconv.FormatBool(err == nil))
+			},
+		})
+		req, _ := NewRequestWithContext(ctx, "GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return string(data), events
+	}
+
+	t.Run("we parse and record the alternatives", func(t *testing.T) {
+		altSvc.Store([]string{
+			`h3=":443"; ma=60, h2="alt.example.com:8443"; persist=1, h2="bad", h2=":70000"`,
+			`unknown%2Dproto="[::1]:443", http%2F1.1="a.example.com:80"; ma="10", invalid`,
+		})
+		defer altSvc.Store([]string(nil))
+		cache := &AltSvcCache{}
+		txp := newTransport(t, cache)
+		before := time.Now()
+		get(t, txp)
+		alts := cache.Get(origin)
+		expected := []AltSvc{
+			{Protocol: "h3", Host: "", Port: 443},
+			{Protocol: "h2", Host: "alt.example.com", Port: 8443, Persist: true},
+			{Protocol: "unknown-proto", Host: "::1", Port: 443},
+			{Protocol: "http/1.1", Host: "a.example.com", Port: 80},
+		}
+		maxAges := []time.Duration{time.Minute, 24 * time.Hour, 24 * time.Hour, 10 * time.Second}
+		if len(alts) != len(expected) {
+			t.Fatalf("unexpected alternatives: %+v", alts)
+		}
+		for i, alt := range alts {
+			expires := alt.Expires
+			alt.Expires = time.Time{}
+			if alt != expected[i] || expires.Before(before.Add(maxAges[i])) || expires.After(time.Now().Add(maxAges[i])) {
+				t.Fatalf("unexpected alternative #%d: %+v", i, alts[i])
+			}
+		}
+	})
+
+	t.Run("we send requests to the alternative keeping the Host", func(t *testing.T) {
+		altSvc.Store([]string{`h2=":` + altPort + `"`})
+		defer altSvc.Store([]string(nil))
+		txp := newTransport(t, &AltSvcCache{})
+		if body, events := get(t, txp); body != "origin "+srvURL.Host || len(events) != 0 {
+			t.Fatalf("unexpected response: %q %v", body, events)
+		}
+		body, events := get(t, txp)
+		if body != "alternative "+srvURL.Host {
+			t.Fatalf("unexpected response: %q", body)
+		}
+		addr := "127.0.0.1:" + altPort
+		if len(events) != 2 || events[0] != "start h2 "+addr || events[1] != "done h2 "+addr+" true" {
+			t.Fatalf("unexpected events: %v", events)
+		}
+	})
+
+	t.Run("we fall back to the origin when the alternative fails", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := l.Addr().String()
+		l.Close()
+		altSvc.Store([]string{`h2="` + addr + `"`})
+		cache := &AltSvcCache{}
+		txp := newTransport(t, cache)
+		get(t, txp)
+		altSvc.Store([]string(nil))
+		body, events := get(t, txp)
+		if body != "origin "+srvURL.Host {
+			t.Fatalf("unexpected response: %q", body)
+		}
+		if len(events) != 2 || events[1] != "done h2 "+addr+" false" {
+			t.Fatalf("unexpected events: %v", events)
+		}
+		if alts := cache.Get(origin); len(alts) != 0 {
+			t.Fatalf("expected no alternatives, got %+v", alts)
+		}
+	})
+
+	t.Run("we persist the alternatives using the Store", func(t *testing.T) {
+		store := &memAltSvcStore{entries: map[string][]AltSvc{
+			origin: {{Protocol: "h2", Port: mustAtoi(t, altPort), Expires: time.Now().Add(time.Hour)}},
+		}}
+		altSvc.Store([]string{"clear"})
+		defer altSvc.Store([]string(nil))
+		txp := newTransport(t, &AltSvcCache{Store: store})
+		if body, _ := get(t, txp); body != "alternative "+srvURL.Host {
+			t.Fatalf("unexpected response: %q", body)
+		}
+		if alts := store.LoadAltSvc(origin); len(alts) != 0 {
+			t.Fatalf("expected no alternatives, got %+v", alts)
+		}
+		if body, _ := get(t, txp); body != "origin "+srvURL.Host {
+			t.Fatalf("unexpected response: %q", body)
+		}
+	})
+
+	t.Run("we load each origin from the Store once", func(t *testing.T) {
+		store := &memAltSvcStore{entries: map[string][]AltSvc{
+			"https://expired.example:443": {{Protocol: "h2", Port: 443, Expires: time.Now()}},
+		}}
+		cache := &AltSvcCache{Store: store}
+		for _, origin := range []string{"https://missing.example:443", "https://expired.example:443"} {
+			for idx := 0; idx < 2; idx++ {
+				if alts := cache.Get(origin); len(alts) != 0 {
+					t.Fatalf("expected no alternatives, got %+v", alts)
+				}
+			}
+		}
+		if store.loads != 2 {
+			t.Fatalf("expected 2 loads, got %d", store.loads)
+		}
+	})
+
+	t.Run("we only remember the origins without alternatives when using a Store", func(t *testing.T) {
+		cache := &AltSvcCache{}
+		cache.Set("https://cleared.example:443", []AltSvc{{Protocol: "h2", Port: 443, Expires: time.Now().Add(time.Hour)}})
+		cache.Set("https://cleared.example:443", nil)
+		for idx := 0; idx < 10; idx++ {
+			cache.Get("https://missing" + strconv.Itoa(idx) + ".example:443")
+		}
+		if n := cache.LenForTesting(); n != 0 {
+			t.Fatalf("expected no entries, got %d", n)
+		}
+	})
+
+	t.Run("we ignore the expired alternatives", func(t *testing.T) {
+		cache := &AltSvcCache{}
+		cache.Set(origin, []AltSvc{{Protocol: "h2", Port: mustAtoi(t, altPort), Expires: time.Now()}})
+		txp := newTransport(t, cache)
+		if body, _ := get(t, txp); body != "origin "+srvURL.Host {
+			t.Fatalf("unexpected response: %q", body)
+		}
+	})
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	t.Helper()
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
@@ -0,0 +1,124 @@
+package capture_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/capture"
+)
+
+// events returns the events used by the tests.
+func events() []*oohttp.ConnEvent {
+	now := time.Unix(1700000000, 123456789)
+	return []*oohttp.ConnEvent{{
+		ConnID: 7, Layer: oohttp.ConnLayerNet, Op: oohttp.ConnOpWrite, Time: now,
+		LocalAddr: "127.0.0.1:5555", RemoteAddr: "127.0.0.1:443", Data: []byte("hello"),
+	}, {
+		ConnID: 7, Layer: oohttp.ConnLayerNet, Op: oohttp.ConnOpRead, Time: now,
+		LocalAddr: "127.0.0.1:5555", RemoteAddr: "127.0.0.1:443", Err: errors.New("EOF"),
+	}, {
+		ConnID: 7, Layer: oohttp.ConnLayerTLS, Op: oohttp.ConnOpClose, Time: now,
+		LocalAddr: "127.0.0.1:5555", RemoteAddr: "127.0.0.1:443",
+	}}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	t.Run("we write a record per line", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := capture.NewJSONLWriter(&buf)
+		for _, ev := range events() {
+			w.OnConnEvent(ev)
+		}
+		if err := w.Err(); err != nil {
+			t.Fatal(err)
+		}
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		if len(lines) != 3 {
+			t.Fatalf("expected three lines, got %d", len(lines))
+		}
+		var records []capture.Record
+		for _, line := range lines {
+			var r capture.Record
+			if err := json.Unmarshal(line, &r); err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, r)
+		}
+		if string(records[0].Data) != "hello" || records[0].Op != "write" || records[0].ConnID != 7 {
+			t.Fatalf("unexpected record: %+v", records[0])
+		}
+		if records[1].Error != "EOF" || records[2].Layer != "tls" || records[2].Op != "close" {
+			t.Fatalf("unexpected records: %+v", records[1:])
+		}
+	})
+}
+
+// pcapngBlock is a parsed pcapng block.
+type pcapngBlock struct {
+	blockType uint32
+	body      []byte
+}
+
+func TestPcapngWriter(t *testing.T) {
+	t.Run("we write a valid pcapng file", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := capture.NewPcapngWriter(&buf)
+		for _, ev := range events() {
+			w.OnConnEvent(ev)
+		}
+		if err := w.Err(); err != nil {
+			t.Fatal(err)
+		}
+		var blocks []pcapngBlock
+		data := buf.Bytes()
+		for len(data) > 0 {
+			if len(data) < 12 {
+				t.Fatal("truncated block")
+			}
+			length := binary.LittleEndian.Uint32(data[4:])
+			if length%4 != 0 || int(length) > len(data) {
+				t.Fatalf("invalid block length: %d", length)
+			}
+			if binary.LittleEndian.Uint32(data[length-4:]) != length {
+				t.Fatal("mismatched trailing block length")
+			}
+			blocks = append(blocks, pcapngBlock{
+				blockType: binary.LittleEndian.Uint32(data),
+				body:      data[8 : length-4],
+			})
+			data = data[length:]
+		}
+		// SHB, IDB for net, EPB, EPB, IDB for tls, EPB
+		wantTypes := []uint32{0x0A0D0D0A, 1, 6, 6, 1, 6}
+		if len(blocks) != len(wantTypes) {
+			t.Fatalf("expected %d blocks, got %d", len(wantTypes), len(blocks))
+		}
+		for idx, block := range blocks {
+			if block.blockType != wantTypes[idx] {
+				t.Fatalf("block %d: unexpected type %x", idx, block.blockType)
+			}
+		}
+		if !bytes.Contains(blocks[1].body, []byte("7/net")) || !bytes.Contains(blocks[4].body, []byte("7/tls")) {
+			t.Fatal("unexpected interface names")
+		}
+		epb := blocks[2].body
+		if id := binary.LittleEndian.Uint32(epb); id != 0 {
+			t.Fatalf("unexpected interface ID: %d", id)
+		}
+		ts := uint64(binary.LittleEndian.Uint32(epb[4:]))<<32 | uint64(binary.LittleEndian.Uint32(epb[8:]))
+		if ts != 1700000000123456789 {
+			t.Fatalf("unexpected timestamp: %d", ts)
+		}
+		if n := binary.LittleEndian.Uint32(epb[12:]); n != 5 || string(epb[20:25]) != "hello" {
+			t.Fatal("unexpected packet data")
+		}
+		if id := binary.LittleEndian.Uint32(blocks[5].body); id != 1 {
+			t.Fatalf("unexpected interface ID: %d", id)
+		}
+	})
+}
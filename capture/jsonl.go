@@ -0,0 +1,78 @@
+// Package capture writes the events observed by the ConnObserver of an
+// oohttp.Transport to files suitable for archiving measurement evidence.
+//
+// The JSONLWriter writes one JSON object per event. The PcapngWriter
+// writes a pcapng file containing one interface per connection layer and
+// one packet per read or write, whose data is the application payload
+// (i.e., without any link, network or transport header).
+package capture
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// Record is the JSON representation of an oohttp.ConnEvent.
+type Record struct {
+	ConnID     int64     `json:"conn_id"`
+	Layer      string    `json:"layer"`
+	Op         string    `json:"op"`
+	Time       time.Time `json:"t"`
+	LocalAddr  string    `json:"local_addr,omitempty"`
+	RemoteAddr string    `json:"remote_addr,omitempty"`
+	Data       []byte    `json:"data,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// NewRecord converts an oohttp.ConnEvent to a Record.
+func NewRecord(ev *oohttp.ConnEvent) *Record {
+	r := &Record{
+		ConnID:     ev.ConnID,
+		Layer:      string(ev.Layer),
+		Op:         string(ev.Op),
+		Time:       ev.Time,
+		LocalAddr:  ev.LocalAddr,
+		RemoteAddr: ev.RemoteAddr,
+		Data:       ev.Data,
+	}
+	if ev.Err != nil {
+		r.Error = ev.Err.Error()
+	}
+	return r
+}
+
+// JSONLWriter is an oohttp.ConnObserver writing each event as a
+// JSON Record followed by a newline. It is safe for concurrent use.
+type JSONLWriter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+var _ oohttp.ConnObserver = &JSONLWriter{}
+
+// NewJSONLWriter returns a JSONLWriter writing to w.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{enc: json.NewEncoder(w)}
+}
+
+// OnConnEvent implements oohttp.ConnObserver.
+func (w *JSONLWriter) OnConnEvent(ev *oohttp.ConnEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err == nil {
+		w.err = w.enc.Encode(NewRecord(ev))
+	}
+}
+
+// Err returns the first error that occurred when writing, if any. After
+// an error occurs, the JSONLWriter ignores the subsequent events.
+func (w *JSONLWriter) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
@@ -0,0 +1,155 @@
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// pcapng block types and options (see draft-ietf-opsawg-pcapng).
+const (
+	blockSectionHeader  = 0x0A0D0D0A
+	blockInterfaceDesc  = 0x00000001
+	blockEnhancedPacket = 0x00000006
+	byteOrderMagic      = 0x1A2B3C4D
+	optEndOfOpt         = 0
+	optComment          = 1
+	optIfName           = 2
+	optIfDescription    = 3
+	optIfTsresol        = 9
+	optEpbFlags         = 2
+	epbFlagsInbound     = 1
+	epbFlagsOutbound    = 2
+	tsresolNanoseconds  = 9
+	linkTypeUser0       = 147
+	snapLenNoLimit      = 0
+)
+
+// interfaceKey identifies a pcapng interface.
+type interfaceKey struct {
+	connID int64
+	layer  oohttp.ConnLayer
+}
+
+// PcapngWriter is an oohttp.ConnObserver writing events into a pcapng
+// file. Each connection layer is an interface named "<ConnID>/<Layer>"
+// whose description contains the addresses and whose link type is
+// LINKTYPE_USER0. Each read or write is an enhanced packet block whose
+// flags tell the direction, reads being inbound. A close is an empty
+// packet with the "close" comment. Errors are also written as comments.
+// It is safe for concurrent use.
+type PcapngWriter struct {
+	mu         sync.Mutex
+	w          io.Writer
+	interfaces map[interfaceKey]uint32
+	err        error
+}
+
+var _ oohttp.ConnObserver = &PcapngWriter{}
+
+// NewPcapngWriter returns a PcapngWriter writing to w. It writes the
+// section header block when it writes the first event.
+func NewPcapngWriter(w io.Writer) *PcapngWriter {
+	return &PcapngWriter{w: w}
+}
+
+// OnConnEvent implements oohttp.ConnObserver.
+func (w *PcapngWriter) OnConnEvent(ev *oohttp.ConnEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return
+	}
+	if w.interfaces == nil {
+		w.interfaces = make(map[interfaceKey]uint32)
+		w.writeBlock(blockSectionHeader, sectionHeader())
+	}
+	key := interfaceKey{connID: ev.ConnID, layer: ev.Layer}
+	id, found := w.interfaces[key]
+	if !found {
+		id = uint32(len(w.interfaces))
+		w.interfaces[key] = id
+		w.writeBlock(blockInterfaceDesc, interfaceDescription(ev))
+	}
+	w.writeBlock(blockEnhancedPacket, enhancedPacket(id, ev))
+}
+
+// Err returns the first error that occurred when writing, if any. After
+// an error occurs, the PcapngWriter ignores the subsequent events.
+func (w *PcapngWriter) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// writeBlock writes a block with the given type and body.
+func (w *PcapngWriter) writeBlock(blockType uint32, body []byte) {
+	if w.err != nil {
+		return
+	}
+	length := uint32(12 + len(body))
+	block := binary.LittleEndian.AppendUint32(nil, blockType)
+	block = binary.LittleEndian.AppendUint32(block, length)
+	block = append(block, body...)
+	block = binary.LittleEndian.AppendUint32(block, length)
+	_, w.err = w.w.Write(block)
+}
+
+// sectionHeader returns the body of the section header block.
+func sectionHeader() []byte {
+	body := binary.LittleEndian.AppendUint32(nil, byteOrderMagic)
+	body = binary.LittleEndian.AppendUint16(body, 1) // major version
+	body = binary.LittleEndian.AppendUint16(body, 0) // minor version
+	return binary.LittleEndian.AppendUint64(body, ^uint64(0))
+}
+
+// interfaceDescription returns the body of the interface description
+// block for the connection layer of ev.
+func interfaceDescription(ev *oohttp.ConnEvent) []byte {
+	body := binary.LittleEndian.AppendUint16(nil, linkTypeUser0)
+	body = binary.LittleEndian.AppendUint16(body, 0) // reserved
+	body = binary.LittleEndian.AppendUint32(body, snapLenNoLimit)
+	body = appendOption(body, optIfName, []byte(fmt.Sprintf("%d/%s", ev.ConnID, ev.Layer)))
+	body = appendOption(body, optIfDescription, []byte(ev.LocalAddr+" -> "+ev.RemoteAddr))
+	body = appendOption(body, optIfTsresol, []byte{tsresolNanoseconds})
+	return appendOption(body, optEndOfOpt, nil)
+}
+
+// enhancedPacket returns the body of the enhanced packet block for ev.
+func enhancedPacket(id uint32, ev *oohttp.ConnEvent) []byte {
+	ts := uint64(ev.Time.UnixNano())
+	body := binary.LittleEndian.AppendUint32(nil, id)
+	body = binary.LittleEndian.AppendUint32(body, uint32(ts>>32))
+	body = binary.LittleEndian.AppendUint32(body, uint32(ts))
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(ev.Data))) // captured length
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(ev.Data))) // original length
+	body = appendPadded(body, ev.Data)
+	switch ev.Op {
+	case oohttp.ConnOpRead:
+		body = appendOption(body, optEpbFlags, binary.LittleEndian.AppendUint32(nil, epbFlagsInbound))
+	case oohttp.ConnOpWrite:
+		body = appendOption(body, optEpbFlags, binary.LittleEndian.AppendUint32(nil, epbFlagsOutbound))
+	case oohttp.ConnOpClose:
+		body = appendOption(body, optComment, []byte("close"))
+	}
+	if ev.Err != nil {
+		body = appendOption(body, optComment, []byte("error: "+ev.Err.Error()))
+	}
+	return appendOption(body, optEndOfOpt, nil)
+}
+
+// appendOption appends an option with the given code and value.
+func appendOption(body []byte, code uint16, value []byte) []byte {
+	body = binary.LittleEndian.AppendUint16(body, code)
+	body = binary.LittleEndian.AppendUint16(body, uint16(len(value)))
+	return appendPadded(body, value)
+}
+
+// appendPadded appends data padded to 32 bits.
+func appendPadded(body, data []byte) []byte {
+	body = append(body, data...)
+	return append(body, make([]byte, (4-len(data)%4)%4)...)
+}
@@ -0,0 +1,182 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows observing the bytes
+// read and written by the connections a Transport dials.
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConnObserver observes the bytes read and written by the connections a
+// Transport dials. See the ConnObserver field of Transport.
+type ConnObserver interface {
+	// OnConnEvent is called for each event. The Transport may call this
+	// method concurrently for different connections. The observer owns
+	// the event and its data.
+	OnConnEvent(ev *ConnEvent)
+}
+
+// The ConnObserverFunc type is an adapter to allow the use of ordinary
+// functions as connection observers. If f is a function with the
+// appropriate signature, ConnObserverFunc(f) is a ConnObserver that calls f.
+type ConnObserverFunc func(ev *ConnEvent)
+
+// OnConnEvent implements ConnObserver.
+func (f ConnObserverFunc) OnConnEvent(ev *ConnEvent) {
+	f(ev)
+}
+
+// ConnLayer identifies the layer of a connection an event refers to.
+type ConnLayer string
+
+const (
+	// ConnLayerNet is the dialed connection, which carries the SOCKS
+	// handshake, the HTTP CONNECT exchange with HTTP proxies and the TLS
+	// records, if any.
+	ConnLayerNet = ConnLayer("net")
+
+	// ConnLayerProxyTLS is the plaintext of the TLS connection to an
+	// HTTPS proxy, which carries the HTTP CONNECT exchange and, for http
+	// URLs, the requests and the responses.
+	ConnLayerProxyTLS = ConnLayer("proxy-tls")
+
+	// ConnLayerTLS is the plaintext of the TLS connection to the server,
+	// which carries the requests and the responses.
+	ConnLayerTLS = ConnLayer("tls")
+)
+
+// ConnOp is the operation an event refers to.
+type ConnOp string
+
+const (
+	// ConnOpRead means that we read Data from the connection.
+	ConnOpRead = ConnOp("read")
+
+	// ConnOpWrite means that we wrote Data to the connection.
+	ConnOpWrite = ConnOp("write")
+
+	// ConnOpClose means that we closed the connection.
+	ConnOpClose = ConnOp("close")
+)
+
+// ConnEvent is an event observed by a ConnObserver.
+type ConnEvent struct {
+	// ConnID identifies the dialed connection. All the layers of a
+	// connection share the same ConnID, which is unique in the process.
+	ConnID int64
+
+	// Layer is the layer of the connection.
+	Layer ConnLayer
+
+	// Op is the operation.
+	Op ConnOp
+
+	// Time is when the operation completed.
+	Time time.Time
+
+	// LocalAddr and RemoteAddr are the addresses of the connection.
+	LocalAddr, RemoteAddr string
+
+	// Data contains the bytes read or written, if any.
+	Data []byte
+
+	// Err is the error returned by the operation, if any.
+	Err error
+}
+
+// connObserverID is the last ConnEvent.ConnID we assigned.
+var connObserverID atomic.Int64
+
+// observeConn returns conn wrapped such that t.ConnObserver observes it
+// using the given layer, or conn itself when t.ConnObserver is nil. The
+// returned conn implements TLSConn when conn implements TLSConn.
+func (pconn *persistConn) observeConn(conn net.Conn, layer ConnLayer) net.Conn {
+	observer := pconn.t.ConnObserver
+	if observer == nil {
+		return conn
+	}
+	if pconn.connID == 0 {
+		pconn.connID = connObserverID.Add(1)
+	}
+	oc := &observedConn{Conn: conn, observer: observer, id: pconn.connID, layer: layer}
+	if tc, ok := conn.(TLSConn); ok {
+		return &observedTLSConn{observedConn: oc, tc: tc}
+	}
+	return oc
+}
+
+// observedConn is a net.Conn that emits events.
+type observedConn struct {
+	net.Conn
+	observer  ConnObserver
+	id        int64
+	layer     ConnLayer
+	closeOnce sync.Once
+}
+
+// emit emits an event unless there is nothing to report.
+func (c *observedConn) emit(op ConnOp, data []byte, err error) {
+	if len(data) <= 0 && err == nil && op != ConnOpClose {
+		return
+	}
+	ev := &ConnEvent{
+		ConnID: c.id,
+		Layer:  c.layer,
+		Op:     op,
+		Time:   time.Now(),
+		Data:   append([]byte(nil), data...),
+		Err:    err,
+	}
+	if addr := c.Conn.LocalAddr(); addr != nil {
+		ev.LocalAddr = addr.String()
+	}
+	if addr := c.Conn.RemoteAddr(); addr != nil {
+		ev.RemoteAddr = addr.String()
+	}
+	c.observer.OnConnEvent(ev)
+}
+
+func (c *observedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.emit(ConnOpRead, b[:n], err)
+	return n, err
+}
+
+func (c *observedConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.emit(ConnOpWrite, b[:n], err)
+	return n, err
+}
+
+func (c *observedConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() {
+		c.emit(ConnOpClose, nil, err)
+	})
+	return err
+}
+
+// observedTLSConn is an observedConn that implements TLSConn.
+type observedTLSConn struct {
+	*observedConn
+	tc TLSConn
+}
+
+var _ TLSConn = &observedTLSConn{}
+
+func (c *observedTLSConn) ConnectionState() tls.ConnectionState {
+	return c.tc.ConnectionState()
+}
+
+func (c *observedTLSConn) HandshakeContext(ctx context.Context) error {
+	return c.tc.HandshakeContext(ctx)
+}
+
+func (c *observedTLSConn) NetConn() net.Conn {
+	return c.tc.NetConn()
+}
@@ -0,0 +1,96 @@
+package http_test
+
+import (
+	"bytes"
+	"net/url"
+	"sync"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// connEvents is a ConnObserver saving the events.
+type connEvents struct {
+	mu     sync.Mutex
+	events []*ConnEvent
+}
+
+func (ce *connEvents) OnConnEvent(ev *ConnEvent) {
+	ce.mu.Lock()
+	ce.events = append(ce.events, ev)
+	ce.mu.Unlock()
+}
+
+// data returns the concatenated data of the events with the given layer and op.
+func (ce *connEvents) data(layer ConnLayer, op ConnOp) []byte {
+	ce.mu.Lock()
+	defer ce.mu.Unlock()
+	var out []byte
+	for _, ev := range ce.events {
+		if ev.Layer == layer && ev.Op == op {
+			out = append(out, ev.Data...)
+		}
+	}
+	return out
+}
+
+func TestTransportConnObserver(t *testing.T) {
+	t.Run("we observe the dialed conn and the TLS plaintext", func(t *testing.T) {
+		srv := httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			w.Write([]byte("hello"))
+		}))
+		defer srv.Close()
+		observer := &connEvents{}
+		txp := srv.Client().Transport.(*Transport)
+		txp.ConnObserver = observer
+		resp, err := srv.Client().Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		txp.CloseIdleConnections()
+
+		if w := observer.data(ConnLayerNet, ConnOpWrite); len(w) <= 0 || w[0] != 0x16 {
+			t.Fatal("expected a TLS handshake record")
+		}
+		if w := observer.data(ConnLayerTLS, ConnOpWrite); !bytes.HasPrefix(w, []byte("GET / HTTP/1.1\r\n")) {
+			t.Fatalf("unexpected request: %q", w)
+		}
+		if r := observer.data(ConnLayerTLS, ConnOpRead); !bytes.HasSuffix(r, []byte("hello")) {
+			t.Fatalf("unexpected response: %q", r)
+		}
+		observer.mu.Lock()
+		defer observer.mu.Unlock()
+		var closed bool
+		for _, ev := range observer.events {
+			if ev.ConnID != observer.events[0].ConnID || ev.RemoteAddr != srv.Listener.Addr().String() {
+				t.Fatalf("unexpected event: %+v", ev)
+			}
+			closed = closed || ev.Op == ConnOpClose
+		}
+		if !closed {
+			t.Fatal("did not observe the close")
+		}
+	})
+
+	t.Run("we observe the CONNECT exchange", func(t *testing.T) {
+		proxy := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			w.WriteHeader(StatusForbidden)
+		}))
+		defer proxy.Close()
+		observer := &connEvents{}
+		proxyURL, _ := url.Parse(proxy.URL)
+		txp := &Transport{Proxy: ProxyURL(proxyURL), ConnObserver: observer}
+		req, _ := NewRequest("GET", "https://example.com/", nil)
+		if _, err := txp.RoundTrip(req); err == nil {
+			t.Fatal("expected an error")
+		}
+		if w := observer.data(ConnLayerNet, ConnOpWrite); !bytes.HasPrefix(w, []byte("CONNECT example.com:443 HTTP/1.1\r\n")) {
+			t.Fatalf("unexpected CONNECT request: %q", w)
+		}
+		if r := observer.data(ConnLayerNet, ConnOpRead); !bytes.HasPrefix(r, []byte("HTTP/1.1 403 Forbidden\r\n")) {
+			t.Fatalf("unexpected CONNECT response: %q", r)
+		}
+	})
+}
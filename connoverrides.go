@@ -0,0 +1,140 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows overriding, on a per
+// request basis, the address the Transport dials and the TLS server name
+// it sends, which is needed for domain fronting and IP pinning.
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ConnOverrides contains per-request overrides of how the Transport
+// connects to the server identified by the request URL. The Transport
+// pools connections created using different overrides separately, such
+// that a request never reuses a connection created for other overrides.
+//
+// To send a Host header different from the URL host, set Request.Host,
+// which does not affect how the Transport connects to the server.
+type ConnOverrides struct {
+	// DialAddr is the "host:port" address to dial instead of the URL
+	// host. When using a SOCKS5 proxy or an HTTP proxy for an https URL,
+	// DialAddr is the address the proxy connects to. DialAddr has no
+	// effect for http URLs fetched through an HTTP proxy.
+	DialAddr string
+
+	// ServerName is the TLS server name to send and to verify the
+	// server certificate against, instead of the URL host. ServerName
+	// also takes precedence over TLSClientConfig.ServerName.
+	ServerName string
+
+	// OmitServerName causes the Transport to send no SNI extension. The
+	// Transport still verifies the server certificate against ServerName
+	// or, when ServerName is empty, against the URL host, unless
+	// TLSClientConfig.InsecureSkipVerify is set.
+	OmitServerName bool
+}
+
+type connOverridesKey struct{}
+
+// WithConnOverrides returns a copy of ctx such that the Transport uses the
+// given overrides when connecting for requests using the returned context.
+// The overrides have no effect when using DialTLS or DialTLSContext, except
+// for DialAddr, which is passed to those functions.
+func WithConnOverrides(ctx context.Context, overrides ConnOverrides) context.Context {
+	return context.WithValue(ctx, connOverridesKey{}, overrides)
+}
+
+// ConnOverridesFromContext returns the overrides set by WithConnOverrides,
+// or the zero value, meaning no overrides. RoundTrippers other than the
+// Transport, such as the one of the http3 package, use it to honour the
+// overrides.
+func ConnOverridesFromContext(ctx context.Context) ConnOverrides {
+	overrides, _ := ctx.Value(connOverridesKey{}).(ConnOverrides)
+	return overrides
+}
+
+// connPoolPartition returns the string identifying the connections that
+// a request using ctx may use among those to the same host. The empty
+// string identifies the connections created without any override.
+func connPoolPartition(ctx context.Context) (partition string) {
+	if overrides := ConnOverridesFromContext(ctx); overrides != (ConnOverrides{}) {
+		partition += fmt.Sprintf("|dial=%q,sni=%q,nosni=%t",
+			overrides.DialAddr, overrides.ServerName, overrides.OmitServerName)
+	}
+	if override := tlsClientFactoryFromContext(ctx); override != nil {
+		partition += fmt.Sprintf("|factory=%q", override.name)
+	}
+	if config, id := tlsConfigFromContext(ctx); config != nil {
+		partition += fmt.Sprintf("|config=%d", id)
+	}
+	return
+}
+
+// tunnelAddr returns the "host:port" to which the proxy should connect.
+func (cm *connectMethod) tunnelAddr() string {
+	if cm.overrides.DialAddr != "" {
+		return cm.overrides.DialAddr
+	}
+	return cm.targetAddr
+}
+
+// applyConnOverrides modifies cfg, a clone of TLSClientConfig whose
+// ServerName defaults to name, to honour the given overrides.
+func applyConnOverrides(cfg *tls.Config, name string, overrides *ConnOverrides) {
+	if overrides.ServerName != "" {
+		cfg.ServerName = overrides.ServerName
+	}
+	if !overrides.OmitServerName {
+		return
+	}
+	verifyName := cfg.ServerName
+	if overrides.ServerName == "" {
+		verifyName = name
+	}
+	cfg.ServerName = ""
+	if cfg.InsecureSkipVerify {
+		return
+	}
+	// Without a ServerName crypto/tls requires InsecureSkipVerify, thus we
+	// verify the certificate ourselves, like crypto/tls would do.
+	cfg.InsecureSkipVerify = true
+	verifyConnection := cfg.VerifyConnection
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if err := verifyPeerCertificates(cfg, cs, verifyName); err != nil {
+			return err
+		}
+		if verifyConnection != nil {
+			return verifyConnection(cs)
+		}
+		return nil
+	}
+}
+
+// errNoPeerCertificates indicates that the server did not send any certificate.
+var errNoPeerCertificates = errors.New("http: server did not send any certificate")
+
+// verifyPeerCertificates verifies the certificates in cs against name.
+func verifyPeerCertificates(cfg *tls.Config, cs tls.ConnectionState, name string) error {
+	if len(cs.PeerCertificates) <= 0 {
+		return errNoPeerCertificates
+	}
+	opts := x509.VerifyOptions{
+		Roots:         cfg.RootCAs,
+		CurrentTime:   time.Now(),
+		DNSName:       name,
+		Intermediates: x509.NewCertPool(),
+	}
+	if cfg.Time != nil {
+		opts.CurrentTime = cfg.Time()
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
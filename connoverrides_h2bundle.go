@@ -0,0 +1,34 @@
+//go:build !nethttpomithttp2
+
+package http
+
+// This file is an ooni/oohttp extension. It pools the HTTP/2 connections
+// created using ConnOverrides separately, as connoverrides.go does for
+// HTTP/1.1 connections.
+
+// upgradeH2Partition registers an HTTP/2 connection into the connection
+// pool of the bundled HTTP/2 transport using the pool partition of cm, as
+// upgradeFn in http2configureTransports does for the default partition.
+// It returns false when we should use TLSNextProto instead.
+func (t *Transport) upgradeH2Partition(cm *connectMethod, proto string, c TLSConn) (RoundTripper, bool) {
+	if cm.partition == "" || proto != "h2" {
+		return nil, false
+	}
+	t2, ok := t.h2transport.(*http2Transport)
+	if !ok {
+		return nil, false
+	}
+	pool, ok := t2.ConnPool.(http2noDialClientConnPool)
+	if !ok {
+		return nil, false
+	}
+	key := http2authorityAddr("https", cm.targetAddr) + cm.partition
+	if used, err := pool.addConnIfNeeded(key, t2, c); err != nil {
+		go c.Close()
+		return http2erringRoundTripper{err}, true
+	} else if !used {
+		// Another dial for the same partition won the race.
+		go c.Close()
+	}
+	return t2, true
+}
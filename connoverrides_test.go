@@ -0,0 +1,127 @@
+package http_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	httptrace "github.com/ooni/oohttp/httptrace"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+func TestTransportConnOverrides(t *testing.T) {
+	// newServer returns a server saving the SNI of each handshake.
+	newServer := func(t *testing.T, enableHTTP2 bool) (*httptest.Server, *[]string) {
+		t.Helper()
+		var names []string
+		srv := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			w.Header().Set("X-Host", r.Host)
+		}))
+		srv.EnableHTTP2 = enableHTTP2
+		srv.TLS = &tls.Config{
+			GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+				names = append(names, hello.ServerName)
+				return nil, nil
+			},
+		}
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		return srv, &names
+	}
+
+	// get performs a GET request and returns the response
+	// and whether the request reused a connection.
+	get := func(t *testing.T, txp *Transport, ctx context.Context, URL string) (*Response, bool, error) {
+		t.Helper()
+		var reused bool
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+		})
+		req, _ := NewRequestWithContext(ctx, "GET", URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			return nil, false, err
+		}
+		resp.Body.Close()
+		return resp, reused, nil
+	}
+
+	t.Run("we can connect to an address sending another SNI and Host", func(t *testing.T) {
+		srv, names := newServer(t, false)
+		txp := srv.Client().Transport.(*Transport)
+		ctx := WithConnOverrides(context.Background(), ConnOverrides{
+			DialAddr:   srv.Listener.Addr().String(),
+			ServerName: "example.com",
+		})
+		resp, _, err := get(t, txp, ctx, "https://hidden.invalid/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.Header.Get("X-Host"); got != "hidden.invalid" {
+			t.Fatalf("unexpected Host: %q", got)
+		}
+		if len(*names) != 1 || (*names)[0] != "example.com" {
+			t.Fatalf("unexpected SNI: %v", *names)
+		}
+	})
+
+	t.Run("we can omit the SNI and still verify the certificate", func(t *testing.T) {
+		srv, names := newServer(t, false)
+		txp := srv.Client().Transport.(*Transport)
+		ctx := WithConnOverrides(context.Background(), ConnOverrides{
+			DialAddr:       srv.Listener.Addr().String(),
+			OmitServerName: true,
+		})
+		if _, _, err := get(t, txp, ctx, "https://example.com/"); err != nil {
+			t.Fatal(err)
+		}
+		if len(*names) != 1 || (*names)[0] != "" {
+			t.Fatalf("unexpected SNI: %v", *names)
+		}
+		_, _, err := get(t, txp, ctx, "https://hidden.invalid/")
+		var hostnameErr x509.HostnameError
+		if !errors.As(err, &hostnameErr) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	for _, enableHTTP2 := range []bool{false, true} {
+		name := "we do not reuse connections created for other overrides with HTTP/1.1"
+		if enableHTTP2 {
+			name = "we do not reuse connections created for other overrides with HTTP/2"
+		}
+		t.Run(name, func(t *testing.T) {
+			if enableHTTP2 {
+				CondSkipHTTP2(t)
+			}
+			srv, names := newServer(t, enableHTTP2)
+			txp := srv.Client().Transport.(*Transport)
+			defer txp.CloseIdleConnections()
+			fronted := WithConnOverrides(context.Background(), ConnOverrides{
+				DialAddr:   srv.Listener.Addr().String(),
+				ServerName: "example.com",
+			})
+			for _, ctx := range []context.Context{context.Background(), fronted} {
+				resp, reused, err := get(t, txp, ctx, srv.URL)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if reused {
+					t.Fatal("reused a connection created for other overrides")
+				}
+				if enableHTTP2 && resp.ProtoMajor != 2 {
+					t.Fatalf("unexpected protocol: %s", resp.Proto)
+				}
+			}
+			if _, reused, err := get(t, txp, fronted, srv.URL); err != nil || !reused {
+				t.Fatalf("did not reuse the connection: %v", err)
+			}
+			if len(*names) != 2 || (*names)[1] != "example.com" {
+				t.Fatalf("unexpected SNIs: %v", *names)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows a Transport to advertise
+// and transparently decode the content codings that browsers use.
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	ascii "github.com/ooni/oohttp/internal/ascii"
+	brotli "github.com/ooni/oohttp/internal/brotli"
+	zstd "github.com/ooni/oohttp/internal/zstd"
+)
+
+// ContentDecoder decodes response bodies using a content coding.
+type ContentDecoder struct {
+	// Coding is the name of the content coding, e.g., "br".
+	Coding string
+
+	// NewReader returns a reader decoding r. We call it when reading
+	// the response body for the first time, and we close the returned
+	// reader when closing the response body.
+	NewReader func(r io.Reader) (io.ReadCloser, error)
+}
+
+// The content decoders that browsers use. We decode brotli and zstd
+// using internal copies of github.com/andybalholm/brotli and of the
+// decoder of the standard library, respectively.
+var (
+	// GzipDecoder decodes the "gzip" content coding.
+	GzipDecoder = ContentDecoder{
+		Coding: "gzip",
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	}
+
+	// DeflateDecoder decodes the "deflate" content coding, which is the
+	// zlib format, also accepting raw deflate streams, as browsers do.
+	DeflateDecoder = ContentDecoder{
+		Coding:    "deflate",
+		NewReader: newDeflateReader,
+	}
+
+	// BrotliDecoder decodes the "br" content coding.
+	BrotliDecoder = ContentDecoder{
+		Coding: "br",
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return io.NopCloser(brotli.NewReader(r)), nil
+		},
+	}
+
+	// ZstdDecoder decodes the "zstd" content coding.
+	ZstdDecoder = ContentDecoder{
+		Coding: "zstd",
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return io.NopCloser(zstd.NewReader(r)), nil
+		},
+	}
+)
+
+// newDeflateReader returns a reader decoding r, which is either a zlib
+// stream or, as some servers send, a raw deflate stream.
+func newDeflateReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err != nil && len(header) < 2 {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	// See RFC 1950 section 2.2: the compression method is 8 (deflate),
+	// and the header, as a big-endian integer, is a multiple of 31.
+	if header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
+
+// ContentDecoders is a list of ContentDecoder, in order of preference.
+type ContentDecoders []ContentDecoder
+
+// AcceptEncoding returns the codings of d separated by commas, which is
+// suitable for the Accept-Encoding header, e.g., "gzip, deflate, br, zstd".
+func (d ContentDecoders) AcceptEncoding() string {
+	codings := make([]string, 0, len(d))
+	for _, dec := range d {
+		codings = append(codings, dec.Coding)
+	}
+	return strings.Join(codings, ", ")
+}
+
+// lookup returns the decoder for coding.
+func (d ContentDecoders) lookup(coding string) (ContentDecoder, bool) {
+	for _, dec := range d {
+		if ascii.EqualFold(dec.Coding, coding) {
+			return dec, true
+		}
+	}
+	return ContentDecoder{}, false
+}
+
+// Decode arranges for resp.Body to be decoded according to its
+// Content-Encoding header, which may list several codings. It reports
+// whether it did so, in which case, like a Transport decoding gzip, it
+// removes the Content-Encoding and Content-Length headers, sets the
+// ContentLength to -1 and sets Uncompressed. It leaves resp unchanged
+// when there is no Content-Encoding or d cannot decode all the codings.
+func (d ContentDecoders) Decode(resp *Response) bool {
+	var decoders []ContentDecoder
+	for _, value := range resp.Header["Content-Encoding"] {
+		for _, coding := range strings.Split(value, ",") {
+			coding = strings.TrimSpace(coding)
+			if coding == "" || ascii.EqualFold(coding, "identity") {
+				continue
+			}
+			dec, found := d.lookup(coding)
+			if !found {
+				return false
+			}
+			decoders = append(decoders, dec)
+		}
+	}
+	if len(decoders) == 0 {
+		return false
+	}
+	resp.Body = &contentDecoderReader{body: resp.Body, decoders: decoders}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return true
+}
+
+// contentDecoderReader wraps a response body so it can lazily create
+// the decoders on the first call to Read.
+type contentDecoderReader struct {
+	body     io.ReadCloser
+	decoders []ContentDecoder // in the order the server applied them
+	closed   atomic.Bool
+
+	// mu protects the remaining fields.
+	mu      sync.Mutex
+	r       io.Reader
+	closers []io.Closer
+	err     error // any error from NewReader; sticky
+}
+
+func (cr *contentDecoderReader) Read(p []byte) (int, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if cr.closed.Load() {
+		return 0, errReadOnClosedResBody
+	}
+	if cr.r == nil && cr.err == nil {
+		var r io.Reader = cr.body
+		for i := len(cr.decoders) - 1; i >= 0; i-- {
+			rc, err := cr.decoders[i].NewReader(r)
+			if err != nil {
+				cr.err = err
+				break
+			}
+			cr.closers = append(cr.closers, rc)
+			r = rc
+		}
+		cr.r = r
+	}
+	if cr.err != nil {
+		return 0, cr.err
+	}
+	return cr.r.Read(p)
+}
+
+func (cr *contentDecoderReader) Close() error {
+	cr.closed.Store(true)
+	// Closing the body first interrupts any pending Read.
+	err := cr.body.Close()
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	for i := len(cr.closers) - 1; i >= 0; i-- {
+		cr.closers[i].Close()
+	}
+	cr.closers = nil
+	return err
+}
+
+// acceptEncoding returns the Accept-Encoding header t sends when it
+// requests compression.
+func (t *Transport) acceptEncoding() string {
+	switch {
+	case t.AcceptEncoding != "":
+		return t.AcceptEncoding
+	case len(t.ContentDecoders) > 0:
+		return t.ContentDecoders.AcceptEncoding()
+	default:
+		return "gzip"
+	}
+}
@@ -0,0 +1,207 @@
+package http_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// contentDecoderBody is the body that contentDecoderHandler encodes.
+const contentDecoderBody = "hello, world\n"
+
+// zstdHelloWorld is contentDecoderBody encoded using zstd.
+const zstdHelloWorld = "\x28\xb5\x2f\xfd\x24\x0d\x69\x00\x00\x68\x65\x6c\x6c\x6f\x2c\x20\x77\x6f\x72\x6c\x64\x0a\x4c\x1f\xf9\xf1"
+
+// brotliHelloWorld is contentDecoderBody encoded using brotli.
+const brotliHelloWorld = "\x0b\x06\x80\x68\x65\x6c\x6c\x6f\x2c\x20\x77\x6f\x72\x6c\x64\x0a\x03"
+
+// contentDecoderHandler replies with contentDecoderBody encoded according
+// to the "coding" query parameter, and with the Accept-Encoding header of
+// the request in the X-Accept-Encoding header.
+var contentDecoderHandler = HandlerFunc(func(w ResponseWriter, r *Request) {
+	w.Header().Set("X-Accept-Encoding", r.Header.Get("Accept-Encoding"))
+	var buf bytes.Buffer
+	encode := func(newWriter func(io.Writer) io.WriteCloser) {
+		var out bytes.Buffer
+		wc := newWriter(&out)
+		wc.Write(buf.Bytes())
+		wc.Close()
+		buf = out
+	}
+	buf.WriteString(contentDecoderBody)
+	coding := r.URL.Query().Get("coding")
+	switch coding {
+	case "gzip":
+		encode(func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) })
+	case "deflate":
+		encode(func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) })
+	case "raw-deflate":
+		coding = "deflate"
+		encode(func(w io.Writer) io.WriteCloser {
+			fw, _ := flate.NewWriter(w, flate.DefaultCompression)
+			return fw
+		})
+	case "gzip, deflate":
+		encode(func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) })
+		encode(func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) })
+	case "zstd":
+		buf.Reset()
+		buf.WriteString(zstdHelloWorld)
+	case "br":
+		buf.Reset()
+		buf.WriteString(brotliHelloWorld)
+	}
+	w.Header().Set("Content-Encoding", coding)
+	w.Write(buf.Bytes())
+})
+
+func TestContentDecoders(t *testing.T) {
+	for _, mode := range []struct {
+		name  string
+		http2 bool
+	}{{"HTTP/1.1", false}, {"HTTP/2", true}} {
+		t.Run(mode.name, func(t *testing.T) {
+			if mode.http2 {
+				CondSkipHTTP2(t)
+			}
+			srv := httptest.NewUnstartedServer(contentDecoderHandler)
+			srv.EnableHTTP2 = mode.http2
+			srv.StartTLS()
+			defer srv.Close()
+
+			// get fetches the body encoded using coding with txp.
+			get := func(t *testing.T, txp *Transport, coding string, header Header) (*Response, string) {
+				t.Helper()
+				req, _ := NewRequest("GET", srv.URL+"/?coding="+url.QueryEscape(coding), nil)
+				for key, values := range header {
+					req.Header[key] = values
+				}
+				resp, err := txp.RoundTrip(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer resp.Body.Close()
+				if (resp.ProtoMajor == 2) != mode.http2 {
+					t.Fatalf("unexpected protocol: %s", resp.Proto)
+				}
+				data, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return resp, string(data)
+			}
+			newTransport := func(t *testing.T) *Transport {
+				txp := srv.Client().Transport.(*Transport).Clone()
+				t.Cleanup(txp.CloseIdleConnections)
+				return txp
+			}
+
+			t.Run("we advertise and decode the codings of the decoders", func(t *testing.T) {
+				txp := newTransport(t)
+				txp.ContentDecoders = ContentDecoders{GzipDecoder, DeflateDecoder, BrotliDecoder, ZstdDecoder}
+				for _, coding := range []string{"gzip", "deflate", "raw-deflate", "br", "zstd", "gzip, deflate", ""} {
+					resp, body := get(t, txp, coding, nil)
+					if body != contentDecoderBody {
+						t.Fatalf("%s: unexpected body: %q", coding, body)
+					}
+					if accept := resp.Header.Get("X-Accept-Encoding"); accept != "gzip, deflate, br, zstd" {
+						t.Fatalf("%s: unexpected Accept-Encoding: %q", coding, accept)
+					}
+					if decoded := coding != ""; resp.Uncompressed != decoded ||
+						resp.Header.Get("Content-Encoding") != "" || (decoded && resp.ContentLength != -1) {
+						t.Fatalf("%s: unexpected response: %v %v %d", coding, resp.Uncompressed, resp.Header, resp.ContentLength)
+					}
+				}
+			})
+
+			t.Run("we send the exact Accept-Encoding and only decode what we can", func(t *testing.T) {
+				txp := newTransport(t)
+				txp.AcceptEncoding = "gzip, deflate, br, zstd"
+				resp, body := get(t, txp, "gzip", nil)
+				if body != contentDecoderBody || !resp.Uncompressed {
+					t.Fatalf("unexpected response: %v %q", resp.Uncompressed, body)
+				}
+				if accept := resp.Header.Get("X-Accept-Encoding"); accept != txp.AcceptEncoding {
+					t.Fatalf("unexpected Accept-Encoding: %q", accept)
+				}
+				resp, _ = get(t, txp, "zstd", nil)
+				if resp.Uncompressed || resp.Header.Get("Content-Encoding") != "zstd" {
+					t.Fatalf("unexpected response: %v %v", resp.Uncompressed, resp.Header)
+				}
+			})
+
+			t.Run("we do not decode when the request sets Accept-Encoding", func(t *testing.T) {
+				txp := newTransport(t)
+				txp.ContentDecoders = ContentDecoders{GzipDecoder, ZstdDecoder}
+				resp, body := get(t, txp, "zstd", Header{"Accept-Encoding": {"zstd"}})
+				if resp.Uncompressed || body != zstdHelloWorld {
+					t.Fatalf("unexpected response: %v %q", resp.Uncompressed, body)
+				}
+			})
+
+			t.Run("we keep requesting gzip only by default", func(t *testing.T) {
+				txp := newTransport(t)
+				resp, body := get(t, txp, "gzip", nil)
+				if body != contentDecoderBody || !resp.Uncompressed || resp.Header.Get("X-Accept-Encoding") != "gzip" {
+					t.Fatalf("unexpected response: %v %v %q", resp.Uncompressed, resp.Header, body)
+				}
+			})
+		})
+	}
+}
+
+func TestContentDecodersDecode(t *testing.T) {
+	t.Run("we fail reading when the body is not encoded as declared", func(t *testing.T) {
+		resp := &Response{
+			Header: Header{"Content-Encoding": {"gzip"}},
+			Body:   io.NopCloser(bytes.NewReader([]byte("plain"))),
+		}
+		if !(ContentDecoders{GzipDecoder}).Decode(resp) {
+			t.Fatal("expected to decode")
+		}
+		if _, err := io.ReadAll(resp.Body); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("we fail reading a truncated brotli body", func(t *testing.T) {
+		resp := &Response{
+			Header: Header{"Content-Encoding": {"br"}},
+			Body:   io.NopCloser(strings.NewReader(brotliHelloWorld[:len(brotliHelloWorld)-1])),
+		}
+		(ContentDecoders{BrotliDecoder}).Decode(resp)
+		if _, err := io.ReadAll(resp.Body); err != io.ErrUnexpectedEOF {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("we fail reading after closing the body", func(t *testing.T) {
+		resp := &Response{
+			Header: Header{"Content-Encoding": {"zstd"}},
+			Body:   io.NopCloser(bytes.NewReader([]byte(zstdHelloWorld))),
+		}
+		(ContentDecoders{ZstdDecoder}).Decode(resp)
+		resp.Body.Close()
+		if _, err := resp.Body.Read(make([]byte, 1)); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("we leave alone unknown codings", func(t *testing.T) {
+		resp := &Response{
+			Header:        Header{"Content-Encoding": {"gzip, br"}, "Content-Length": {"10"}},
+			ContentLength: 10,
+		}
+		if (ContentDecoders{GzipDecoder}).Decode(resp) || resp.ContentLength != 10 || resp.Header.Get("Content-Encoding") != "gzip, br" {
+			t.Fatalf("unexpected response: %+v", resp)
+		}
+	})
+}
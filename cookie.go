@@ -37,6 +37,11 @@ type Cookie struct {
 	Secure   bool
 	HttpOnly bool
 	SameSite SameSite
+
+	// Partitioned is an ooni/oohttp extension backported from Go 1.23.
+	// It indicates that the cookie uses partitioned storage (CHIPS).
+	Partitioned bool
+
 	Raw      string
 	Unparsed []string // Raw text of unparsed attribute-value pairs
 }
@@ -128,6 +133,9 @@ func readSetCookies(h Header) []*Cookie {
 			case "httponly":
 				c.HttpOnly = true
 				continue
+			case "partitioned": // oohttp ext
+				c.Partitioned = true
+				continue
 			case "domain":
 				c.Domain = val
 				continue
@@ -237,6 +245,9 @@ func (c *Cookie) String() string {
 	case SameSiteStrictMode:
 		b.WriteString("; SameSite=Strict")
 	}
+	if c.Partitioned { // oohttp ext
+		b.WriteString("; Partitioned")
+	}
 	return b.String()
 }
 
@@ -268,6 +279,9 @@ func (c *Cookie) Valid() error {
 			return errors.New("http: invalid Cookie.Domain")
 		}
 	}
+	if c.Partitioned && !c.Secure { // oohttp ext
+		return errors.New("http: partitioned cookies must be set with Secure")
+	}
 	return nil
 }
 
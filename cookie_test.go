@@ -80,6 +80,10 @@ var writeSetCookiesTests = []struct {
 		&Cookie{Name: "cookie-15", Value: "samesite-none", SameSite: SameSiteNoneMode},
 		"cookie-15=samesite-none; SameSite=None",
 	},
+	{
+		&Cookie{Name: "cookie-16", Value: "partitioned", Secure: true, Partitioned: true},
+		"cookie-16=partitioned; Secure; Partitioned",
+	},
 	// The "special" cookies have values containing commas or spaces which
 	// are disallowed by RFC 6265 but are common in the wild.
 	{
@@ -317,6 +321,16 @@ var readSetCookiesTests = []struct {
 			Raw:      "samesitenone=foo; SameSite=None",
 		}},
 	},
+	{
+		Header{"Set-Cookie": {"partitioned=foo; Secure; Partitioned"}},
+		[]*Cookie{{
+			Name:        "partitioned",
+			Value:       "foo",
+			Secure:      true,
+			Partitioned: true,
+			Raw:         "partitioned=foo; Secure; Partitioned",
+		}},
+	},
 	// Make sure we can properly read back the Set-Cookie headers we create
 	// for values containing spaces or commas:
 	{
@@ -545,9 +559,11 @@ func TestCookieValid(t *testing.T) {
 		{&Cookie{Name: "invalid-path", Path: "/foo;bar/"}, false},
 		{&Cookie{Name: "invalid-domain", Domain: "example.com:80"}, false},
 		{&Cookie{Name: "invalid-expiry", Value: "", Expires: time.Date(1600, 1, 1, 1, 1, 1, 1, time.UTC)}, false},
+		{&Cookie{Name: "invalid-partitioned", Partitioned: true}, false},
 		{&Cookie{Name: "valid-empty"}, true},
 		{&Cookie{Name: "valid-expires", Value: "foo", Path: "/bar", Domain: "example.com", Expires: time.Unix(0, 0)}, true},
 		{&Cookie{Name: "valid-max-age", Value: "foo", Path: "/bar", Domain: "example.com", MaxAge: 60}, true},
+		{&Cookie{Name: "valid-partitioned", Value: "foo", Secure: true, Partitioned: true}, true},
 		{&Cookie{Name: "valid-all-fields", Value: "foo", Path: "/bar", Domain: "example.com", Expires: time.Unix(0, 0), MaxAge: 0}, true},
 	}
 
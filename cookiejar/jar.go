@@ -3,6 +3,11 @@
 // license that can be found in the LICENSE file.
 
 // Package cookiejar implements an in-memory RFC 6265-compliant http.CookieJar.
+//
+// As an ooni/oohttp extension, a Jar can enumerate, export and import its
+// cookies, and it can be backed by a Storage, such as a FileStorage. It also
+// supports partitioned cookies (CHIPS) and, using CookiesForRequest and
+// SetCookiesForRequest, the SameSite rules that modern browsers enforce.
 package cookiejar
 
 import (
@@ -32,7 +37,8 @@ import (
 // set a cookie for bar.com.
 //
 // A public suffix list implementation is in the package
-// golang.org/x/net/publicsuffix.
+// golang.org/x/net/publicsuffix. As an ooni/oohttp extension, another one
+// is in the package github.com/ooni/oohttp/cookiejar/publicsuffix.
 type PublicSuffixList interface {
 	// PublicSuffix returns the public suffix of domain.
 	//
@@ -56,6 +62,11 @@ type Options struct {
 	// secure: it means that the HTTP server for foo.co.uk can set a cookie
 	// for bar.co.uk.
 	PublicSuffixList PublicSuffixList
+
+	// Storage is an ooni/oohttp extension. If this field is not nil, New
+	// loads the cookies from it, and the Jar saves them into it when they
+	// change. See Jar.Save.
+	Storage Storage
 }
 
 // Jar implements the http.CookieJar interface from the net/http package.
@@ -66,12 +77,15 @@ type Jar struct {
 	mu sync.Mutex
 
 	// entries is a set of entries, keyed by their eTLD+1 and subkeyed by
-	// their name/domain/path.
+	// their name/domain/path (and partition, for partitioned cookies).
 	entries map[string]map[string]entry
 
 	// nextSeqNum is the next sequence number assigned to a new cookie
 	// created SetCookies.
 	nextSeqNum uint64
+
+	storage Storage    // oohttp ext
+	saveMu  sync.Mutex // oohttp ext: serializes saving
 }
 
 // New returns a new cookie jar. A nil [*Options] is equivalent to a zero
@@ -82,6 +96,16 @@ func New(o *Options) (*Jar, error) {
 	}
 	if o != nil {
 		jar.psList = o.PublicSuffixList
+		jar.storage = o.Storage // oohttp ext
+	}
+	if jar.storage != nil { // oohttp ext
+		entries, err := jar.storage.Load()
+		if err != nil {
+			return nil, err
+		}
+		if err := jar.addEntries(entries, time.Now()); err != nil {
+			return nil, err
+		}
 	}
 	return jar, nil
 }
@@ -104,6 +128,12 @@ type entry struct {
 	Creation   time.Time
 	LastAccess time.Time
 
+	// Partitioned and PartitionKey are an ooni/oohttp extension. The
+	// PartitionKey of a partitioned cookie is the site of the top-level
+	// document when the jar received it. See RequestContext.
+	Partitioned  bool
+	PartitionKey string
+
 	// seqNum is a sequence number so that Cookies returns cookies in a
 	// deterministic order, even for cookies that have equal Path length and
 	// equal Creation time. This simplifies testing.
@@ -112,6 +142,9 @@ type entry struct {
 
 // id returns the domain;path;name triple of e as an id.
 func (e *entry) id() string {
+	if e.PartitionKey != "" { // oohttp ext
+		return fmt.Sprintf("%s;%s;%s;%s", e.Domain, e.Path, e.Name, e.PartitionKey)
+	}
 	return fmt.Sprintf("%s;%s;%s", e.Domain, e.Path, e.Name)
 }
 
@@ -161,6 +194,12 @@ func (j *Jar) Cookies(u *url.URL) (cookies []*http.Cookie) {
 
 // cookies is like Cookies but takes the current time as a parameter.
 func (j *Jar) cookies(u *url.URL, now time.Time) (cookies []*http.Cookie) {
+	return j.cookiesForRequest(u, nil, now)
+}
+
+// cookiesForRequest is like cookies but also takes the context of the
+// request, where nil means enforcing no SameSite rules.
+func (j *Jar) cookiesForRequest(u *url.URL, rc *RequestContext, now time.Time) (cookies []*http.Cookie) {
 	if u.Scheme != "http" && u.Scheme != "https" {
 		return cookies
 	}
@@ -169,6 +208,7 @@ func (j *Jar) cookies(u *url.URL, now time.Time) (cookies []*http.Cookie) {
 		return cookies
 	}
 	key := jarKey(host, j.psList)
+	site := j.newRequestSite(u, host, rc) // oohttp ext
 
 	j.mu.Lock()
 	defer j.mu.Unlock()
@@ -195,6 +235,9 @@ func (j *Jar) cookies(u *url.URL, now time.Time) (cookies []*http.Cookie) {
 		if !e.shouldSend(https, host, path) {
 			continue
 		}
+		if !site.allowsSend(&e) { // oohttp ext
+			continue
+		}
 		e.LastAccess = now
 		submap[id] = e
 		selected = append(selected, e)
@@ -231,23 +274,33 @@ func (j *Jar) cookies(u *url.URL, now time.Time) (cookies []*http.Cookie) {
 //
 // It does nothing if the URL's scheme is not HTTP or HTTPS.
 func (j *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
-	j.setCookies(u, cookies, time.Now())
+	if j.setCookies(u, cookies, time.Now()) {
+		j.save() // oohttp ext
+	}
 }
 
 // setCookies is like SetCookies but takes the current time as parameter.
-func (j *Jar) setCookies(u *url.URL, cookies []*http.Cookie, now time.Time) {
+// It returns whether it modified the jar.
+func (j *Jar) setCookies(u *url.URL, cookies []*http.Cookie, now time.Time) bool {
+	return j.setCookiesForRequest(u, cookies, nil, now)
+}
+
+// setCookiesForRequest is like setCookies but also takes the context of
+// the request, where nil means enforcing no SameSite rules.
+func (j *Jar) setCookiesForRequest(u *url.URL, cookies []*http.Cookie, rc *RequestContext, now time.Time) bool {
 	if len(cookies) == 0 {
-		return
+		return false
 	}
 	if u.Scheme != "http" && u.Scheme != "https" {
-		return
+		return false
 	}
 	host, err := canonicalHost(u.Host)
 	if err != nil {
-		return
+		return false
 	}
 	key := jarKey(host, j.psList)
 	defPath := defaultPath(u.Path)
+	site := j.newRequestSite(u, host, rc) // oohttp ext
 
 	j.mu.Lock()
 	defer j.mu.Unlock()
@@ -260,6 +313,9 @@ func (j *Jar) setCookies(u *url.URL, cookies []*http.Cookie, now time.Time) {
 		if err != nil {
 			continue
 		}
+		if !site.admit(&e, cookie) { // oohttp ext
+			continue
+		}
 		id := e.id()
 		if remove {
 			if submap != nil {
@@ -294,6 +350,7 @@ func (j *Jar) setCookies(u *url.URL, cookies []*http.Cookie, now time.Time) {
 			j.entries[key] = submap
 		}
 	}
+	return modified
 }
 
 // canonicalHost strips port from host if present and returns the canonicalized
@@ -410,6 +467,12 @@ func (j *Jar) newEntry(c *http.Cookie, now time.Time, defPath, host string) (e e
 		return e, false, err
 	}
 
+	// oohttp ext: partitioned cookies must be secure, as with CHIPS.
+	if c.Partitioned && !c.Secure {
+		return e, false, errInsecurePartitioned
+	}
+	e.Partitioned = c.Partitioned
+
 	// MaxAge takes precedence over Expires.
 	if c.MaxAge < 0 {
 		return e, true, nil
@@ -440,6 +503,8 @@ func (j *Jar) newEntry(c *http.Cookie, now time.Time, defPath, host string) (e e
 		e.SameSite = "SameSite=Strict"
 	case http.SameSiteLaxMode:
 		e.SameSite = "SameSite=Lax"
+	case http.SameSiteNoneMode: // oohttp ext
+		e.SameSite = "SameSite=None"
 	}
 
 	return e, false, nil
@@ -448,6 +513,8 @@ func (j *Jar) newEntry(c *http.Cookie, now time.Time, defPath, host string) (e e
 var (
 	errIllegalDomain   = errors.New("cookiejar: illegal cookie domain attribute")
 	errMalformedDomain = errors.New("cookiejar: malformed cookie domain attribute")
+
+	errInsecurePartitioned = errors.New("cookiejar: partitioned cookie without secure attribute") // oohttp ext
 )
 
 // endOfTime is the time when session (non-persistent) cookies expire.
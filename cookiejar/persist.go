@@ -0,0 +1,375 @@
+package cookiejar
+
+// This file is an ooni/oohttp extension. It allows enumerating the cookies
+// of a Jar, exporting and importing them using JSON and the Netscape
+// cookies.txt format, and backing a Jar using a Storage.
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is a cookie stored in a Jar along with the metadata of RFC 6265.
+type Entry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+
+	// Domain is the host of the cookie, for host-only cookies, or its
+	// domain attribute, without the leading dot, for domain cookies.
+	Domain string `json:"domain"`
+
+	Path string `json:"path"`
+
+	// SameSite is empty, "SameSite", "SameSite=Strict", "SameSite=Lax"
+	// or "SameSite=None".
+	SameSite string `json:"same_site,omitempty"`
+
+	Secure   bool `json:"secure"`
+	HttpOnly bool `json:"http_only"`
+
+	// Persistent is false for session cookies, which have no Expires.
+	Persistent bool `json:"persistent"`
+
+	// HostOnly is true for cookies without a domain attribute, which we
+	// only send to the host that set them.
+	HostOnly bool `json:"host_only"`
+
+	Expires    time.Time `json:"expires"`
+	Creation   time.Time `json:"creation"`
+	LastAccess time.Time `json:"last_access"`
+
+	// Partitioned is true for partitioned cookies, which are secure and
+	// whose PartitionKey is the site of the top-level document when the
+	// jar received them, e.g., "https://example.com". See RequestContext.
+	Partitioned  bool   `json:"partitioned,omitempty"`
+	PartitionKey string `json:"partition_key,omitempty"`
+}
+
+// Storage persists the entries of a Jar, e.g., in a file. See the Storage
+// field of Options. Its methods may be called concurrently.
+type Storage interface {
+	// Load returns the entries to add to a new Jar.
+	Load() ([]Entry, error)
+
+	// Save replaces the stored entries.
+	Save(entries []Entry) error
+}
+
+// AllCookies returns all the cookies of the jar that have not expired,
+// including session cookies, in the order in which the jar received them.
+func (j *Jar) AllCookies() []Entry {
+	return j.allCookies(time.Now())
+}
+
+// allCookies is like AllCookies but takes the current time as a parameter.
+func (j *Jar) allCookies(now time.Time) []Entry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var selected []entry
+	for _, submap := range j.entries {
+		for _, e := range submap {
+			if e.Persistent && !e.Expires.After(now) {
+				continue
+			}
+			selected = append(selected, e)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].seqNum < selected[j].seqNum
+	})
+	entries := make([]Entry, 0, len(selected))
+	for _, e := range selected {
+		entries = append(entries, e.export())
+	}
+	return entries
+}
+
+// export converts e to an Entry.
+func (e *entry) export() Entry {
+	out := Entry{
+		Name:       e.Name,
+		Value:      e.Value,
+		Domain:     e.Domain,
+		Path:       e.Path,
+		SameSite:   e.SameSite,
+		Secure:     e.Secure,
+		HttpOnly:   e.HttpOnly,
+		Persistent: e.Persistent,
+		HostOnly:   e.HostOnly,
+		Creation:   e.Creation,
+		LastAccess: e.LastAccess,
+
+		Partitioned:  e.Partitioned,
+		PartitionKey: e.PartitionKey,
+	}
+	if e.Persistent {
+		out.Expires = e.Expires
+	}
+	return out
+}
+
+var errInvalidEntry = errors.New("cookiejar: invalid entry")
+
+// AddEntries adds the given entries to the jar, replacing the cookies
+// with the same name, domain, path and partition, and skipping the expired
+// ones.
+// It returns an error, without adding any entry, if an entry is invalid.
+func (j *Jar) AddEntries(entries []Entry) error {
+	if err := j.addEntries(entries, time.Now()); err != nil {
+		return err
+	}
+	j.save()
+	return nil
+}
+
+// addEntries is like AddEntries but takes the current time as a parameter
+// and does not save the jar.
+func (j *Jar) addEntries(entries []Entry, now time.Time) error {
+	converted := make([]entry, 0, len(entries))
+	for _, in := range entries {
+		domain, err := canonicalHost(in.Domain)
+		if err != nil || in.Name == "" || domain == "" || !strings.HasPrefix(in.Path, "/") {
+			return fmt.Errorf("%w: %q for %q", errInvalidEntry, in.Name, in.Domain)
+		}
+		switch in.SameSite {
+		case "", "SameSite", "SameSite=Strict", "SameSite=Lax", "SameSite=None":
+		default:
+			return fmt.Errorf("%w: SameSite %q", errInvalidEntry, in.SameSite)
+		}
+		if in.Partitioned != (in.PartitionKey != "") || (in.Partitioned && !in.Secure) {
+			return fmt.Errorf("%w: %q for %q: invalid partition", errInvalidEntry, in.Name, in.Domain)
+		}
+		hostOnly := in.HostOnly
+		if !hostOnly {
+			// Check the domain as SetCookies would if the domain itself
+			// set the cookie, so a public suffix becomes host-only.
+			domain, hostOnly, err = j.domainAndType(domain, domain)
+			if err != nil {
+				return fmt.Errorf("%w: %q for %q: %v", errInvalidEntry, in.Name, in.Domain, err)
+			}
+		}
+		e := entry{
+			Name:       in.Name,
+			Value:      in.Value,
+			Domain:     domain,
+			Path:       in.Path,
+			SameSite:   in.SameSite,
+			Secure:     in.Secure,
+			HttpOnly:   in.HttpOnly,
+			Persistent: in.Persistent,
+			HostOnly:   hostOnly,
+			Expires:    in.Expires,
+			Creation:   in.Creation,
+			LastAccess: in.LastAccess,
+
+			Partitioned:  in.Partitioned,
+			PartitionKey: in.PartitionKey,
+		}
+		if !e.Persistent {
+			e.Expires = endOfTime
+		} else if !e.Expires.After(now) {
+			continue
+		}
+		if e.Creation.IsZero() {
+			e.Creation = now
+		}
+		if e.LastAccess.IsZero() {
+			e.LastAccess = e.Creation
+		}
+		converted = append(converted, e)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, e := range converted {
+		key := jarKey(e.Domain, j.psList)
+		submap := j.entries[key]
+		if submap == nil {
+			submap = make(map[string]entry)
+			j.entries[key] = submap
+		}
+		id := e.id()
+		if old, ok := submap[id]; ok {
+			e.seqNum = old.seqNum
+		} else {
+			e.seqNum = j.nextSeqNum
+			j.nextSeqNum++
+		}
+		submap[id] = e
+	}
+	return nil
+}
+
+// Export writes all the cookies of the jar, as returned by AllCookies, to
+// w as a JSON array of Entry.
+func (j *Jar) Export(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(j.AllCookies())
+}
+
+// Import reads a JSON array of Entry, as written by Export, from r and
+// adds the entries to the jar using AddEntries.
+func (j *Jar) Import(r io.Reader) error {
+	var entries []Entry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return err
+	}
+	return j.AddEntries(entries)
+}
+
+// netscapeHeader is the first line of Netscape cookies.txt files.
+const netscapeHeader = "# Netscape HTTP Cookie File"
+
+// netscapeHttpOnlyPrefix is the domain prefix curl uses for HttpOnly cookies.
+const netscapeHttpOnlyPrefix = "#HttpOnly_"
+
+// ExportNetscape writes all the cookies of the jar to w using the Netscape
+// cookies.txt format used by curl and wget. This format does not contain
+// the SameSite, Creation and LastAccess fields, and session cookies have
+// zero expiration time. Since it cannot represent partitions, we skip the
+// partitioned cookies rather than leaking them to other top-level sites.
+func (j *Jar) ExportNetscape(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s\n\n", netscapeHeader)
+	for _, e := range j.AllCookies() {
+		if e.Partitioned {
+			continue
+		}
+		domain := e.Domain
+		if !e.HostOnly {
+			domain = "." + domain
+		}
+		if e.HttpOnly {
+			domain = netscapeHttpOnlyPrefix + domain
+		}
+		var expires int64
+		if e.Persistent {
+			expires = e.Expires.Unix()
+		}
+		fmt.Fprintf(bw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", domain, netscapeBool(!e.HostOnly),
+			e.Path, netscapeBool(e.Secure), expires, e.Name, e.Value)
+	}
+	return bw.Flush()
+}
+
+func netscapeBool(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// ImportNetscape reads cookies using the Netscape cookies.txt format from r
+// and adds them to the jar using AddEntries.
+func (j *Jar) ImportNetscape(r io.Reader) error {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		httpOnly := strings.HasPrefix(line, netscapeHttpOnlyPrefix)
+		line = strings.TrimPrefix(line, netscapeHttpOnlyPrefix)
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 7 {
+			return fmt.Errorf("cookiejar: line %d: expected 7 fields, got %d", lineno, len(fields))
+		}
+		expires, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return fmt.Errorf("cookiejar: line %d: invalid expiration time: %w", lineno, err)
+		}
+		e := Entry{
+			Name:     fields[5],
+			Value:    fields[6],
+			Domain:   strings.TrimPrefix(fields[0], "."),
+			Path:     fields[2],
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			HttpOnly: httpOnly,
+			HostOnly: !strings.EqualFold(fields[1], "TRUE"),
+		}
+		if expires > 0 {
+			e.Persistent, e.Expires = true, time.Unix(expires, 0)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return j.AddEntries(entries)
+}
+
+// Save saves all the cookies of the jar, as returned by AllCookies, to the
+// Storage of the jar, if any. The jar saves itself when SetCookies or
+// AddEntries change it, ignoring errors. Call Save to also save the last
+// access times and to check for errors, e.g., before exiting.
+func (j *Jar) Save() error {
+	if j.storage == nil {
+		return nil
+	}
+	j.saveMu.Lock()
+	defer j.saveMu.Unlock()
+	return j.storage.Save(j.AllCookies())
+}
+
+// save is like Save but ignores errors.
+func (j *Jar) save() {
+	j.Save()
+}
+
+// FileStorage is a Storage that saves the entries in a file using the
+// JSON format of Export.
+type FileStorage struct {
+	// Path is the path of the file.
+	Path string
+}
+
+var _ Storage = &FileStorage{}
+
+// Load implements Storage. It returns no entries if the file does not exist.
+func (s *FileStorage) Load() ([]Entry, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("cookiejar: %s: %w", s.Path, err)
+	}
+	return entries, nil
+}
+
+// Save implements Storage. It atomically replaces the file, whose
+// permissions are 0600, since cookies are sensitive.
+func (s *FileStorage) Save(entries []Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.Path)
+}
@@ -0,0 +1,219 @@
+package cookiejar
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	http "github.com/ooni/oohttp"
+)
+
+// newPersistTestJar returns a jar containing some cookies set at now
+// for www.example.com, along with the URL.
+func newPersistTestJar(t *testing.T, o *Options, now time.Time) (*Jar, *url.URL) {
+	t.Helper()
+	jar, err := New(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://www.example.com/foo/bar")
+	jar.setCookies(u, []*http.Cookie{
+		{Name: "session", Value: "1", HttpOnly: true},
+		{Name: "domain", Value: "2", Domain: "example.com", Path: "/", MaxAge: 3600, Secure: true},
+		{Name: "strict", Value: "3", SameSite: http.SameSiteStrictMode, Expires: now.Add(time.Hour)},
+		{Name: "expired", Value: "4", Expires: now.Add(-time.Hour)},
+	}, now)
+	return jar, u
+}
+
+func TestAllCookies(t *testing.T) {
+	jar, _ := newPersistTestJar(t, nil, tNow)
+	expected := []Entry{{
+		Name: "session", Value: "1", Domain: "www.example.com", Path: "/foo",
+		HttpOnly: true, HostOnly: true, Creation: tNow, LastAccess: tNow,
+	}, {
+		Name: "domain", Value: "2", Domain: "example.com", Path: "/", Secure: true,
+		Persistent: true, Expires: tNow.Add(time.Hour), Creation: tNow, LastAccess: tNow,
+	}, {
+		Name: "strict", Value: "3", Domain: "www.example.com", Path: "/foo", SameSite: "SameSite=Strict",
+		HostOnly: true, Persistent: true, Expires: tNow.Add(time.Hour), Creation: tNow, LastAccess: tNow,
+	}}
+	if got := jar.allCookies(tNow); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("unexpected cookies:\n got: %+v\nwant: %+v", got, expected)
+	}
+	if got := jar.allCookies(tNow.Add(2 * time.Hour)); len(got) != 1 || got[0].Name != "session" {
+		t.Fatalf("unexpected cookies after expiration: %+v", got)
+	}
+}
+
+// cookieString returns the cookies the jar sends to u.
+func cookieString(jar *Jar, u *url.URL) string {
+	var s []string
+	for _, c := range jar.Cookies(u) {
+		s = append(s, c.Name+"="+c.Value)
+	}
+	return strings.Join(s, " ")
+}
+
+func TestExportImport(t *testing.T) {
+	for _, format := range []struct {
+		name    string
+		export  func(*Jar, *bytes.Buffer) error
+		import_ func(*Jar, *bytes.Buffer) error
+	}{{
+		name:    "JSON",
+		export:  func(j *Jar, b *bytes.Buffer) error { return j.Export(b) },
+		import_: func(j *Jar, b *bytes.Buffer) error { return j.Import(b) },
+	}, {
+		name:    "Netscape",
+		export:  func(j *Jar, b *bytes.Buffer) error { return j.ExportNetscape(b) },
+		import_: func(j *Jar, b *bytes.Buffer) error { return j.ImportNetscape(b) },
+	}} {
+		t.Run(format.name, func(t *testing.T) {
+			jar, u := newPersistTestJar(t, nil, time.Now())
+			var buf bytes.Buffer
+			if err := format.export(jar, &buf); err != nil {
+				t.Fatal(err)
+			}
+			other, _ := New(nil)
+			if err := format.import_(other, &buf); err != nil {
+				t.Fatal(err)
+			}
+			want, got := jar.AllCookies(), other.AllCookies()
+			for i := range got {
+				if format.name == "Netscape" {
+					// This format lacks some fields.
+					want[i].SameSite, want[i].Expires = "", want[i].Expires.Truncate(time.Second)
+					want[i].Creation, want[i].LastAccess = got[i].Creation, got[i].LastAccess
+				}
+				if !got[i].Expires.Equal(want[i].Expires) {
+					t.Fatalf("unexpected expiration: got %v, want %v", got[i].Expires, want[i].Expires)
+				}
+				got[i].Expires = want[i].Expires
+			}
+			if !reflect.DeepEqual(normalizeTimes(got), normalizeTimes(want)) {
+				t.Fatalf("unexpected cookies:\n got: %+v\nwant: %+v", got, want)
+			}
+			for _, u := range []*url.URL{u, {Scheme: "https", Host: "api.example.com", Path: "/"}} {
+				if want, got := cookieString(jar, u), cookieString(other, u); got != want {
+					t.Fatalf("%s: got %q, want %q", u, got, want)
+				}
+			}
+		})
+	}
+}
+
+// normalizeTimes converts the times of the entries to UTC, stripping the
+// monotonic clock readings, such that we can compare them.
+func normalizeTimes(entries []Entry) []Entry {
+	for i := range entries {
+		entries[i].Expires = entries[i].Expires.UTC()
+		entries[i].Creation = entries[i].Creation.UTC()
+		entries[i].LastAccess = entries[i].LastAccess.UTC()
+	}
+	return entries
+}
+
+func TestImportNetscape(t *testing.T) {
+	const file = `# Netscape HTTP Cookie File
+# https://curl.se/docs/http-cookies.html
+
+.example.com	TRUE	/	TRUE	0	a	1
+#HttpOnly_www.example.com	FALSE	/foo	FALSE	4102444800	b	2
+www.example.com	FALSE	/	FALSE	1	expired	3
+`
+	jar, _ := New(nil)
+	if err := jar.ImportNetscape(strings.NewReader(file)); err != nil {
+		t.Fatal(err)
+	}
+	entries := jar.AllCookies()
+	if len(entries) != 2 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	a, b := entries[0], entries[1]
+	if a.Domain != "example.com" || a.HostOnly || !a.Secure || a.Persistent || a.HttpOnly {
+		t.Fatalf("unexpected entry: %+v", a)
+	}
+	if b.Domain != "www.example.com" || !b.HostOnly || b.Secure || !b.Persistent || !b.HttpOnly ||
+		b.Expires.Unix() != 4102444800 || b.Path != "/foo" {
+		t.Fatalf("unexpected entry: %+v", b)
+	}
+
+	for _, file := range []string{"example.com\tTRUE\t/\n", "example.com\tTRUE\t/\tTRUE\tx\ta\t1\n"} {
+		if err := jar.ImportNetscape(strings.NewReader(file)); err == nil {
+			t.Fatalf("expected an error for %q", file)
+		}
+	}
+}
+
+func TestAddEntriesRejectsInvalidEntries(t *testing.T) {
+	jar, _ := New(nil)
+	for _, e := range []Entry{
+		{Name: "", Domain: "example.com", Path: "/"},
+		{Name: "a", Domain: "", Path: "/"},
+		{Name: "a", Domain: "example.com", Path: "foo"},
+		{Name: "a", Domain: "example.com", Path: "/", SameSite: "Bogus"},
+		{Name: "a", Domain: "example.com", Path: "/", Secure: true, Partitioned: true},
+		{Name: "a", Domain: "example.com", Path: "/", Partitioned: true, PartitionKey: "https://example.com"},
+	} {
+		if err := jar.AddEntries([]Entry{e}); !errors.Is(err, errInvalidEntry) {
+			t.Fatalf("%+v: unexpected error: %v", e, err)
+		}
+	}
+	if entries := jar.AllCookies(); len(entries) != 0 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestAddEntriesChecksPublicSuffixes(t *testing.T) {
+	jar := newTestJar()
+	if err := jar.AddEntries([]Entry{
+		{Name: "super", Value: "1", Domain: "co.uk", Path: "/"},
+		{Name: "domain", Value: "2", Domain: "bbc.co.uk", Path: "/"},
+		{Name: "ip", Value: "3", Domain: "127.0.0.1", Path: "/"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	hostOnly := make(map[string]bool)
+	for _, e := range jar.AllCookies() {
+		hostOnly[e.Name] = e.HostOnly
+	}
+	if expected := map[string]bool{"super": true, "domain": false, "ip": true}; !reflect.DeepEqual(hostOnly, expected) {
+		t.Fatalf("unexpected host-only flags: %v", hostOnly)
+	}
+	u, _ := url.Parse("https://www.bbc.co.uk/")
+	if got := cookieString(jar, u); got != "domain=2" {
+		t.Fatalf("unexpected cookies: %q", got)
+	}
+}
+
+func TestFileStorage(t *testing.T) {
+	storage := &FileStorage{Path: filepath.Join(t.TempDir(), "cookies.json")}
+	jar, u := newPersistTestJar(t, &Options{Storage: storage}, time.Now())
+	// The jar saves itself on SetCookies, not on setCookies.
+	jar.SetCookies(u, []*http.Cookie{{Name: "last", Value: "5"}})
+	other, err := New(&Options{Storage: storage})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := cookieString(jar, u), cookieString(other, u); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	// A missing file contains no cookies, while a broken one is an error.
+	if _, err := New(&Options{Storage: &FileStorage{Path: storage.Path + ".missing"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(storage.Path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(&Options{Storage: storage}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,135 @@
+//go:build ignore
+
+// This program generates table.go from a local copy of the public suffix
+// list, which you can download from https://publicsuffix.org/list/. Since
+// the copies of Linux distributions are often stale, and the modification
+// time of a download says nothing about the list, the version is required.
+//
+// Usage:
+//
+//	go run gen.go -input public_suffix_list.dat -version 20240101
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// These constants must match those of list.go.
+const (
+	ruleNormal    = 1 << iota // name
+	ruleWildcard              // *.name
+	ruleException             // !name
+
+	privateShift = 3
+)
+
+var (
+	input   = flag.String("input", "public_suffix_list.dat", "the public suffix list to read")
+	output  = flag.String("output", "table.go", "the Go file to write")
+	version = flag.String("version", "", "the version of the list, e.g., its date as YYYYMMDD (required)")
+)
+
+func main() {
+	flag.Parse()
+	if err := generate(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate() error {
+	if *version == "" {
+		return errors.New("missing -version")
+	}
+	f, err := os.Open(*input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rules, err := parse(f)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from %s; DO NOT EDIT.\n\n", filepath.Base(*input))
+	fmt.Fprintf(&buf, "package publicsuffix\n\n")
+	fmt.Fprintf(&buf, "// version describes the list we compiled into table.\n")
+	fmt.Fprintf(&buf, "const version = %q\n\n", "publicsuffix.org's public_suffix_list.dat, version "+*version)
+	fmt.Fprintf(&buf, "// table contains the rules of %d names, one per line, sorted by name.\n", len(names))
+	fmt.Fprintf(&buf, "// Each name is followed by a space and by its flags as two hex digits.\n")
+	fmt.Fprintf(&buf, "const table = `")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s %02x\n", name, rules[name])
+	}
+	fmt.Fprintf(&buf, "`\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*output, src, 0644)
+}
+
+// parse returns the flags of the rules of each name in the list read from f.
+func parse(f *os.File) (map[string]uint8, error) {
+	rules := make(map[string]uint8)
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "// ===BEGIN ICANN DOMAINS==="):
+			section = "icann"
+			continue
+		case strings.HasPrefix(line, "// ===BEGIN PRIVATE DOMAINS==="):
+			section = "private"
+			continue
+		case strings.HasPrefix(line, "// ===END "):
+			section = ""
+			continue
+		case line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+		if section == "" {
+			return nil, fmt.Errorf("%s:%d: rule outside of the sections", *input, lineno)
+		}
+		// A rule is the first token of the line.
+		rule := strings.Fields(line)[0]
+		kind := uint8(ruleNormal)
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			kind, rule = ruleException, rule[1:]
+		case strings.HasPrefix(rule, "*."):
+			kind, rule = ruleWildcard, rule[2:]
+		}
+		if strings.ContainsAny(rule, "*!") {
+			return nil, fmt.Errorf("%s:%d: unsupported rule %q", *input, lineno, line)
+		}
+		name, err := idna.ToASCII(strings.ToLower(rule))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", *input, lineno, err)
+		}
+		if section == "private" {
+			kind <<= privateShift
+		}
+		rules[name] |= kind
+	}
+	return rules, scanner.Err()
+}
@@ -0,0 +1,147 @@
+// Package publicsuffix provides a public suffix list based on data from
+// https://publicsuffix.org/, which tells which domains are registered
+// under a public suffix, and whether the ICANN manages that suffix or it
+// belongs to the private domains section, e.g., "blogspot.com".
+//
+// This package is an ooni/oohttp extension. It is a self-contained
+// alternative to golang.org/x/net/publicsuffix for the cookiejar package.
+// We compile the list into a compact table, sorted by domain name, using
+// gen.go, which reads a copy of public_suffix_list.dat downloaded from
+// https://publicsuffix.org/list/ into this directory. To refresh the table,
+// run go generate setting PSL_VERSION to the date of the list:
+//
+//	PSL_VERSION=20240101 go generate
+package publicsuffix
+
+//go:generate go run gen.go -input public_suffix_list.dat -version $PSL_VERSION -output table.go
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ooni/oohttp/cookiejar"
+)
+
+// List implements the cookiejar.PublicSuffixList interface by calling the
+// PublicSuffix function.
+var List cookiejar.PublicSuffixList = list{}
+
+type list struct{}
+
+func (list) PublicSuffix(domain string) string {
+	ps, _ := PublicSuffix(domain)
+	return ps
+}
+
+func (list) String() string {
+	return version
+}
+
+// The flags of the rules of a name in table. The low bits are for the
+// rules in the ICANN section and the high ones for the private section.
+const (
+	ruleNormal    = 1 << iota // name
+	ruleWildcard              // *.name
+	ruleException             // !name
+
+	privateShift = 3
+)
+
+// PublicSuffix returns the public suffix of the domain using a copy of the
+// publicsuffix.org database compiled into the library.
+//
+// icann is whether the public suffix is managed by the Internet Corporation
+// for Assigned Names and Numbers. If not, the public suffix is either a
+// privately managed domain (and in practice, not a top level domain) or an
+// unmanaged top level domain (and not explicitly mentioned in the
+// publicsuffix.org list). For example, "foo.org" and "foo.co.uk" are ICANN
+// domains, "foo.dyndns.org" and "foo.blogspot.co.uk" are private domains
+// and "cromulent" is an unmanaged top level domain.
+//
+// The domain must be lower case, without leading or trailing dots, and use
+// Punycode for internationalized names, as cookiejar does.
+func PublicSuffix(domain string) (publicSuffix string, icann bool) {
+	suffix := domain
+	for {
+		flags := find(suffix)
+		dot := strings.IndexByte(suffix, '.')
+		if dot < 0 {
+			// The default rule "*" applies to unlisted TLDs.
+			icann, _ := match(flags, ruleNormal)
+			return suffix, icann
+		}
+		parent := suffix[dot+1:]
+		// An exception rule prevails and makes the parent public.
+		if icann, ok := match(flags, ruleException); ok {
+			return parent, icann
+		}
+		if icann, ok := match(flags, ruleNormal); ok {
+			return suffix, icann
+		}
+		if icann, ok := match(find(parent), ruleWildcard); ok {
+			return suffix, icann
+		}
+		suffix = parent
+	}
+}
+
+// match reports whether flags contain a rule of the given kind and, if
+// so, whether such a rule belongs to the ICANN section.
+func match(flags, kind uint8) (icann, ok bool) {
+	switch {
+	case flags&kind != 0:
+		return true, true
+	case flags&(kind<<privateShift) != 0:
+		return false, true
+	}
+	return false, false
+}
+
+// find returns the flags of the rules of name or zero. It uses binary
+// search on table, whose lines are the names followed by a space and
+// by two hex digits containing the flags.
+func find(name string) uint8 {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		// Find the line containing the middle byte.
+		mid := lo + (hi-lo)/2
+		start := lo + strings.LastIndexByte(table[lo:mid], '\n') + 1
+		end := mid + strings.IndexByte(table[mid:], '\n')
+		line := table[start:end]
+		sep := len(line) - 3
+		switch cmp := strings.Compare(line[:sep], name); {
+		case cmp == 0:
+			return unhex(line[sep+1])<<4 | unhex(line[sep+2])
+		case cmp < 0:
+			lo = end + 1
+		default:
+			hi = start
+		}
+	}
+	return 0
+}
+
+func unhex(c byte) uint8 {
+	if c >= 'a' {
+		return c - 'a' + 10
+	}
+	return c - '0'
+}
+
+// EffectiveTLDPlusOne returns the effective top level domain plus one more
+// label. For example, the eTLD+1 for "foo.bar.golang.org" is "golang.org".
+func EffectiveTLDPlusOne(domain string) (string, error) {
+	if strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") || strings.Contains(domain, "..") {
+		return "", fmt.Errorf("publicsuffix: empty label in domain %q", domain)
+	}
+
+	suffix, _ := PublicSuffix(domain)
+	if len(domain) <= len(suffix) {
+		return "", fmt.Errorf("publicsuffix: cannot derive eTLD+1 for domain %q", domain)
+	}
+	i := len(domain) - len(suffix) - 1
+	if domain[i] != '.' {
+		return "", fmt.Errorf("publicsuffix: invalid public suffix %q for domain %q", suffix, domain)
+	}
+	return domain[1+strings.LastIndexByte(domain[:i], '.'):], nil
+}
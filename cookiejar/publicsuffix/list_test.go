@@ -0,0 +1,129 @@
+package publicsuffix
+
+import (
+	"net/url"
+	"testing"
+
+	http "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/cookiejar"
+)
+
+func TestPublicSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		domain string
+		want   string
+		icann  bool
+	}{
+		// Unlisted TLDs use the default rule.
+		{"example", "example", false},
+		{"example.cromulent", "cromulent", false},
+		// Normal rules.
+		{"com", "com", true},
+		{"example.com", "com", true},
+		{"b.example.com", "com", true},
+		{"example.co.uk", "co.uk", true},
+		{"www.ac.jp", "ac.jp", true},
+		// Wildcard rules.
+		{"c.kobe.jp", "c.kobe.jp", true},
+		{"b.c.kobe.jp", "c.kobe.jp", true},
+		{"test.ck", "test.ck", true},
+		// Exception rules.
+		{"www.ck", "ck", true},
+		{"www.www.ck", "ck", true},
+		{"city.kobe.jp", "kobe.jp", true},
+		{"www.city.kobe.jp", "kobe.jp", true},
+		// Private rules.
+		{"blogspot.com", "blogspot.com", false},
+		{"foo.blogspot.com", "blogspot.com", false},
+		{"foo.github.io", "github.io", false},
+		{"foo.dyndns.org", "dyndns.org", false},
+		// Internationalized names use Punycode.
+		{"xn--85x722f.xn--55qx5d.cn", "xn--55qx5d.cn", true},
+		{"shishi.xn--fiqs8s", "xn--fiqs8s", true},
+	} {
+		got, icann := PublicSuffix(tc.domain)
+		if got != tc.want || icann != tc.icann {
+			t.Errorf("%q: got (%q, %v), want (%q, %v)", tc.domain, got, icann, tc.want, tc.icann)
+		}
+	}
+}
+
+func TestEffectiveTLDPlusOne(t *testing.T) {
+	for _, tc := range []struct {
+		domain string
+		want   string
+	}{
+		{"example.com", "example.com"},
+		{"a.b.example.com", "example.com"},
+		{"www.example.co.uk", "example.co.uk"},
+		{"foo.blogspot.com", "foo.blogspot.com"},
+		{"b.c.kobe.jp", "b.c.kobe.jp"},
+		{"www.city.kobe.jp", "city.kobe.jp"},
+		{"com", ""},
+		{"co.uk", ""},
+		{".example.com", ""},
+		{"example..com", ""},
+		{"example.com.", ""},
+	} {
+		got, err := EffectiveTLDPlusOne(tc.domain)
+		if got != tc.want || (err != nil) != (tc.want == "") {
+			t.Errorf("%q: got (%q, %v), want %q", tc.domain, got, err, tc.want)
+		}
+	}
+}
+
+func TestFindAllNames(t *testing.T) {
+	// Every name of the table must be found, wherever the binary search
+	// lands in the middle of its line.
+	count := 0
+	for start := 0; start < len(table); count++ {
+		end := start
+		for table[end] != '\n' {
+			end++
+		}
+		line := table[start:end]
+		name := line[:len(line)-3]
+		if got, want := find(name), unhex(line[len(line)-2])<<4|unhex(line[len(line)-1]); got != want || got == 0 {
+			t.Fatalf("%q: got %x, want %x", name, got, want)
+		}
+		if got := find(name + "-missing"); got != 0 {
+			t.Fatalf("%q: got %x, want 0", name+"-missing", got)
+		}
+		start = end + 1
+	}
+	if count == 0 {
+		t.Fatal("empty table")
+	}
+}
+
+func TestList(t *testing.T) {
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: List})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The jar rejects cookies for public suffixes, including private ones.
+	for _, tc := range []struct {
+		host, domain string
+		accepted     bool
+	}{
+		{"www.example.co.uk", "example.co.uk", true},
+		{"www.example.co.uk", "co.uk", false},
+		{"foo.blogspot.com", "blogspot.com", false},
+	} {
+		u := mustParseURL(t, "https://"+tc.host+"/")
+		jar.SetCookies(u, []*http.Cookie{{Name: "a", Value: "1", Domain: tc.domain}})
+		other := mustParseURL(t, "https://other."+tc.domain+"/")
+		if got := len(jar.Cookies(other)) == 1; got != tc.accepted {
+			t.Errorf("%s: got %v, want %v", tc.domain, got, tc.accepted)
+		}
+	}
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
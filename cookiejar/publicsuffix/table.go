@@ -0,0 +1,10143 @@
+// Code generated by gen.go from public_suffix_list.dat; DO NOT EDIT.
+
+package publicsuffix
+
+// version describes the list we compiled into table.
+const version = "publicsuffix.org's public_suffix_list.dat, version 20260206"
+
+// table contains the rules of 10133 names, one per line, sorted by name.
+// Each name is followed by a space and by its flags as two hex digits.
+const table = `0.bg 01
+001.test.code-builder-stg.platform.salesforce.com 10
+0am.jp 08
+0e.vc 10
+0emm.com 10
+0g0.jp 08
+0j0.jp 08
+0t0.jp 08
+1.azurestaticapps.net 08
+1.bg 01
+123hjemmeside.dk 08
+123hjemmeside.no 08
+123homepage.it 08
+123kotisivu.fi 08
+123minsida.se 08
+123miweb.es 08
+123paginaweb.pt 08
+123siteweb.fr 08
+123webseite.at 08
+123webseite.de 08
+123website.be 08
+123website.ch 08
+123website.lu 08
+123website.nl 08
+12hp.at 08
+12hp.ch 08
+12hp.de 08
+1337.pictures 08
+16-b.it 08
+180r.com 08
+1cooldns.com 08
+1kapp.com 08
+2-d.jp 08
+2.azurestaticapps.net 08
+2.bg 01
+2000.hu 01
+2038.io 08
+2ix.at 08
+2ix.ch 08
+2ix.de 08
+3.azurestaticapps.net 08
+3.bg 01
+32-b.it 08
+3utilities.com 08
+4.at 08
+4.azurestaticapps.net 08
+4.bg 01
+4lima.at 08
+4lima.ch 08
+4lima.de 08
+4u.com 08
+5.azurestaticapps.net 08
+5.bg 01
+5g.in 01
+6.azurestaticapps.net 08
+6.bg 01
+611.to 08
+64-b.it 08
+6g.in 01
+7.azurestaticapps.net 08
+7.bg 01
+8.bg 01
+9.bg 01
+9guacu.br 01
+a.bg 01
+a.prod.fastly.net 08
+a.se 01
+a.ssl.fastly.net 08
+a2hosted.com 08
+aa.crm.dev 10
+aa.no 01
+aaa 01
+aaa.pro 01
+aarborte.no 01
+aarp 01
+ab.ca 01
+ab.crm.dev 10
+abashiri.hokkaido.jp 01
+abb 01
+abbott 01
+abbvie 01
+abc 01
+abc.br 01
+abeno.osaka.jp 01
+abg.ec 01
+abiko.chiba.jp 01
+abira.hokkaido.jp 01
+abkhazia.su 08
+able 01
+abo.pa 01
+abogado 01
+abr.it 01
+abrdns.com 08
+abruzzo.it 01
+abu.yamaguchi.jp 01
+abudhabi 01
+ac 01
+ac.ae 01
+ac.at 01
+ac.bd 01
+ac.be 01
+ac.bw 01
+ac.ci 01
+ac.cn 01
+ac.cr 01
+ac.crm.dev 10
+ac.cy 01
+ac.eg 01
+ac.fj 01
+ac.gn 01
+ac.gov.br 01
+ac.id 01
+ac.il 01
+ac.im 01
+ac.in 01
+ac.ir 01
+ac.jp 01
+ac.ke 01
+ac.kr 01
+ac.leg.br 08
+ac.lk 01
+ac.ls 01
+ac.ma 01
+ac.me 01
+ac.ml 01
+ac.mu 01
+ac.mw 01
+ac.mz 01
+ac.ni 01
+ac.nz 01
+ac.pa 01
+ac.pk 01
+ac.pr 01
+ac.rs 01
+ac.ru 08
+ac.rw 01
+ac.se 01
+ac.sz 01
+ac.th 01
+ac.tj 01
+ac.tz 01
+ac.ug 01
+ac.uk 01
+ac.vn 01
+ac.za 01
+ac.zm 01
+ac.zw 01
+aca.pro 01
+academia.bo 01
+academy 01
+accenture 01
+accesscam.org 08
+accident-investigation.aero 01
+accident-prevention.aero 01
+accountant 01
+accountants 01
+acct.pro 01
+achi.nagano.jp 01
+aco 01
+act.au 01
+act.edu.au 01
+activetrail.biz 08
+actor 01
+ad 01
+ad.crm.dev 10
+ad.jp 01
+adachi.tokyo.jp 01
+adaptable.app 08
+adimo.co.uk 08
+adm.br 01
+adm.ec 01
+adobeaemcloud.com 08
+adobeaemcloud.net 08
+adobeio-static.net 08
+adobeioruntime.net 08
+ads 01
+adult 01
+adult.ht 01
+adv.br 01
+adv.mz 01
+advisor.ws 10
+adygeya.ru 08
+adygeya.su 08
+ae 01
+ae.crm.dev 10
+ae.kg 08
+ae.org 08
+aeg 01
+aejrie.no 01
+aem.live 08
+aem.network 08
+aem.page 08
+aem.reviews 08
+aero 01
+aero.mv 01
+aerobatic.aero 01
+aeroclub.aero 01
+aerodrome.aero 01
+aeroport.fr 08
+aetna 01
+af 01
+af-south-1.airflow.amazonaws.com 10
+af-south-1.elasticbeanstalk.com 08
+af-south-1.rds.amazonaws.com 10
+af.crm.dev 10
+affinitylottery.org.uk 08
+afjord.no 01
+afl 01
+africa 01
+africa.bj 01
+africa.com 08
+ag 01
+ag.it 01
+aga.niigata.jp 01
+agakhan 01
+agano.niigata.jp 01
+agdenes.no 01
+agematsu.nagano.jp 01
+agency 01
+agents.aero 01
+agr.br 01
+agrar.hu 01
+agri.jo 01
+agric.za 01
+agrigento.it 01
+agro.bj 01
+agro.bo 01
+agro.pl 01
+agron.ec 01
+aguni.okinawa.jp 01
+ah.cn 01
+ah.no 01
+ai 01
+ai.bd 01
+ai.in 01
+ai.jo 01
+ai.kr 01
+ai.vn 01
+aibetsu.hokkaido.jp 01
+aichi.jp 01
+aid.pl 01
+aig 01
+aikawa.kanagawa.jp 01
+ainan.ehime.jp 01
+aioi.hyogo.jp 01
+aip.ee 01
+air-surveillance.aero 01
+air-traffic-control.aero 01
+airbus 01
+aircraft.aero 01
+airflow.af-south-1.on.aws 10
+airflow.ap-east-1.on.aws 10
+airflow.ap-northeast-1.on.aws 10
+airflow.ap-northeast-2.on.aws 10
+airflow.ap-northeast-3.on.aws 10
+airflow.ap-south-1.on.aws 10
+airflow.ap-south-2.on.aws 10
+airflow.ap-southeast-1.on.aws 10
+airflow.ap-southeast-2.on.aws 10
+airflow.ap-southeast-3.on.aws 10
+airflow.ap-southeast-4.on.aws 10
+airflow.ap-southeast-5.on.aws 10
+airflow.ca-central-1.on.aws 10
+airflow.ca-west-1.on.aws 10
+airflow.cn-north-1.on.amazonwebservices.com.cn 10
+airflow.cn-northwest-1.on.amazonwebservices.com.cn 10
+airflow.eu-central-1.on.aws 10
+airflow.eu-central-2.on.aws 10
+airflow.eu-north-1.on.aws 10
+airflow.eu-south-1.on.aws 10
+airflow.eu-south-2.on.aws 10
+airflow.eu-west-1.on.aws 10
+airflow.eu-west-2.on.aws 10
+airflow.eu-west-3.on.aws 10
+airflow.il-central-1.on.aws 10
+airflow.me-central-1.on.aws 10
+airflow.me-south-1.on.aws 10
+airflow.sa-east-1.on.aws 10
+airflow.us-east-1.on.aws 10
+airflow.us-east-2.on.aws 10
+airflow.us-west-1.on.aws 10
+airflow.us-west-2.on.aws 10
+airforce 01
+airline.aero 01
+airport.aero 01
+airtel 01
+airtraffic.aero 01
+aisai.aichi.jp 01
+aisho.shiga.jp 01
+aiven.app 08
+aivencloud.com 08
+aizubange.fukushima.jp 01
+aizumi.tokushima.jp 01
+aizumisato.fukushima.jp 01
+aizuwakamatsu.fukushima.jp 01
+aju.br 01
+ak.us 01
+akabira.hokkaido.jp 01
+akadns.net 08
+akagi.shimane.jp 01
+akaiwa.okayama.jp 01
+akamai-staging.net 08
+akamai.net 08
+akamaiedge-staging.net 08
+akamaiedge.net 08
+akamaihd-staging.net 08
+akamaihd.net 08
+akamaiorigin-staging.net 08
+akamaiorigin.net 08
+akamaized-staging.net 08
+akamaized.net 08
+akashi.hyogo.jp 01
+akdn 01
+aki.kochi.jp 01
+akiruno.tokyo.jp 01
+akishima.tokyo.jp 01
+akita.akita.jp 01
+akita.jp 01
+akkeshi.hokkaido.jp 01
+aknoluokta.no 01
+ako.hyogo.jp 01
+akrehamn.no 01
+aktyubinsk.su 08
+akune.kagoshima.jp 01
+al 01
+al.eu.org 08
+al.gov.br 01
+al.it 01
+al.leg.br 08
+al.no 01
+al.us 01
+alaheadju.no 01
+aland.fi 01
+alces.network 10
+alessandria.it 01
+alesund.no 01
+algard.no 01
+aliases121.com 08
+alibaba 01
+alibabacloudcs.com 08
+alipay 01
+allfinanz 01
+allstate 01
+ally 01
+alp1.ae.flow.ch 08
+alpha-myqnapcloud.com 08
+alsace 01
+alstahaug.no 01
+alstom 01
+alt.na 01
+alt.za 01
+alta.no 01
+altervista.org 08
+alto-adige.it 01
+altoadige.it 01
+alvdal.no 01
+alwaysdata.net 08
+am 01
+am.br 01
+am.gov.br 01
+am.in 01
+am.leg.br 08
+ama.aichi.jp 01
+ama.shimane.jp 01
+amagasaki.hyogo.jp 01
+amakusa.kumamoto.jp 01
+amami.kagoshima.jp 01
+amazon 01
+ambulance.aero 01
+americanexpress 01
+americanfamily 01
+amex 01
+amfam 01
+ami.ibaraki.jp 01
+amica 01
+amli.no 01
+amot.no 01
+amplifyapp.com 08
+amsterdam 01
+an.it 01
+analytics 01
+analytics-gateway.ap-northeast-1.amazonaws.com 08
+analytics-gateway.ap-northeast-2.amazonaws.com 08
+analytics-gateway.ap-south-1.amazonaws.com 08
+analytics-gateway.ap-southeast-1.amazonaws.com 08
+analytics-gateway.ap-southeast-2.amazonaws.com 08
+analytics-gateway.eu-central-1.amazonaws.com 08
+analytics-gateway.eu-west-1.amazonaws.com 08
+analytics-gateway.us-east-1.amazonaws.com 08
+analytics-gateway.us-east-2.amazonaws.com 08
+analytics-gateway.us-west-2.amazonaws.com 08
+anamizu.ishikawa.jp 01
+anan.nagano.jp 01
+anan.tokushima.jp 01
+anani.br 01
+ancona.it 01
+andasuolo.no 01
+andebu.no 01
+ando.nara.jp 01
+andoy.no 01
+andria-barletta-trani.it 01
+andria-trani-barletta.it 01
+andriabarlettatrani.it 01
+andriatranibarletta.it 01
+android 01
+angiang.vn 01
+angry.jp 08
+anjo.aichi.jp 01
+ann-arbor.mi.us 01
+annaka.gunma.jp 01
+anpachi.gifu.jp 01
+anquan 01
+antagonist.cloud 08
+anz 01
+ao 01
+ao.it 01
+aogaki.hyogo.jp 01
+aogashima.tokyo.jp 01
+aoki.nagano.jp 01
+aol 01
+aomori.aomori.jp 01
+aomori.jp 01
+aosta-valley.it 01
+aosta.it 01
+aostavalley.it 01
+aoste.it 01
+ap-east-1.airflow.amazonaws.com 10
+ap-east-1.elasticbeanstalk.com 08
+ap-east-1.rds.amazonaws.com 10
+ap-east-2.rds.amazonaws.com 10
+ap-north-1.r.cloud.int.apple 10
+ap-northeast-1.airflow.amazonaws.com 10
+ap-northeast-1.elasticbeanstalk.com 08
+ap-northeast-1.rds.amazonaws.com 10
+ap-northeast-2.airflow.amazonaws.com 10
+ap-northeast-2.elasticbeanstalk.com 08
+ap-northeast-2.rds.amazonaws.com 10
+ap-northeast-3.airflow.amazonaws.com 10
+ap-northeast-3.elasticbeanstalk.com 08
+ap-northeast-3.rds.amazonaws.com 10
+ap-south-1.airflow.amazonaws.com 10
+ap-south-1.elasticbeanstalk.com 08
+ap-south-1.r.cloud.int.apple 10
+ap-south-1.rds.amazonaws.com 10
+ap-south-2.airflow.amazonaws.com 10
+ap-south-2.r.cloud.int.apple 10
+ap-south-2.rds.amazonaws.com 10
+ap-southeast-1.airflow.amazonaws.com 10
+ap-southeast-1.elasticbeanstalk.com 08
+ap-southeast-1.rds.amazonaws.com 10
+ap-southeast-2.airflow.amazonaws.com 10
+ap-southeast-2.elasticbeanstalk.com 08
+ap-southeast-2.rds.amazonaws.com 10
+ap-southeast-3.airflow.amazonaws.com 10
+ap-southeast-3.elasticbeanstalk.com 08
+ap-southeast-3.rds.amazonaws.com 10
+ap-southeast-4.airflow.amazonaws.com 10
+ap-southeast-4.rds.amazonaws.com 10
+ap-southeast-5.airflow.amazonaws.com 10
+ap-southeast-5.elasticbeanstalk.com 08
+ap-southeast-5.rds.amazonaws.com 10
+ap-southeast-6.rds.amazonaws.com 10
+ap-southeast-7.airflow.amazonaws.com 10
+ap-southeast-7.elasticbeanstalk.com 08
+ap-southeast-7.rds.amazonaws.com 10
+ap.gov.br 01
+ap.gov.pl 01
+ap.it 01
+ap.leg.br 08
+ap.ngrok.io 08
+aparecida.br 01
+apartments 01
+api.br 01
+api.gov.uk 08
+api.lp.dev 08
+api.stdlib.com 08
+apigee.io 08
+app 01
+app-ionos.space 08
+app.br 01
+app.os.fedoraproject.org 08
+app.os.stg.fedoraproject.org 08
+app.render.com 08
+appchizi.com 08
+appengine.flow.ch 08
+apple 01
+applinzi.com 08
+apps-1and1.com 08
+apps-1and1.net 08
+apps.fbsbx.com 08
+apps.lair.io 08
+appspacehosted.com 08
+appspaceusercontent.com 08
+appspot.com 08
+appudo.net 08
+appwrite.global 08
+appwrite.network 08
+appwrite.run 10
+aq 01
+aq.it 01
+aquarelle 01
+aquila.it 01
+ar 01
+ar.it 01
+ar.us 01
+arab 01
+arai.shizuoka.jp 01
+arakawa.saitama.jp 01
+arakawa.tokyo.jp 01
+aramco 01
+arao.kumamoto.jp 01
+archer.replit.dev 08
+archi 01
+architectes.bj 01
+ardal.no 01
+aremark.no 01
+arendal.no 01
+arezzo.it 01
+ariake.saga.jp 01
+arida.wakayama.jp 01
+aridagawa.wakayama.jp 01
+arita.saga.jp 01
+arkhangelsk.su 08
+armenia.su 08
+army 01
+arna.no 01
+arpa 01
+arq.br 01
+arqt.ec 01
+art 01
+art.br 01
+art.do 01
+art.dz 01
+art.ec 01
+art.ht 01
+art.ml 01
+art.pl 08
+art.sn 01
+arte 01
+arte.bo 01
+arts.nf 01
+arts.ro 01
+arts.ve 01
+arvanedge.ir 08
+arvo.network 08
+as 01
+as.sh.cn 08
+as.us 01
+asago.hyogo.jp 01
+asahi.chiba.jp 01
+asahi.ibaraki.jp 01
+asahi.mie.jp 01
+asahi.nagano.jp 01
+asahi.toyama.jp 01
+asahi.yamagata.jp 01
+asahikawa.hokkaido.jp 01
+asaka.saitama.jp 01
+asakawa.fukushima.jp 01
+asakuchi.okayama.jp 01
+asaminami.hiroshima.jp 01
+ascoli-piceno.it 01
+ascolipiceno.it 01
+asda 01
+aseral.no 01
+ashgabad.su 08
+ashibetsu.hokkaido.jp 01
+ashikaga.tochigi.jp 01
+ashiya.fukuoka.jp 01
+ashiya.hyogo.jp 01
+ashoro.hokkaido.jp 01
+asia 01
+asker.no 01
+askim.no 01
+askoy.no 01
+askvoll.no 01
+asn.au 01
+asn.lv 01
+asnes.no 01
+aso.kumamoto.jp 01
+ass.km 01
+assabu.hokkaido.jp 01
+assessments.cx 08
+assn.lk 01
+asso.ci 01
+asso.dz 01
+asso.eu.org 08
+asso.fr 01
+asso.gp 01
+asso.ht 01
+asso.km 01
+asso.mc 01
+asso.ml 01
+asso.nc 01
+asso.re 01
+associates 01
+association.aero 01
+assur.bj 01
+asti.it 01
+asuke.aichi.jp 01
+at 01
+at-band-camp.net 08
+at.emf.camp 08
+at.eu.org 08
+at.it 01
+at.playit.plus 10
+at.ply.gg 10
+atami.shizuoka.jp 01
+ath.cx 08
+athleta 01
+atl.jelastic.vps-host.net 08
+atm.pl 01
+atmeta.com 08
+ato.br 01
+atsugi.kanagawa.jp 01
+atsuma.hokkaido.jp 01
+attorney 01
+au 01
+au.eu.org 08
+au.ngrok.io 08
+auction 01
+audi 01
+audible 01
+audio 01
+audnedaln.no 01
+augustow.pl 01
+auiusercontent.com 10
+aukra.no 01
+aure.no 01
+aurland.no 01
+aurskog-holand.no 01
+aus.basketball 08
+auspost 01
+austevoll.no 01
+austrheim.no 01
+auth-fips.us-east-1.amazoncognito.com 08
+auth-fips.us-east-2.amazoncognito.com 08
+auth-fips.us-gov-east-1.amazoncognito.com 08
+auth-fips.us-gov-west-1.amazoncognito.com 08
+auth-fips.us-west-1.amazoncognito.com 08
+auth-fips.us-west-2.amazoncognito.com 08
+auth.af-south-1.amazoncognito.com 08
+auth.ap-east-1.amazoncognito.com 08
+auth.ap-northeast-1.amazoncognito.com 08
+auth.ap-northeast-2.amazoncognito.com 08
+auth.ap-northeast-3.amazoncognito.com 08
+auth.ap-south-1.amazoncognito.com 08
+auth.ap-south-2.amazoncognito.com 08
+auth.ap-southeast-1.amazoncognito.com 08
+auth.ap-southeast-2.amazoncognito.com 08
+auth.ap-southeast-3.amazoncognito.com 08
+auth.ap-southeast-4.amazoncognito.com 08
+auth.ap-southeast-5.amazoncognito.com 08
+auth.ap-southeast-7.amazoncognito.com 08
+auth.ca-central-1.amazoncognito.com 08
+auth.ca-west-1.amazoncognito.com 08
+auth.cognito-idp.eusc-de-east-1.on.amazonwebservices.eu 08
+auth.eu-central-1.amazoncognito.com 08
+auth.eu-central-2.amazoncognito.com 08
+auth.eu-north-1.amazoncognito.com 08
+auth.eu-south-1.amazoncognito.com 08
+auth.eu-south-2.amazoncognito.com 08
+auth.eu-west-1.amazoncognito.com 08
+auth.eu-west-2.amazoncognito.com 08
+auth.eu-west-3.amazoncognito.com 08
+auth.il-central-1.amazoncognito.com 08
+auth.me-central-1.amazoncognito.com 08
+auth.me-south-1.amazoncognito.com 08
+auth.mx-central-1.amazoncognito.com 08
+auth.sa-east-1.amazoncognito.com 08
+auth.us-east-1.amazoncognito.com 08
+auth.us-east-2.amazoncognito.com 08
+auth.us-west-1.amazoncognito.com 08
+auth.us-west-2.amazoncognito.com 08
+authgear-staging.com 08
+authgearapps.com 08
+author 01
+author.aero 01
+auto 01
+auto.pl 01
+autos 01
+av.it 01
+av.tr 01
+avellino.it 01
+averoy.no 01
+avocat.fr 08
+avocat.pro 01
+avocats.bj 01
+avoues.fr 01
+aw 01
+awaji.hyogo.jp 01
+awdev.ca 10
+aws 01
+awsapprunner.com 10
+awsapps.com 08
+awsglobalaccelerator.com 08
+ax 01
+axa 01
+aya.miyazaki.jp 01
+ayabe.kyoto.jp 01
+ayagawa.kagawa.jp 01
+ayase.kanagawa.jp 01
+az 01
+az.us 01
+azerbaijan.su 08
+azimuth.network 08
+azumino.nagano.jp 01
+azure 01
+azure-api.net 08
+azure-api.us 08
+azure-mobile.net 08
+azurecontainer.io 10
+azureedge.net 08
+azurefd.net 08
+azurestaticapps.net 08
+azurewebsites.net 08
+azurewebsites.us 08
+b-data.io 08
+b.bg 01
+b.br 01
+b.se 01
+b.ssl.fastly.net 08
+ba 01
+ba.gov.br 01
+ba.it 01
+ba.leg.br 08
+babia-gora.pl 01
+baby 01
+babyblue.jp 08
+babymilk.jp 08
+bacgiang.vn 01
+backan.vn 01
+backdrop.jp 08
+baclieu.vn 01
+bacninh.vn 01
+badaddja.no 01
+bahcavuotna.no 01
+bahccavuotna.no 01
+baidar.no 01
+baidu 01
+bajddar.no 01
+balashov.su 08
+balat.no 01
+balena-devices.com 08
+balestrand.no 01
+ballangen.no 01
+ballooning.aero 01
+balsan-sudtirol.it 01
+balsan-suedtirol.it 01
+balsan.it 01
+balsfjord.no 01
+bambina.jp 08
+bamble.no 01
+banamex 01
+band 01
+bandai.fukushima.jp 01
+bando.ibaraki.jp 01
+bank 01
+bank.in 01
+bar 01
+bar.ec 01
+bar.pro 01
+barcelona 01
+barclaycard 01
+barclays 01
+bardu.no 01
+barefoot 01
+bargains 01
+bari.it 01
+baria-vungtau.vn 01
+barletta-trani-andria.it 01
+barlettatraniandria.it 01
+barrel-of-knowledge.info 08
+barrell-of-knowledge.info 08
+barsy.bg 08
+barsy.ca 08
+barsy.club 08
+barsy.co.uk 08
+barsy.de 08
+barsy.dev 08
+barsy.eu 08
+barsy.gr 08
+barsy.in 08
+barsy.info 08
+barsy.io 08
+barsy.me 08
+barsy.menu 08
+barsy.mobi 08
+barsy.net 08
+barsy.online 08
+barsy.org 08
+barsy.pro 08
+barsy.pub 08
+barsy.ro 08
+barsy.rs 08
+barsy.shop 08
+barsy.site 08
+barsy.store 08
+barsy.support 08
+barsy.uk 08
+barsycenter.com 08
+barsyonline.co.uk 08
+barsyonline.com 08
+barsyonline.menu 08
+barsyonline.shop 08
+barueri.br 01
+barum.no 01
+bas.it 01
+base.ec 08
+base.shop 08
+base44-sandbox.com 08
+base44.app 08
+baseball 01
+bashkiria.ru 08
+bashkiria.su 08
+basicserver.io 08
+basilicata.it 01
+basketball 01
+bato.tochigi.jp 01
+batsfjord.no 01
+bauhaus 01
+bayern 01
+bb 01
+bbc 01
+bbs.tr 01
+bbt 01
+bbva 01
+bc.ca 01
+bcg 01
+bcn 01
+bd 01
+bd.se 01
+be 01
+be.eu.org 08
+beagleboard.io 08
+bearalvahki.no 01
+bearblog.dev 08
+beardu.no 01
+beats 01
+beauty 01
+bedzin.pl 01
+beep.pl 08
+beer 01
+beget.app 10
+beiarn.no 01
+bel.tr 01
+belem.br 01
+belluno.it 01
+benevento.it 01
+bentre.vn 01
+beppu.oita.jp 01
+berg.no 01
+bergamo.it 01
+bergen.no 01
+berlevag.no 01
+berlin 01
+beskidy.pl 01
+best 01
+bestbuy 01
+bet 01
+bet.ar 01
+bet.br 01
+beta.wmcloud.org 08
+better-than.tv 08
+bf 01
+bg 01
+bg.eu.org 08
+bg.it 01
+bh 01
+bharti 01
+bhz.br 01
+bi 01
+bi.it 01
+bialowieza.pl 01
+bialystok.pl 01
+bib.br 01
+bib.ve 01
+bibai.hokkaido.jp 01
+bible 01
+bid 01
+biei.hokkaido.jp 01
+bielawa.pl 01
+biella.it 01
+bielsko.pl 08
+bieszczady.pl 01
+bievat.no 01
+bifuka.hokkaido.jp 01
+bihar.in 01
+bihoro.hokkaido.jp 01
+bike 01
+bindal.no 01
+bing 01
+bingo 01
+binhdinh.vn 01
+binhduong.vn 01
+binhphuoc.vn 01
+binhthuan.vn 01
+bio 01
+bio.br 01
+bir.ru 08
+biratori.hokkaido.jp 01
+birkenes.no 01
+bitbucket.io 08
+bitter.jp 08
+biz 01
+biz.at 08
+biz.az 01
+biz.bb 01
+biz.cy 01
+biz.dk 08
+biz.et 01
+biz.fj 01
+biz.gh 01
+biz.id 01
+biz.in 01
+biz.ki 01
+biz.ls 01
+biz.mv 01
+biz.mw 01
+biz.my 01
+biz.ng 08
+biz.ni 01
+biz.nr 01
+biz.pk 01
+biz.pl 01
+biz.pr 01
+biz.ss 01
+biz.tj 01
+biz.tr 01
+biz.tt 01
+biz.ua 08
+biz.vn 01
+biz.wf 08
+biz.zm 01
+bizen.okayama.jp 01
+bj 01
+bj.cn 01
+bjerkreim.no 01
+bjugn.no 01
+bl.it 01
+black 01
+blackbaudcdn.net 08
+blackfriday 01
+blob.core.usgovcloudapi.net 08
+blob.core.windows.net 08
+blockbuster 01
+blog 01
+blog.bo 01
+blog.br 01
+blogdns.com 08
+blogdns.net 08
+blogdns.org 08
+blogsite.org 08
+blogspot.com 08
+blogsyte.com 08
+bloomberg 01
+blue 01
+bluebite.io 08
+blush.jp 08
+bm 01
+bmd.br 01
+bmoattachments.org 08
+bms 01
+bmw 01
+bn 01
+bn.it 01
+bnpparibas 01
+bnr.la 08
+bo 01
+bo.it 01
+bo.nordland.no 01
+bo.telemark.no 01
+boats 01
+boavista.br 01
+bodo.no 01
+boehringer 01
+bofa 01
+bokn.no 01
+boldlygoingnowhere.org 08
+boleslawiec.pl 01
+bolivia.bo 01
+bologna.it 01
+bolt.host 08
+bolt.hu 01
+bolzano-altoadige.it 01
+bolzano.it 01
+bom 01
+bomlo.no 01
+bona.jp 08
+bond 01
+bones.replit.dev 08
+boo 01
+boo.jp 08
+book 01
+booking 01
+bookonline.app 08
+boomla.net 08
+bosch 01
+bostik 01
+boston 01
+bot 01
+botda.sh 08
+botdash.app 08
+botdash.dev 08
+botdash.gg 08
+botdash.net 08
+botdash.xyz 08
+bounceme.net 08
+boutique 01
+boutir.com 08
+box 01
+box.ca 08
+boxfuse.io 08
+boy.jp 08
+boyfriend.jp 08
+bozen-sudtirol.it 01
+bozen-suedtirol.it 01
+bozen.it 01
+bplaced.com 08
+bplaced.de 08
+bplaced.net 08
+br 01
+br.com 08
+br.it 01
+bradesco 01
+brand.se 01
+brasilia.me 08
+brave.app 08
+brave.dev 08
+brave.io 08
+bremanger.no 01
+brescia.it 01
+bridgestone 01
+brindisi.it 01
+broadway 01
+broke-it.net 08
+broker 01
+broker.aero 01
+bronnoy.no 01
+bronnoysund.no 01
+brother 01
+browsersafetymark.io 08
+brumunddal.no 01
+brussels 01
+bryansk.su 08
+bryne.no 01
+bs 01
+bs.it 01
+bsb.br 01
+bss.design 08
+bt 01
+bt.it 01
+bu.no 01
+bubbleapps.io 08
+budejju.no 01
+build 01
+build.run 10
+builder.code.com 10
+builders 01
+builtwithdark.com 08
+bukhara.su 08
+bulsan-sudtirol.it 01
+bulsan-suedtirol.it 01
+bulsan.it 01
+bumbleshrimp.com 08
+bungoono.oita.jp 01
+bungotakada.oita.jp 01
+bunkyo.tokyo.jp 01
+busan.kr 01
+business 01
+business.in 01
+but.jp 08
+buy 01
+buyshop.jp 08
+buyshouses.net 08
+buzen.fukuoka.jp 01
+buzz 01
+bv 01
+bw 01
+bwcloud-os-instance.de 10
+by 01
+bydgoszcz.pl 01
+byen.site 08
+bygland.no 01
+bykle.no 01
+bytom.pl 01
+bz 01
+bz.it 01
+bzh 01
+c.bg 01
+c.cdn77.org 08
+c.se 01
+c.ts.net 10
+c01.kr 08
+c66.me 08
+ca 01
+ca-central-1.airflow.amazonaws.com 10
+ca-central-1.elasticbeanstalk.com 08
+ca-central-1.rds.amazonaws.com 10
+ca-west-1.airflow.amazonaws.com 10
+ca-west-1.rds.amazonaws.com 10
+ca.eu.org 08
+ca.in 01
+ca.it 01
+ca.reclaim.cloud 08
+ca.us 01
+caa.aero 01
+cab 01
+cable-modem.org 08
+cafe 01
+caffeine.site 08
+caffeine.xyz 08
+cafjs.com 08
+cagliari.it 01
+cahcesuolo.no 01
+cal 01
+cal.it 01
+calabria.it 01
+calculators.cx 08
+call 01
+caltanissetta.it 01
+calvinklein 01
+cam 01
+cam.it 01
+camau.vn 01
+camdvr.org 08
+camera 01
+camp 01
+campaign.gov.uk 08
+campania.it 01
+campidano-medio.it 01
+campidanomedio.it 01
+campinagrande.br 01
+campinas.br 01
+campobasso.it 01
+can.re 08
+canary.replit.dev 08
+candypop.jp 08
+canon 01
+cantho.vn 01
+canva-apps.cn 08
+canva-apps.com 08
+canva-hosted-embed.com 08
+canva.run 08
+canvacode.com 08
+caobang.vn 01
+capetown 01
+capital 01
+capitalone 01
+capoo.jp 08
+car 01
+caracal.mythic-beasts.com 08
+caravan 01
+carbonia-iglesias.it 01
+carboniaiglesias.it 01
+cards 01
+care 01
+career 01
+careers 01
+cargo.aero 01
+carrara-massa.it 01
+carraramassa.it 01
+carrd.co 08
+cars 01
+casa 01
+casacam.net 08
+case 01
+caserta.it 01
+cash 01
+casino 01
+casino.hu 01
+cat 01
+catania.it 01
+catanzaro.it 01
+catering 01
+catering.aero 01
+catfood.jp 08
+catholic 01
+catholic.edu.au 01
+caxias.br 01
+cb.it 01
+cba 01
+cbg.ru 08
+cbn 01
+cbre 01
+cc 01
+cc.ak.us 01
+cc.al.us 01
+cc.ar.us 01
+cc.as.us 01
+cc.az.us 01
+cc.ca.us 01
+cc.cd 08
+cc.co.us 01
+cc.ct.us 01
+cc.dc.us 01
+cc.de.us 01
+cc.fl.us 01
+cc.ga.us 01
+cc.gu.us 01
+cc.hi.us 01
+cc.ia.us 01
+cc.id.us 01
+cc.il.us 01
+cc.in.us 01
+cc.ks.us 01
+cc.ky.us 01
+cc.la.us 01
+cc.ma.us 01
+cc.md.us 01
+cc.me.us 01
+cc.mi.us 01
+cc.mn.us 01
+cc.mo.us 01
+cc.ms.us 01
+cc.mt.us 01
+cc.nc.us 01
+cc.nd.us 01
+cc.ne.us 01
+cc.nh.us 01
+cc.nj.us 01
+cc.nm.us 01
+cc.nv.us 01
+cc.ny.us 01
+cc.oh.us 01
+cc.ok.us 01
+cc.or.us 01
+cc.pa.us 01
+cc.pr.us 01
+cc.ri.us 01
+cc.sc.us 01
+cc.sd.us 01
+cc.tn.us 01
+cc.tx.us 01
+cc.ua 08
+cc.ut.us 01
+cc.va.us 01
+cc.vi.us 01
+cc.vt.us 01
+cc.wa.us 01
+cc.wi.us 01
+cc.wv.us 01
+cc.wy.us 01
+cci.fr 01
+ccwu.cc 08
+cd 01
+cd.eu.org 08
+cdn-edges.net 08
+cdn.bubble.io 08
+cdn.cloudflare.net 08
+cdn.cloudflareanycast.net 08
+cdn.cloudflarecn.net 08
+cdn.cloudflareglobal.net 08
+cdn.prod.atlassian-dev.net 08
+cdn77-ssl.net 08
+cdn77-storage.com 08
+ce.gov.br 01
+ce.it 01
+ce.leg.br 08
+cechire.com 08
+center 01
+centralus.azurestaticapps.net 08
+ceo 01
+cern 01
+certification.aero 01
+cesena-forli.it 01
+cesenaforli.it 01
+cf 01
+cf-ipfs.com 08
+cfa 01
+cfd 01
+cfolks.pl 08
+cg 01
+ch 01
+ch.eu.org 08
+ch.it 01
+ch.trendhosting.cloud 08
+chambagri.fr 08
+championship.aero 01
+chanel 01
+channel 01
+channelsdvr.net 08
+charity 01
+charter.aero 01
+chase 01
+chat 01
+cheap 01
+cheap.jp 08
+chef.ec 01
+cherkassy.ua 01
+cherkasy.ua 01
+chernigov.ua 01
+chernihiv.ua 01
+chernivtsi.ua 01
+chernovtsy.ua 01
+chiba.jp 01
+chicappa.jp 08
+chichibu.saitama.jp 01
+chieti.it 01
+chigasaki.kanagawa.jp 01
+chihayaakasaka.osaka.jp 01
+chijiwa.nagasaki.jp 01
+chikugo.fukuoka.jp 01
+chikuho.fukuoka.jp 01
+chikuhoku.nagano.jp 01
+chikujo.fukuoka.jp 01
+chikuma.nagano.jp 01
+chikusei.ibaraki.jp 01
+chikushino.fukuoka.jp 01
+chikuzen.fukuoka.jp 01
+chillout.jp 08
+chimkent.su 08
+chino.nagano.jp 01
+chintai 01
+chippubetsu.hokkaido.jp 01
+chips.jp 08
+chirurgiens-dentistes-en-france.fr 08
+chirurgiens-dentistes.fr 08
+chiryu.aichi.jp 01
+chita.aichi.jp 01
+chitose.hokkaido.jp 01
+chiyoda.gunma.jp 01
+chiyoda.tokyo.jp 01
+chizu.tottori.jp 01
+chofu.tokyo.jp 01
+chonan.chiba.jp 01
+chosei.chiba.jp 01
+choshi.chiba.jp 01
+chowder.jp 08
+choyo.kumamoto.jp 01
+christmas 01
+chrome 01
+chtr.k12.ma.us 01
+chu.jp 08
+chungbuk.kr 01
+chungnam.kr 01
+chuo.chiba.jp 01
+chuo.fukuoka.jp 01
+chuo.osaka.jp 01
+chuo.tokyo.jp 01
+chuo.yamanashi.jp 01
+church 01
+ci 01
+ci.crm.dev 10
+ci.it 01
+ciao.jp 08
+ciencia.bo 01
+cieszyn.pl 01
+cim.br 01
+cipriani 01
+circle 01
+cisco 01
+ciscofreak.com 08
+cistron.nl 08
+citadel 01
+citi 01
+citic 01
+city 01
+city.hu 01
+city.kawasaki.jp 04
+city.kitakyushu.jp 04
+city.kobe.jp 04
+city.nagoya.jp 04
+city.sapporo.jp 04
+city.sendai.jp 04
+city.yokohama.jp 04
+civilaviation.aero 01
+ck 02
+ck.ua 01
+cl 01
+cl.it 01
+claims 01
+clan.rip 08
+cleaning 01
+clerk.app 08
+clerkstage.app 08
+cleverapps.cc 08
+cleverapps.io 08
+cleverapps.tech 08
+click 01
+clickrising.net 08
+client.scrypted.io 08
+clinic 01
+clinique 01
+clothing 01
+cloud 01
+cloud-ip.biz 08
+cloud-ip.cc 08
+cloud.fedoraproject.org 08
+cloud.goog 08
+cloud.int.apple 10
+cloud.interhostsolutions.be 08
+cloud.metacentrum.cz 10
+cloud.nospamproxy.com 08
+cloud66.ws 08
+cloudaccess.host 08
+cloudaccess.net 08
+cloudapp.net 08
+cloudapps.digital 08
+cloudbeesusercontent.io 08
+cloudera.site 10
+cloudflare-ipfs.com 08
+cloudflare.app 08
+cloudflare.net 08
+cloudfront.net 08
+cloudfunctions.net 08
+cloudjiffy.net 08
+cloudns.asia 08
+cloudns.be 08
+cloudns.biz 08
+cloudns.cc 08
+cloudns.ch 08
+cloudns.cl 08
+cloudns.club 08
+cloudns.cx 08
+cloudns.eu 08
+cloudns.in 08
+cloudns.info 08
+cloudns.nz 08
+cloudns.org 08
+cloudns.ph 08
+cloudns.pro 08
+cloudns.pw 08
+cloudns.us 08
+cloudsite.builders 08
+cloudycluster.net 08
+club 01
+club.aero 01
+club.tw 01
+clubmed 01
+clusters.rdpa.co 10
+cm 01
+cn 01
+cn-north-1.airflow.amazonaws.com.cn 10
+cn-north-1.eb.amazonaws.com.cn 08
+cn-northwest-1.airflow.amazonaws.com.cn 10
+cn-northwest-1.eb.amazonaws.com.cn 08
+cn.com 08
+cn.eu.org 08
+cn.in 01
+cn.it 01
+cn.st 10
+cn.ua 01
+cng.br 01
+cnpy.gdn 08
+cnt.br 01
+co 01
+co.ae 01
+co.ag 01
+co.am 01
+co.ao 01
+co.at 01
+co.az 01
+co.bb 01
+co.bd 01
+co.bi 01
+co.biz.ng 08
+co.bj 01
+co.bn 08
+co.business 08
+co.bw 01
+co.bz 01
+co.ca 08
+co.ci 01
+co.cl 01
+co.cm 01
+co.com 08
+co.cr 01
+co.cz 08
+co.de 08
+co.dk 08
+co.dm 01
+co.education 08
+co.events 08
+co.financial 08
+co.gg 01
+co.gl 01
+co.gy 01
+co.hu 01
+co.id 01
+co.il 01
+co.im 01
+co.in 01
+co.io 01
+co.ir 01
+co.it 01
+co.je 01
+co.jp 01
+co.ke 01
+co.kr 01
+co.krd 08
+co.lc 01
+co.ls 01
+co.ma 01
+co.me 01
+co.mg 01
+co.mu 01
+co.mw 01
+co.mz 01
+co.na 01
+co.network 08
+co.ni 01
+co.nl 08
+co.no 08
+co.nz 01
+co.om 01
+co.pl 08
+co.place 08
+co.pn 01
+co.ro 08
+co.rs 01
+co.rw 01
+co.scot 08
+co.site 08
+co.ss 01
+co.st 01
+co.sz 01
+co.technology 08
+co.th 01
+co.tj 01
+co.tm 01
+co.tt 01
+co.tz 01
+co.ua 08
+co.ug 01
+co.uk 01
+co.us 01
+co.uz 01
+co.ve 01
+co.vi 01
+co.za 01
+co.zm 01
+co.zw 01
+coach 01
+cockpit.fr-par.scw.cloud 08
+cockpit.nl-ams.scw.cloud 08
+cockpit.pl-waw.scw.cloud 08
+cocotte.jp 08
+code.run 10
+codeberg.page 08
+codes 01
+codespot.com 08
+coffee 01
+cog.mi.us 01
+col.ng 08
+college 01
+collegefan.org 08
+cologne 01
+com 01
+com.ac 01
+com.af 01
+com.ag 01
+com.ai 01
+com.al 01
+com.am 01
+com.ar 01
+com.au 01
+com.aw 01
+com.az 01
+com.ba 01
+com.bb 01
+com.bd 01
+com.bh 01
+com.bi 01
+com.bj 01
+com.bm 01
+com.bn 01
+com.bo 01
+com.br 01
+com.bs 01
+com.bt 01
+com.by 01
+com.bz 01
+com.ci 01
+com.cm 01
+com.cn 01
+com.co 01
+com.cu 01
+com.cv 01
+com.cw 01
+com.cy 01
+com.de 08
+com.dm 01
+com.do 01
+com.dz 01
+com.ec 01
+com.ee 01
+com.eg 01
+com.es 01
+com.et 01
+com.fj 01
+com.fm 01
+com.fr 01
+com.ge 01
+com.gh 01
+com.gi 01
+com.gl 01
+com.gn 01
+com.gp 01
+com.gr 01
+com.gt 01
+com.gu 01
+com.gy 01
+com.hk 01
+com.hn 01
+com.hr 01
+com.ht 01
+com.im 01
+com.in 01
+com.io 01
+com.iq 01
+com.jo 01
+com.kg 01
+com.ki 01
+com.km 01
+com.kp 01
+com.kw 01
+com.ky 01
+com.kz 01
+com.la 01
+com.lb 01
+com.lc 01
+com.lk 01
+com.lr 01
+com.lv 01
+com.ly 01
+com.mg 01
+com.mk 01
+com.ml 01
+com.mo 01
+com.ms 01
+com.mt 01
+com.mu 01
+com.mv 01
+com.mw 01
+com.mx 01
+com.my 01
+com.na 01
+com.nf 01
+com.ng 01
+com.ni 01
+com.nr 01
+com.om 01
+com.pa 01
+com.pe 01
+com.pf 01
+com.ph 01
+com.pk 01
+com.pl 01
+com.pr 01
+com.ps 01
+com.pt 01
+com.py 01
+com.qa 01
+com.re 01
+com.ro 01
+com.ru 08
+com.sa 01
+com.sb 01
+com.sc 01
+com.sd 01
+com.se 08
+com.sg 01
+com.sh 01
+com.sl 01
+com.sn 01
+com.so 01
+com.ss 01
+com.st 01
+com.sv 01
+com.sy 01
+com.tj 01
+com.tm 01
+com.tn 01
+com.to 01
+com.tr 01
+com.tt 01
+com.tw 01
+com.ua 01
+com.ug 01
+com.uy 01
+com.uz 01
+com.vc 01
+com.ve 01
+com.vi 01
+com.vn 01
+com.vu 01
+com.ws 01
+com.ye 01
+com.zm 01
+commbank 01
+commune.am 01
+community 01
+community-pro.de 08
+community-pro.net 08
+como.it 01
+company 01
+compare 01
+compute-1.amazonaws.com 10
+compute.amazonaws.com 10
+compute.amazonaws.com.cn 10
+compute.estate 10
+computer 01
+comsec 01
+condos 01
+conf.au 01
+conf.lv 01
+conference.aero 01
+conn.uk 08
+construction 01
+consulado.st 01
+consultant.aero 01
+consulting 01
+consulting.aero 01
+cont.ec 01
+contact 01
+contagem.br 01
+contractors 01
+control.aero 01
+convex.app 08
+convex.cloud 08
+convex.site 08
+cooking 01
+cool 01
+coolblog.jp 08
+coop 01
+coop.ar 01
+coop.br 01
+coop.ht 01
+coop.in 01
+coop.km 01
+coop.mv 01
+coop.mw 01
+coop.py 01
+coop.rw 01
+cooperativa.bo 01
+copro.uk 08
+corespeed.app 08
+corsica 01
+cosenza.it 01
+couchpotatofries.org 08
+council.aero 01
+country 01
+coupon 01
+coupons 01
+courses 01
+coz.br 01
+cpa 01
+cpa.ec 01
+cpa.pro 01
+cpanel.site 08
+cprapid.com 08
+cpserver.com 08
+cq.cn 01
+cr 01
+cr.it 01
+cr.ua 01
+craft.me 08
+cranky.jp 08
+crap.jp 08
+crd.co 08
+credit 01
+creditcard 01
+creditunion 01
+cremona.it 01
+crew.aero 01
+cri.br 01
+cri.nz 01
+cricket 01
+crimea.ua 01
+crotone.it 01
+crown 01
+crs 01
+cruise 01
+cruises 01
+cryptonomic.net 10
+cs.in 01
+cs.it 01
+cs.keliweb.cloud 08
+csb.app 08
+csx.cc 08
+ct.it 01
+ct.us 01
+ctfcloud.net 08
+cu 01
+cue.ec 01
+cuiaba.br 01
+cuisinella 01
+cuneo.it 01
+curitiba.br 01
+cust.cloudscale.ch 08
+cust.dev.thingdust.io 08
+cust.disrec.thingdust.io 08
+cust.prod.thingdust.io 08
+cust.retrosnub.co.uk 08
+cust.testing.thingdust.io 08
+custom.metacentrum.cz 08
+customer-oci.com 10
+customer.mythic-beasts.com 08
+customer.speedpartner.de 08
+cutegirl.jp 08
+cv 01
+cv.ua 01
+cw 01
+cx 01
+cx.ua 08
+cy 01
+cy.eu.org 08
+cymru 01
+cyon.link 08
+cyon.site 08
+cyou 01
+cz 01
+cz.eu.org 08
+cz.it 01
+czeladz.pl 01
+czest.pl 01
+d.bg 01
+d.crm.dev 10
+d.gv.vc 08
+d.se 01
+d6.ply.gg 08
+daa.jp 08
+dad 01
+daegu.kr 01
+daejeon.kr 01
+daemon.asia 08
+daemon.panel.gg 08
+dagestan.ru 08
+dagestan.su 08
+daigo.ibaraki.jp 01
+daisen.akita.jp 01
+daito.osaka.jp 01
+daiwa.hiroshima.jp 01
+daklak.vn 01
+daknong.vn 01
+damnserver.com 08
+danang.vn 01
+dance 01
+darklang.io 08
+data 01
+database.run 10
+date 01
+date.fukushima.jp 01
+date.hokkaido.jp 01
+dating 01
+datsun 01
+dattolocal.com 08
+dattolocal.net 08
+dattorelay.com 08
+dattoweb.com 08
+davvenjarga.no 01
+davvesiida.no 01
+day 01
+daynight.jp 08
+dazaifu.fukuoka.jp 01
+dc.us 01
+dclk 01
+ddl.fr-par.scw.cloud 08
+ddl.nl-ams.scw.cloud 08
+ddl.pl-waw.scw.cloud 08
+ddns-ip.net 08
+ddns.me 08
+ddns.net 08
+ddnsfree.com 08
+ddnsgeek.com 08
+ddnsguru.com 08
+ddnsking.com 08
+ddnss.de 08
+ddnss.org 08
+dds 01
+de 01
+de.com 08
+de.cool 08
+de.eu.org 08
+de.trendhosting.cloud 08
+de.us 01
+de5.net 08
+deal 01
+dealer 01
+deals 01
+deatnu.no 01
+debian.net 08
+deca.jp 08
+deci.jp 08
+dedibox.fr 08
+dedyn.io 08
+def.br 01
+definima.io 08
+definima.net 08
+degree 01
+delhi.in 01
+delivery 01
+dell 01
+dell-ogliastra.it 01
+dellogliastra.it 01
+deloitte 01
+delta 01
+demo.datacenter.fi 08
+demo.datadetect.com 08
+demo.jelastic.com 08
+democracia.bo 01
+democrat 01
+demon.nl 08
+deno-staging.dev 08
+deno.dev 08
+deno.net 08
+dent.ec 01
+dental 01
+dentist 01
+dep.no 01
+deporte.bo 01
+des.br 01
+desa.id 01
+desi 01
+design 01
+design.aero 01
+det.br 01
+deta.app 08
+deta.dev 08
+deus-canvas.com 08
+deuxfleurs.eu 08
+deuxfleurs.page 08
+dev 01
+dev-builder.code.com 10
+dev-myqnapcloud.com 08
+dev.adobeaemcloud.com 10
+dev.br 01
+dev.project-study.com 08
+developer.app 10
+development.run 08
+devices.resinstaging.io 08
+devinapps.com 10
+df.gov.br 01
+df.leg.br 08
+dfirma.pl 08
+dgca.aero 01
+dgn.ec 01
+dh.bytemark.co.uk 08
+dhl 01
+diadem.cloud 08
+diamonds 01
+dielddanuorri.no 01
+dienbien.vn 01
+diet 01
+digick.jp 08
+digital 01
+digitaloceanspaces.com 10
+direct 01
+direct.quickconnect.cn 08
+direct.quickconnect.to 08
+directory 01
+directwp.eu 08
+disco.ec 01
+discordsays.com 08
+discordsez.com 08
+discount 01
+discourse.diy 08
+discourse.group 08
+discourse.team 08
+discover 01
+dish 01
+diskstation.me 08
+diskussionsbereich.de 08
+ditchyourip.com 08
+divtasvuodna.no 01
+divttasvuotna.no 01
+dix.asia 08
+diy 01
+dj 01
+dk 01
+dk.eu.org 08
+dkonto.pl 08
+dl.biz.ng 08
+dlugoleka.pl 01
+dm 01
+dn.ua 01
+dnepropetrovsk.ua 01
+dni.us 01
+dnipropetrovsk.ua 01
+dnp 01
+dns-cloud.net 08
+dns-dynamic.net 08
+dnsabr.com 08
+dnsalias.com 08
+dnsalias.net 08
+dnsalias.org 08
+dnsdojo.com 08
+dnsdojo.net 08
+dnsdojo.org 08
+dnsfor.me 08
+dnshome.de 08
+dnsiskinky.com 08
+dnsking.ch 08
+dnsup.net 08
+dnsupdate.info 08
+dnsupdater.de 08
+do 01
+doc.ec 01
+docs 01
+doctor 01
+does-it.net 08
+doesntexist.com 08
+doesntexist.org 08
+dog 01
+dojin.com 08
+domains 01
+donetsk.ua 01
+dongnai.vn 01
+dongthap.vn 01
+donna.no 01
+dontexist.com 08
+dontexist.net 08
+dontexist.org 08
+doomdns.com 08
+doomdns.org 08
+dopaas.com 08
+doshi.yamanashi.jp 01
+dot 01
+dovre.no 01
+download 01
+dp.ua 01
+dpdns.org 08
+dr.in 01
+dr.tr 01
+drammen.no 01
+drangedal.no 01
+drayddns.com 08
+dreamhosters.com 08
+drive 01
+drobak.no 01
+drr.ac 08
+dscloud.biz 08
+dscloud.me 08
+dscloud.mobi 08
+dsmynas.com 08
+dsmynas.net 08
+dsmynas.org 08
+dst.mi.us 01
+dtv 01
+dtwh.fr-par.scw.cloud 08
+dtwh.nl-ams.scw.cloud 08
+dtwh.pl-waw.scw.cloud 08
+dubai 01
+duckdns.org 08
+dupont 01
+durban 01
+durumis.com 08
+dvag 01
+dvr 01
+dvrcam.info 08
+dvrdns.org 08
+dweb.link 10
+dy.fi 08
+dyn-berlin.de 08
+dyn-ip24.de 08
+dyn-o-saur.com 08
+dyn.addr.tools 08
+dyn.cosidns.de 08
+dyn.ddnss.de 08
+dyn.home-webserver.de 08
+dynalias.com 08
+dynalias.net 08
+dynalias.org 08
+dynamic-dns.info 08
+dynamisches-dns.de 08
+dynathome.net 08
+dyndns-at-home.com 08
+dyndns-at-work.com 08
+dyndns-blog.com 08
+dyndns-free.com 08
+dyndns-home.com 08
+dyndns-ip.com 08
+dyndns-mail.com 08
+dyndns-office.com 08
+dyndns-pics.com 08
+dyndns-remote.com 08
+dyndns-server.com 08
+dyndns-web.com 08
+dyndns-wiki.com 08
+dyndns-work.com 08
+dyndns.biz 08
+dyndns.dappnode.io 08
+dyndns.ddnss.de 08
+dyndns.info 08
+dyndns.org 08
+dyndns.tv 08
+dyndns.ws 08
+dyndns1.de 08
+dynns.com 08
+dynserv.org 08
+dynu.net 08
+dynuddns.com 08
+dynuddns.net 08
+dynuhosting.com 08
+dynv6.net 08
+dyroy.no 01
+dz 01
+e.bg 01
+e.id 08
+e.se 01
+e12.ve 01
+e164.arpa 01
+e2b.app 08
+e4.cz 08
+earth 01
+east-kazakhstan.su 08
+eastasia.azurestaticapps.net 08
+eastus2.azurestaticapps.net 08
+easypanel.app 08
+easypanel.host 08
+eat 01
+eating-organic.net 08
+eaton.mi.us 01
+ebetsu.hokkaido.jp 01
+ebina.kanagawa.jp 01
+ebino.miyazaki.jp 01
+ebiz.tw 01
+ec 01
+ec.cc 08
+echizen.fukui.jp 01
+ecn.br 01
+eco 01
+eco.bj 01
+eco.br 01
+ecologia.bo 01
+ecommerce-shop.pl 08
+econo.bj 01
+economia.bo 01
+ed.ao 01
+ed.ci 01
+ed.cr 01
+ed.jp 01
+edeka 01
+edgecompute.app 08
+edgekey-staging.net 08
+edgekey.net 08
+edgestack.me 08
+edgesuite-staging.net 08
+edgesuite.net 08
+editorx.io 08
+edogawa.tokyo.jp 01
+edu 01
+edu.ac 01
+edu.af 01
+edu.al 01
+edu.ao 01
+edu.ar 01
+edu.au 01
+edu.az 01
+edu.ba 01
+edu.bb 01
+edu.bd 01
+edu.bh 01
+edu.bi 01
+edu.bj 01
+edu.bm 01
+edu.bn 01
+edu.bo 01
+edu.br 01
+edu.bs 01
+edu.bt 01
+edu.bz 01
+edu.ci 01
+edu.cn 01
+edu.co 01
+edu.cu 01
+edu.cv 01
+edu.cw 01
+edu.dm 01
+edu.do 01
+edu.dz 01
+edu.ec 01
+edu.ee 01
+edu.eg 01
+edu.es 01
+edu.et 01
+edu.eu.org 08
+edu.fj 01
+edu.fm 01
+edu.gd 01
+edu.ge 01
+edu.gh 01
+edu.gi 01
+edu.gl 01
+edu.gn 01
+edu.gp 01
+edu.gr 01
+edu.gt 01
+edu.gu 01
+edu.gy 01
+edu.hk 01
+edu.hn 01
+edu.ht 01
+edu.in 01
+edu.io 01
+edu.iq 01
+edu.it 01
+edu.jo 01
+edu.kg 01
+edu.ki 01
+edu.km 01
+edu.kn 01
+edu.kp 01
+edu.krd 08
+edu.kw 01
+edu.ky 01
+edu.kz 01
+edu.la 01
+edu.lb 01
+edu.lc 01
+edu.lk 01
+edu.lr 01
+edu.ls 01
+edu.lv 01
+edu.ly 01
+edu.me 01
+edu.mg 01
+edu.mk 01
+edu.ml 01
+edu.mn 01
+edu.mo 01
+edu.ms 01
+edu.mt 01
+edu.mv 01
+edu.mw 01
+edu.mx 01
+edu.my 01
+edu.mz 01
+edu.ng 01
+edu.ni 01
+edu.nr 01
+edu.om 01
+edu.pa 01
+edu.pe 01
+edu.pf 01
+edu.ph 01
+edu.pk 01
+edu.pl 01
+edu.pn 01
+edu.pr 01
+edu.ps 01
+edu.pt 01
+edu.py 01
+edu.qa 01
+edu.rs 01
+edu.ru 08
+edu.sa 01
+edu.sb 01
+edu.sc 01
+edu.sd 01
+edu.sg 01
+edu.sl 01
+edu.sn 01
+edu.so 01
+edu.ss 01
+edu.st 01
+edu.sv 01
+edu.sy 01
+edu.tj 01
+edu.tm 01
+edu.to 01
+edu.tr 01
+edu.tt 01
+edu.tw 01
+edu.ua 01
+edu.ug 01
+edu.uy 01
+edu.vc 01
+edu.ve 01
+edu.vg 01
+edu.vn 01
+edu.vu 01
+edu.ws 01
+edu.ye 01
+edu.za 01
+edu.zm 01
+education 01
+educator.aero 01
+edugit.io 08
+ee 01
+ee.eu.org 08
+eek.jp 08
+eero-stage.online 08
+eero.online 08
+eg 01
+egersund.no 01
+egoism.jp 08
+ehime.jp 01
+eid.no 01
+eidfjord.no 01
+eidsberg.no 01
+eidskog.no 01
+eidsvoll.no 01
+eigersund.no 01
+eiheiji.fukui.jp 01
+ekloges.cy 01
+elasticbeanstalk.com 08
+elb.amazonaws.com 10
+elb.amazonaws.com.cn 10
+elblag.pl 01
+elementor.cloud 08
+elementor.cool 08
+eliv-api.kr 08
+eliv-cdn.kr 08
+eliv-dns.kr 08
+elk.pl 01
+elverum.no 01
+email 01
+emb.kw 01
+embaixada.st 01
+embetsu.hokkaido.jp 01
+emerck 01
+emergency.aero 01
+emergent.cloud 08
+emergent.host 08
+emilia-romagna.it 01
+emiliaromagna.it 01
+emp.br 01
+emprende.ve 01
+empresa.bo 01
+emr.it 01
+emrappui-prod.af-south-1.amazonaws.com 08
+emrappui-prod.ap-east-1.amazonaws.com 08
+emrappui-prod.ap-northeast-1.amazonaws.com 08
+emrappui-prod.ap-northeast-2.amazonaws.com 08
+emrappui-prod.ap-northeast-3.amazonaws.com 08
+emrappui-prod.ap-south-1.amazonaws.com 08
+emrappui-prod.ap-south-2.amazonaws.com 08
+emrappui-prod.ap-southeast-1.amazonaws.com 08
+emrappui-prod.ap-southeast-2.amazonaws.com 08
+emrappui-prod.ap-southeast-3.amazonaws.com 08
+emrappui-prod.ap-southeast-4.amazonaws.com 08
+emrappui-prod.ca-central-1.amazonaws.com 08
+emrappui-prod.ca-west-1.amazonaws.com 08
+emrappui-prod.cn-north-1.amazonaws.com.cn 08
+emrappui-prod.cn-northwest-1.amazonaws.com.cn 08
+emrappui-prod.eu-central-1.amazonaws.com 08
+emrappui-prod.eu-central-2.amazonaws.com 08
+emrappui-prod.eu-north-1.amazonaws.com 08
+emrappui-prod.eu-south-1.amazonaws.com 08
+emrappui-prod.eu-south-2.amazonaws.com 08
+emrappui-prod.eu-west-1.amazonaws.com 08
+emrappui-prod.eu-west-2.amazonaws.com 08
+emrappui-prod.eu-west-3.amazonaws.com 08
+emrappui-prod.il-central-1.amazonaws.com 08
+emrappui-prod.me-central-1.amazonaws.com 08
+emrappui-prod.me-south-1.amazonaws.com 08
+emrappui-prod.sa-east-1.amazonaws.com 08
+emrappui-prod.us-east-1.amazonaws.com 08
+emrappui-prod.us-east-2.amazonaws.com 08
+emrappui-prod.us-gov-east-1.amazonaws.com 08
+emrappui-prod.us-gov-west-1.amazonaws.com 08
+emrappui-prod.us-west-1.amazonaws.com 08
+emrappui-prod.us-west-2.amazonaws.com 08
+emrnotebooks-prod.af-south-1.amazonaws.com 08
+emrnotebooks-prod.ap-east-1.amazonaws.com 08
+emrnotebooks-prod.ap-northeast-1.amazonaws.com 08
+emrnotebooks-prod.ap-northeast-2.amazonaws.com 08
+emrnotebooks-prod.ap-northeast-3.amazonaws.com 08
+emrnotebooks-prod.ap-south-1.amazonaws.com 08
+emrnotebooks-prod.ap-south-2.amazonaws.com 08
+emrnotebooks-prod.ap-southeast-1.amazonaws.com 08
+emrnotebooks-prod.ap-southeast-2.amazonaws.com 08
+emrnotebooks-prod.ap-southeast-3.amazonaws.com 08
+emrnotebooks-prod.ap-southeast-4.amazonaws.com 08
+emrnotebooks-prod.ca-central-1.amazonaws.com 08
+emrnotebooks-prod.ca-west-1.amazonaws.com 08
+emrnotebooks-prod.cn-north-1.amazonaws.com.cn 08
+emrnotebooks-prod.cn-northwest-1.amazonaws.com.cn 08
+emrnotebooks-prod.eu-central-1.amazonaws.com 08
+emrnotebooks-prod.eu-central-2.amazonaws.com 08
+emrnotebooks-prod.eu-north-1.amazonaws.com 08
+emrnotebooks-prod.eu-south-1.amazonaws.com 08
+emrnotebooks-prod.eu-south-2.amazonaws.com 08
+emrnotebooks-prod.eu-west-1.amazonaws.com 08
+emrnotebooks-prod.eu-west-2.amazonaws.com 08
+emrnotebooks-prod.eu-west-3.amazonaws.com 08
+emrnotebooks-prod.il-central-1.amazonaws.com 08
+emrnotebooks-prod.me-central-1.amazonaws.com 08
+emrnotebooks-prod.me-south-1.amazonaws.com 08
+emrnotebooks-prod.sa-east-1.amazonaws.com 08
+emrnotebooks-prod.us-east-1.amazonaws.com 08
+emrnotebooks-prod.us-east-2.amazonaws.com 08
+emrnotebooks-prod.us-gov-east-1.amazonaws.com 08
+emrnotebooks-prod.us-gov-west-1.amazonaws.com 08
+emrnotebooks-prod.us-west-1.amazonaws.com 08
+emrnotebooks-prod.us-west-2.amazonaws.com 08
+emrstudio-prod.af-south-1.amazonaws.com 08
+emrstudio-prod.ap-east-1.amazonaws.com 08
+emrstudio-prod.ap-northeast-1.amazonaws.com 08
+emrstudio-prod.ap-northeast-2.amazonaws.com 08
+emrstudio-prod.ap-northeast-3.amazonaws.com 08
+emrstudio-prod.ap-south-1.amazonaws.com 08
+emrstudio-prod.ap-south-2.amazonaws.com 08
+emrstudio-prod.ap-southeast-1.amazonaws.com 08
+emrstudio-prod.ap-southeast-2.amazonaws.com 08
+emrstudio-prod.ap-southeast-3.amazonaws.com 08
+emrstudio-prod.ap-southeast-4.amazonaws.com 08
+emrstudio-prod.ca-central-1.amazonaws.com 08
+emrstudio-prod.ca-west-1.amazonaws.com 08
+emrstudio-prod.cn-north-1.amazonaws.com.cn 08
+emrstudio-prod.cn-northwest-1.amazonaws.com.cn 08
+emrstudio-prod.eu-central-1.amazonaws.com 08
+emrstudio-prod.eu-central-2.amazonaws.com 08
+emrstudio-prod.eu-north-1.amazonaws.com 08
+emrstudio-prod.eu-south-1.amazonaws.com 08
+emrstudio-prod.eu-south-2.amazonaws.com 08
+emrstudio-prod.eu-west-1.amazonaws.com 08
+emrstudio-prod.eu-west-2.amazonaws.com 08
+emrstudio-prod.eu-west-3.amazonaws.com 08
+emrstudio-prod.il-central-1.amazonaws.com 08
+emrstudio-prod.me-central-1.amazonaws.com 08
+emrstudio-prod.me-south-1.amazonaws.com 08
+emrstudio-prod.sa-east-1.amazonaws.com 08
+emrstudio-prod.us-east-1.amazonaws.com 08
+emrstudio-prod.us-east-2.amazonaws.com 08
+emrstudio-prod.us-gov-east-1.amazonaws.com 08
+emrstudio-prod.us-gov-west-1.amazonaws.com 08
+emrstudio-prod.us-west-1.amazonaws.com 08
+emrstudio-prod.us-west-2.amazonaws.com 08
+en.it 01
+ena.gifu.jp 01
+encoreapi.com 08
+encr.app 08
+endofinternet.net 08
+endofinternet.org 08
+endoftheinternet.org 08
+enebakk.no 01
+energy 01
+enf.br 01
+eng.br 01
+eng.ec 01
+eng.jo 01
+eng.pro 01
+engerdal.no 01
+engine.aero 01
+engineer 01
+engineer.aero 01
+engineering 01
+eniwa.hokkaido.jp 01
+enna.it 01
+ens.tn 01
+enscaled.sg 08
+ent.platform.sh 08
+enterprisecloud.nu 08
+enterprises 01
+entertainment.aero 01
+epson 01
+equipment 01
+equipment.aero 01
+er 02
+er.in 01
+ericsson 01
+erimo.hokkaido.jp 01
+erni 01
+erotica.hu 01
+erotika.hu 01
+erp.dev 08
+es 01
+es-1.axarnet.cloud 08
+es.eu.org 08
+es.gov.br 01
+es.kr 01
+es.leg.br 08
+esan.hokkaido.jp 01
+esashi.hokkaido.jp 01
+esm.ec 01
+esp.br 01
+esq 01
+est-a-la-maison.com 08
+est-a-la-masion.com 08
+est-le-patron.com 08
+est-mon-blogueur.com 08
+est.pr 01
+estate 01
+et 01
+etajima.hiroshima.jp 01
+etc.br 01
+eti.br 01
+etne.no 01
+etnedal.no 01
+eu 01
+eu-1.evennode.com 08
+eu-2.evennode.com 08
+eu-3.evennode.com 08
+eu-4.evennode.com 08
+eu-central-1.airflow.amazonaws.com 10
+eu-central-1.elasticbeanstalk.com 08
+eu-central-1.r.cloud.int.apple 10
+eu-central-1.rds.amazonaws.com 10
+eu-central-2.airflow.amazonaws.com 10
+eu-central-2.rds.amazonaws.com 10
+eu-north-1.airflow.amazonaws.com 10
+eu-north-1.elasticbeanstalk.com 08
+eu-north-1.r.cloud.int.apple 10
+eu-south-1.airflow.amazonaws.com 10
+eu-south-1.elasticbeanstalk.com 08
+eu-south-2.airflow.amazonaws.com 10
+eu-south-2.elasticbeanstalk.com 08
+eu-west-1.airflow.amazonaws.com 10
+eu-west-1.elasticbeanstalk.com 08
+eu-west-1.rds.amazonaws.com 10
+eu-west-2.airflow.amazonaws.com 10
+eu-west-2.elasticbeanstalk.com 08
+eu-west-2.rds.amazonaws.com 10
+eu-west-3.airflow.amazonaws.com 10
+eu-west-3.elasticbeanstalk.com 08
+eu-west-3.rds.amazonaws.com 10
+eu.cc 08
+eu.com 08
+eu.encoway.cloud 08
+eu.int 01
+eu.meteorapp.com 08
+eu.ngrok.io 08
+eu.org 08
+eu.platform.sh 08
+eu.pythonanywhere.com 08
+eu1-plenit.com 08
+eun.eg 01
+eurodir.ru 08
+eurovision 01
+eus 01
+evenassi.no 01
+evenes.no 01
+events 01
+evje-og-hornnes.no 01
+ewp.live 10
+ex.futurecms.at 10
+ex.ortsinfo.at 10
+exchange 01
+exchange.aero 01
+execute-api.af-south-1.amazonaws.com 08
+execute-api.ap-east-1.amazonaws.com 08
+execute-api.ap-northeast-1.amazonaws.com 08
+execute-api.ap-northeast-2.amazonaws.com 08
+execute-api.ap-northeast-3.amazonaws.com 08
+execute-api.ap-south-1.amazonaws.com 08
+execute-api.ap-south-2.amazonaws.com 08
+execute-api.ap-southeast-1.amazonaws.com 08
+execute-api.ap-southeast-2.amazonaws.com 08
+execute-api.ap-southeast-3.amazonaws.com 08
+execute-api.ap-southeast-4.amazonaws.com 08
+execute-api.ap-southeast-5.amazonaws.com 08
+execute-api.ca-central-1.amazonaws.com 08
+execute-api.ca-west-1.amazonaws.com 08
+execute-api.cn-north-1.amazonaws.com.cn 08
+execute-api.cn-northwest-1.amazonaws.com.cn 08
+execute-api.eu-central-1.amazonaws.com 08
+execute-api.eu-central-2.amazonaws.com 08
+execute-api.eu-north-1.amazonaws.com 08
+execute-api.eu-south-1.amazonaws.com 08
+execute-api.eu-south-2.amazonaws.com 08
+execute-api.eu-west-1.amazonaws.com 08
+execute-api.eu-west-2.amazonaws.com 08
+execute-api.eu-west-3.amazonaws.com 08
+execute-api.il-central-1.amazonaws.com 08
+execute-api.me-central-1.amazonaws.com 08
+execute-api.me-south-1.amazonaws.com 08
+execute-api.sa-east-1.amazonaws.com 08
+execute-api.us-east-1.amazonaws.com 08
+execute-api.us-east-2.amazonaws.com 08
+execute-api.us-gov-east-1.amazonaws.com 08
+execute-api.us-gov-west-1.amazonaws.com 08
+execute-api.us-west-1.amazonaws.com 08
+execute-api.us-west-2.amazonaws.com 08
+exnet.su 08
+experiments.sagemaker.aws 10
+expert 01
+experts-comptables.fr 08
+expo.app 08
+exposed 01
+express 01
+express.aero 01
+extraspace 01
+ezproxy.kuleuven.be 08
+f.bg 01
+f.se 01
+f5.si 08
+fage 01
+fail 01
+fairwinds 01
+faith 01
+fakefur.jp 08
+fam.pk 01
+family 01
+familyds.com 08
+familyds.net 08
+familyds.org 08
+fan 01
+fans 01
+fantasyleague.cc 08
+far.br 01
+farm 01
+farmers 01
+farsund.no 01
+fashion 01
+fashionstore.jp 08
+fast 01
+fastly-edge.com 08
+fastly-terrarium.com 08
+fastlylb.net 08
+fastvps-server.com 08
+fastvps.host 08
+fastvps.site 08
+fauske.no 01
+fbx-os.fr 08
+fbxos.fr 08
+fc.it 01
+fe.it 01
+federation.aero 01
+fedex 01
+fedje.no 01
+fedorainfracloud.org 08
+fedorapeople.org 08
+feedback 01
+feedback.ac 08
+feira.br 01
+fem.jp 08
+fentiger.mythic-beasts.com 08
+fermo.it 01
+ferrara.it 01
+ferrari 01
+ferrero 01
+feste-ip.net 08
+fet.no 01
+fetsund.no 01
+fg.it 01
+fh-muenster.io 08
+fh.se 01
+fhs.no 01
+fhsk.se 01
+fhv.se 01
+fi 01
+fi.cloudplatform.fi 08
+fi.cr 01
+fi.eu.org 08
+fi.it 01
+fidelity 01
+fido 01
+fie.ee 01
+figma-gov.site 08
+figma.site 08
+file.core.usgovcloudapi.net 08
+file.core.windows.net 08
+filegear-sg.me 08
+filegear.me 08
+film 01
+film.hu 01
+fin.ec 01
+fin.in 01
+fin.tn 01
+final 01
+finance 01
+financial 01
+finnoy.no 01
+fire 01
+firebaseapp.com 08
+firenet.ch 10
+firenze.it 01
+firestone 01
+firewall-gateway.com 08
+firewall-gateway.de 08
+firewall-gateway.net 08
+firewalledreplit.co 08
+firm.dk 08
+firm.ht 01
+firm.in 01
+firm.nf 01
+firm.ng 08
+firm.ro 01
+firm.ve 01
+firmdale 01
+fish 01
+fishing 01
+fit 01
+fitjar.no 01
+fitness 01
+fj 01
+fj.cn 01
+fjaler.no 01
+fjell.no 01
+fk 02
+fl.us 01
+fla.no 01
+flakstad.no 01
+flatanger.no 01
+fldrv.com 08
+flekkefjord.no 01
+flesberg.no 01
+flickr 01
+flier.jp 08
+flight.aero 01
+flights 01
+flir 01
+flog.br 01
+flop.jp 08
+floppy.jp 08
+flora.no 01
+florence.it 01
+floripa.br 01
+florist 01
+floro.no 01
+flowers 01
+flt.cloud.muni.cz 08
+flutterflow.app 08
+fly 01
+fly.dev 08
+fm 01
+fm.br 01
+fm.it 01
+fm.jo 01
+fm.no 01
+fnc.fr-par.scw.cloud 08
+fnd.br 01
+fo 01
+foggia.it 01
+folkebibl.no 01
+folldal.no 01
+foo 01
+food 01
+fool.jp 08
+football 01
+for-better.biz 08
+for-more.biz 08
+for-our.info 08
+for-some.biz 08
+for-the.biz 08
+ford 01
+forde.no 01
+forex 01
+forgeblocks.com 08
+forgot.her.name 08
+forgot.his.name 08
+forli-cesena.it 01
+forlicesena.it 01
+forms.ac 08
+forsale 01
+forsand.no 01
+fortal.br 01
+forum 01
+forum.hu 01
+forumz.info 08
+fosnes.no 01
+fot.br 01
+fot.ec 01
+foundation 01
+fox 01
+foz.br 01
+fr 01
+fr-1.paas.massivegrid.net 08
+fr-par-1.baremetal.scw.cloud 08
+fr-par-2.baremetal.scw.cloud 08
+fr.eu.org 08
+fr.it 01
+fra1-de.cloudjiffy.net 08
+framer.ai 08
+framer.app 08
+framer.media 08
+framer.photos 08
+framer.website 08
+framer.wiki 08
+framercanvas.com 08
+frana.no 01
+fredrikstad.no 01
+free 01
+freebox-os.com 08
+freebox-os.fr 08
+freeboxos.com 08
+freeboxos.fr 08
+freeddns.org 08
+freeddns.us 08
+freedesktop.org 08
+freemyip.com 08
+freesite.host 08
+freetls.fastly.net 08
+frei.no 01
+freight.aero 01
+frenchkiss.jp 08
+fresenius 01
+friuli-v-giulia.it 01
+friuli-ve-giulia.it 01
+friuli-vegiulia.it 01
+friuli-venezia-giulia.it 01
+friuli-veneziagiulia.it 01
+friuli-vgiulia.it 01
+friuliv-giulia.it 01
+friulive-giulia.it 01
+friulivegiulia.it 01
+friulivenezia-giulia.it 01
+friuliveneziagiulia.it 01
+friulivgiulia.it 01
+frl 01
+frogans 01
+frogn.no 01
+froland.no 01
+from-ak.com 08
+from-al.com 08
+from-ar.com 08
+from-az.net 08
+from-ca.com 08
+from-co.net 08
+from-ct.com 08
+from-dc.com 08
+from-de.com 08
+from-fl.com 08
+from-ga.com 08
+from-hi.com 08
+from-ia.com 08
+from-id.com 08
+from-il.com 08
+from-in.com 08
+from-ks.com 08
+from-ky.com 08
+from-la.net 08
+from-ma.com 08
+from-md.com 08
+from-me.org 08
+from-mi.com 08
+from-mn.com 08
+from-mo.com 08
+from-ms.com 08
+from-mt.com 08
+from-nc.com 08
+from-nd.com 08
+from-ne.com 08
+from-nh.com 08
+from-nj.com 08
+from-nm.com 08
+from-nv.com 08
+from-ny.net 08
+from-oh.com 08
+from-ok.com 08
+from-or.com 08
+from-pa.com 08
+from-pr.com 08
+from-ri.com 08
+from-sc.com 08
+from-sd.com 08
+from-tn.com 08
+from-tx.com 08
+from-ut.com 08
+from-va.com 08
+from-vt.com 08
+from-wa.com 08
+from-wi.com 08
+from-wv.com 08
+from-wy.com 08
+from.hr 01
+from.tv 08
+frontend.encr.app 08
+frontier 01
+frosinone.it 01
+frosta.no 01
+froya.no 01
+frusky.de 10
+fst.br 01
+ftpaccess.cc 08
+ftr 01
+fuchu.hiroshima.jp 01
+fuchu.tokyo.jp 01
+fuchu.toyama.jp 01
+fudai.iwate.jp 01
+fuefuki.yamanashi.jp 01
+fuel.aero 01
+fuettertdasnetz.de 08
+fuji.shizuoka.jp 01
+fujieda.shizuoka.jp 01
+fujiidera.osaka.jp 01
+fujikawa.shizuoka.jp 01
+fujikawa.yamanashi.jp 01
+fujikawaguchiko.yamanashi.jp 01
+fujimi.nagano.jp 01
+fujimi.saitama.jp 01
+fujimino.saitama.jp 01
+fujinomiya.shizuoka.jp 01
+fujioka.gunma.jp 01
+fujisato.akita.jp 01
+fujisawa.iwate.jp 01
+fujisawa.kanagawa.jp 01
+fujishiro.ibaraki.jp 01
+fujitsu 01
+fujiyoshida.yamanashi.jp 01
+fukagawa.hokkaido.jp 01
+fukaya.saitama.jp 01
+fukuchi.fukuoka.jp 01
+fukuchiyama.kyoto.jp 01
+fukudomi.saga.jp 01
+fukui.fukui.jp 01
+fukui.jp 01
+fukumitsu.toyama.jp 01
+fukuoka.jp 01
+fukuroi.shizuoka.jp 01
+fukusaki.hyogo.jp 01
+fukushima.fukushima.jp 01
+fukushima.hokkaido.jp 01
+fukushima.jp 01
+fukuyama.hiroshima.jp 01
+fun 01
+funabashi.chiba.jp 01
+funagata.yamagata.jp 01
+funahashi.toyama.jp 01
+functions.fnc.fr-par.scw.cloud 08
+fund 01
+funnels.cx 08
+fuoisku.no 01
+fuossko.no 01
+furano.hokkaido.jp 01
+furniture 01
+furubira.hokkaido.jp 01
+furudono.fukushima.jp 01
+furukawa.miyagi.jp 01
+fusa.no 01
+fuso.aichi.jp 01
+fussa.tokyo.jp 01
+futaba.fukushima.jp 01
+futbol 01
+futsu.nagasaki.jp 01
+futtsu.chiba.jp 01
+futurecms.at 10
+futurehosting.at 08
+futuremailing.at 08
+fvg.it 01
+fyi 01
+fylkesbibl.no 01
+fyresdal.no 01
+g.bg 01
+g.se 01
+g12.br 01
+ga 01
+ga.us 01
+gadget.app 08
+gadget.host 08
+gaivuotna.no 01
+gal 01
+gal.ec 01
+gallery 01
+gallo 01
+gallup 01
+galsa.no 01
+gamagori.aichi.jp 01
+game 01
+game-host.org 08
+game-server.cc 08
+game.tw 01
+games 01
+games.hu 01
+gamo.shiga.jp 01
+gamvik.no 01
+gangaviika.no 01
+gangwon.kr 01
+gap 01
+garden 01
+gateway.dev 10
+gaular.no 01
+gausdal.no 01
+gay 01
+gb 01
+gb.net 08
+gbiz 01
+gc.ca 01
+gd 01
+gd.cn 01
+gda.pl 08
+gdansk.pl 08
+gdn 01
+gdynia.pl 08
+ge 01
+ge.it 01
+gea 01
+geek.nz 01
+geekgalaxy.com 08
+gehirn.ne.jp 08
+geisei.kochi.jp 01
+gen.in 01
+gen.mi.us 01
+gen.ng 08
+gen.nz 01
+gen.tr 01
+genkai.saga.jp 01
+genoa.it 01
+genova.it 01
+gent 01
+gentapps.com 08
+genting 01
+gentlentapis.com 08
+geo.br 01
+george 01
+georgia.su 08
+getmyip.com 08
+gets-it.net 08
+gf 01
+gg 01
+ggee 01
+ggf.br 01
+ggff.net 08
+gh 01
+gh.srv.us 08
+gi 01
+gialai.vn 01
+giehtavuoatna.no 01
+gift 01
+gifts 01
+gifu.gifu.jp 01
+gifu.jp 01
+giize.com 08
+gildeskal.no 01
+ginan.gifu.jp 01
+ginowan.okinawa.jp 01
+ginoza.okinawa.jp 01
+girlfriend.jp 08
+girly.jp 08
+giske.no 01
+git-pages.rit.edu 08
+git-repos.de 08
+gitapp.si 08
+gitbook.io 08
+github.app 08
+github.io 08
+githubpreview.dev 08
+githubusercontent.com 08
+gitlab.io 08
+gitpage.si 08
+gives 01
+giving 01
+gjemnes.no 01
+gjerdrum.no 01
+gjerstad.no 01
+gjesdal.no 01
+gjovik.no 01
+gkp.pk 01
+gl 01
+gl.srv.us 08
+glass 01
+gle 01
+gleeze.com 08
+gliding.aero 01
+gliwice.pl 08
+global 01
+global.prod.fastly.net 08
+global.replit.dev 08
+global.ssl.fastly.net 08
+globo 01
+glogow.pl 01
+gloomy.jp 08
+gloppen.no 01
+glug.org.uk 08
+gm 01
+gmail 01
+gmbh 01
+gmina.pl 01
+gmo 01
+gmx 01
+gn 01
+gniezno.pl 01
+go.biz.ng 08
+go.ci 01
+go.cr 01
+go.dyndns.org 08
+go.gov.br 01
+go.id 01
+go.it 01
+go.jp 01
+go.ke 01
+go.kr 01
+go.leg.br 08
+go.th 01
+go.tj 01
+go.tz 01
+go.ug 01
+gob.ar 01
+gob.bo 01
+gob.cl 01
+gob.cu 01
+gob.do 01
+gob.ec 01
+gob.es 01
+gob.gt 01
+gob.hn 01
+gob.mx 01
+gob.ni 01
+gob.pa 01
+gob.pe 01
+gob.pk 01
+gob.sv 01
+gob.ve 01
+gobo.wakayama.jp 01
+godaddy 01
+godo.gifu.jp 01
+gog.pk 01
+goiania.br 01
+goip.de 08
+gojome.akita.jp 01
+gok.pk 01
+gokase.miyazaki.jp 01
+gol.no 01
+gold 01
+goldpoint 01
+golf 01
+golffan.us 08
+gonna.jp 08
+gonohe.aomori.jp 01
+goo 01
+goodyear 01
+goog 01
+google 01
+googleapis.com 08
+googlecode.com 08
+gop 01
+gop.pk 01
+gorizia.it 01
+gorlice.pl 01
+gos.pk 01
+gose.nara.jp 01
+gosen.niigata.jp 01
+goshiki.hyogo.jp 01
+got 01
+gotdns.ch 08
+gotdns.com 08
+gotdns.org 08
+gotemba.shizuoka.jp 01
+goto.nagasaki.jp 01
+gotpantheon.com 08
+gotsu.shimane.jp 01
+goupile.fr 08
+gouv.ci 01
+gouv.fr 01
+gouv.ht 01
+gouv.km 01
+gouv.ml 01
+gouv.sn 01
+gov 01
+gov.ac 01
+gov.ae 01
+gov.af 01
+gov.al 01
+gov.ao 01
+gov.ar 01
+gov.as 01
+gov.au 01
+gov.az 01
+gov.ba 01
+gov.bb 01
+gov.bd 01
+gov.bf 01
+gov.bh 01
+gov.bm 01
+gov.bn 01
+gov.br 01
+gov.bs 01
+gov.bt 01
+gov.bw 01
+gov.by 01
+gov.bz 01
+gov.cd 01
+gov.cl 01
+gov.cm 01
+gov.cn 01
+gov.co 01
+gov.cx 01
+gov.cy 01
+gov.cz 01
+gov.dm 01
+gov.do 01
+gov.dz 01
+gov.ec 01
+gov.ee 01
+gov.eg 01
+gov.et 01
+gov.fj 01
+gov.gd 01
+gov.ge 01
+gov.gh 01
+gov.gi 01
+gov.gn 01
+gov.gr 01
+gov.gu 01
+gov.gy 01
+gov.hk 01
+gov.ie 01
+gov.il 01
+gov.in 01
+gov.io 01
+gov.iq 01
+gov.ir 01
+gov.it 01
+gov.jo 01
+gov.kg 01
+gov.ki 01
+gov.km 01
+gov.kn 01
+gov.kp 01
+gov.kw 01
+gov.kz 01
+gov.la 01
+gov.lb 01
+gov.lc 01
+gov.lk 01
+gov.lr 01
+gov.ls 01
+gov.lt 01
+gov.lv 01
+gov.ly 01
+gov.ma 01
+gov.me 01
+gov.mg 01
+gov.mk 01
+gov.ml 01
+gov.mn 01
+gov.mo 01
+gov.mr 01
+gov.ms 01
+gov.mu 01
+gov.mv 01
+gov.mw 01
+gov.my 01
+gov.mz 01
+gov.na 01
+gov.nc.tr 01
+gov.ng 01
+gov.nl 08
+gov.nr 01
+gov.om 01
+gov.ph 01
+gov.pk 01
+gov.pl 01
+gov.pn 01
+gov.pr 01
+gov.ps 01
+gov.pt 01
+gov.pw 01
+gov.py 01
+gov.qa 01
+gov.rs 01
+gov.ru 08
+gov.rw 01
+gov.sa 01
+gov.sb 01
+gov.sc 01
+gov.scot 08
+gov.sd 01
+gov.sg 01
+gov.sh 01
+gov.sl 01
+gov.so 01
+gov.ss 01
+gov.sx 01
+gov.sy 01
+gov.tj 01
+gov.tl 01
+gov.tm 01
+gov.tn 01
+gov.to 01
+gov.tr 01
+gov.tt 01
+gov.tw 01
+gov.ua 01
+gov.ug 01
+gov.uk 01
+gov.vc 01
+gov.ve 01
+gov.vn 01
+gov.ws 01
+gov.ye 01
+gov.za 01
+gov.zm 01
+gov.zw 01
+government.aero 01
+govt.nz 01
+gp 01
+gq 01
+gr 01
+gr.com 08
+gr.eu.org 08
+gr.it 01
+gr.jp 01
+grafana-dev.net 08
+grainger 01
+grajewo.pl 01
+gran.no 01
+grane.no 01
+granvin.no 01
+graphic.design 08
+graphics 01
+gratangen.no 01
+gratis 01
+grayjayleagues.com 08
+greater.jp 08
+grebedoc.dev 08
+green 01
+greta.fr 01
+grimstad.no 01
+gripe 01
+griw.gov.pl 01
+grocery 01
+groks-the.info 08
+groks-this.info 08
+grondar.za 01
+grong.no 01
+grosseto.it 01
+groundhandling.aero 01
+group 01
+group.aero 01
+grozny.ru 08
+grozny.su 08
+grp.lk 01
+gru.br 01
+grue.no 01
+gs 01
+gs.aa.no 01
+gs.ah.no 01
+gs.bu.no 01
+gs.cn 01
+gs.fm.no 01
+gs.hl.no 01
+gs.hm.no 01
+gs.jan-mayen.no 01
+gs.mr.no 01
+gs.nl.no 01
+gs.nt.no 01
+gs.of.no 01
+gs.ol.no 01
+gs.oslo.no 01
+gs.rl.no 01
+gs.sf.no 01
+gs.st.no 01
+gs.svalbard.no 01
+gs.tm.no 01
+gs.tr.no 01
+gs.va.no 01
+gs.vf.no 01
+gsj.bz 08
+gsm.pl 01
+gt 01
+gu 01
+gu.cc 08
+gu.us 01
+guam.gu 01
+gub.uy 01
+gucci 01
+guge 01
+guide 01
+guitars 01
+gujarat.in 01
+gujo.gifu.jp 01
+gulen.no 01
+gunma.jp 01
+guovdageaidnu.no 01
+guru 01
+gushikami.okinawa.jp 01
+gv.ao 01
+gv.at 01
+gv.uy 08
+gv.vc 08
+gw 01
+gwangju.kr 01
+gx.cn 01
+gy 01
+gye.ec 01
+gyeongbuk.kr 01
+gyeonggi.kr 01
+gyeongnam.kr 01
+gyokuto.kumamoto.jp 01
+gz.cn 01
+h.bg 01
+h.se 01
+ha.cn 01
+ha.no 01
+habikino.osaka.jp 01
+habmer.no 01
+haboro.hokkaido.jp 01
+hacca.jp 08
+hachijo.tokyo.jp 01
+hachinohe.aomori.jp 01
+hachioji.tokyo.jp 01
+hachirogata.akita.jp 01
+hackclub.app 08
+hacker.replit.dev 08
+hadano.kanagawa.jp 01
+hadsel.no 01
+haebaru.okinawa.jp 01
+haga.tochigi.jp 01
+hagebostad.no 01
+hagi.yamaguchi.jp 01
+hagiang.vn 01
+haibara.shizuoka.jp 01
+haiduong.vn 01
+haiphong.vn 01
+hair 01
+hakata.fukuoka.jp 01
+hakodate.hokkaido.jp 01
+hakone.kanagawa.jp 01
+hakuba.nagano.jp 01
+hakui.ishikawa.jp 01
+hakusan.ishikawa.jp 01
+halden.no 01
+half.host 08
+halfmoon.jp 08
+halsa.no 01
+ham-radio-op.net 08
+hamada.shimane.jp 01
+hamamatsu.shizuoka.jp 01
+hamar.no 01
+hamaroy.no 01
+hamatama.saga.jp 01
+hamatonbetsu.hokkaido.jp 01
+hamburg 01
+hammarfeasta.no 01
+hammerfest.no 01
+hamura.tokyo.jp 01
+hanam.vn 01
+hanamaki.iwate.jp 01
+hanamigawa.chiba.jp 01
+hanawa.fukushima.jp 01
+handa.aichi.jp 01
+handcrafted.jp 08
+hanggliding.aero 01
+hangout 01
+hannan.osaka.jp 01
+hanno.saitama.jp 01
+hanoi.vn 01
+hanyu.saitama.jp 01
+hapmir.no 01
+happou.akita.jp 01
+hara.nagano.jp 01
+haram.no 01
+hareid.no 01
+harima.hyogo.jp 01
+harstad.no 01
+hasama.oita.jp 01
+hasami.nagasaki.jp 01
+hashbang.sh 08
+hashikami.aomori.jp 01
+hashima.gifu.jp 01
+hashimoto.wakayama.jp 01
+hasuda.saitama.jp 01
+hasura-app.io 08
+hasura.app 08
+hasvik.no 01
+hateblo.jp 08
+hatenablog.com 08
+hatenablog.jp 08
+hatenadiary.com 08
+hatenadiary.jp 08
+hatenadiary.org 08
+hatinh.vn 01
+hatogaya.saitama.jp 01
+hatoyama.saitama.jp 01
+hatsukaichi.hiroshima.jp 01
+hattfjelldal.no 01
+haugesund.no 01
+haugiang.vn 01
+haus 01
+hayakawa.yamanashi.jp 01
+hayashima.okayama.jp 01
+hazu.aichi.jp 01
+hb.cldmail.ru 08
+hb.cn 01
+hbo 01
+hdfc 01
+hdfcbank 01
+he.cn 01
+health 01
+health-carereform.com 08
+health.nz 01
+health.vn 01
+healthcare 01
+heavy.jp 08
+heguri.nara.jp 01
+heiyu.space 08
+hekinan.aichi.jp 01
+helioho.st 08
+heliohost.us 08
+help 01
+helsinki 01
+hemne.no 01
+hemnes.no 01
+hemsedal.no 01
+hepforge.org 08
+her.jp 08
+herad.no 01
+hercules-app.com 08
+hercules-dev.com 08
+here 01
+here-for-more.info 08
+hermes 01
+herokuapp.com 08
+heroy.more-og-romsdal.no 01
+heroy.nordland.no 01
+heteml.net 08
+heyflow.page 08
+heyflow.site 08
+hf.space 08
+hi.cn 01
+hi.us 01
+hicam.net 08
+hichiso.gifu.jp 01
+hida.gifu.jp 01
+hidaka.hokkaido.jp 01
+hidaka.kochi.jp 01
+hidaka.saitama.jp 01
+hidaka.wakayama.jp 01
+hidns.co 08
+hidns.vip 08
+higashi.fukuoka.jp 01
+higashi.fukushima.jp 01
+higashi.okinawa.jp 01
+higashiagatsuma.gunma.jp 01
+higashichichibu.saitama.jp 01
+higashihiroshima.hiroshima.jp 01
+higashiizu.shizuoka.jp 01
+higashiizumo.shimane.jp 01
+higashikagawa.kagawa.jp 01
+higashikagura.hokkaido.jp 01
+higashikawa.hokkaido.jp 01
+higashikurume.tokyo.jp 01
+higashimatsushima.miyagi.jp 01
+higashimatsuyama.saitama.jp 01
+higashimurayama.tokyo.jp 01
+higashinaruse.akita.jp 01
+higashine.yamagata.jp 01
+higashiomi.shiga.jp 01
+higashiosaka.osaka.jp 01
+higashishirakawa.gifu.jp 01
+higashisumiyoshi.osaka.jp 01
+higashitsuno.kochi.jp 01
+higashiura.aichi.jp 01
+higashiyama.kyoto.jp 01
+higashiyamato.tokyo.jp 01
+higashiyodogawa.osaka.jp 01
+higashiyoshino.nara.jp 01
+hiho.jp 08
+hiji.oita.jp 01
+hikari.yamaguchi.jp 01
+hikawa.shimane.jp 01
+hikimi.shimane.jp 01
+hikone.shiga.jp 01
+himeji.hyogo.jp 01
+himeshima.oita.jp 01
+himi.toyama.jp 01
+hino.tokyo.jp 01
+hino.tottori.jp 01
+hinode.tokyo.jp 01
+hinohara.tokyo.jp 01
+hioki.kagoshima.jp 01
+hiphop 01
+hippy.jp 08
+hirado.nagasaki.jp 01
+hiraizumi.iwate.jp 01
+hirakata.osaka.jp 01
+hiranai.aomori.jp 01
+hirara.okinawa.jp 01
+hirata.fukushima.jp 01
+hiratsuka.kanagawa.jp 01
+hiraya.nagano.jp 01
+hirogawa.wakayama.jp 01
+hirokawa.fukuoka.jp 01
+hirono.fukushima.jp 01
+hirono.iwate.jp 01
+hiroo.hokkaido.jp 01
+hirosaki.aomori.jp 01
+hiroshima.jp 01
+hisamitsu 01
+hisayama.fukuoka.jp 01
+hita.oita.jp 01
+hitachi 01
+hitachi.ibaraki.jp 01
+hitachinaka.ibaraki.jp 01
+hitachiomiya.ibaraki.jp 01
+hitachiota.ibaraki.jp 01
+hitra.no 01
+hiv 01
+hizen.saga.jp 01
+hjartdal.no 01
+hjelmeland.no 01
+hk 01
+hk.cn 01
+hk.com 08
+hk.org 08
+hkt 01
+hl.cn 01
+hl.no 01
+hlx.live 08
+hlx.page 08
+hm 01
+hm.no 01
+hn 01
+hn.cn 01
+hoabinh.vn 01
+hobby-site.com 08
+hobby-site.org 08
+hobol.no 01
+hockey 01
+hof.no 01
+hofu.yamaguchi.jp 01
+hokkaido.jp 01
+hokksund.no 01
+hokuryu.hokkaido.jp 01
+hokuto.hokkaido.jp 01
+hokuto.yamanashi.jp 01
+hol.no 01
+holdings 01
+hole.no 01
+holiday 01
+holmestrand.no 01
+holtalen.no 01
+holy.jp 08
+home-webserver.de 08
+home.arpa 01
+home.dyndns.org 08
+homebuilt.aero 01
+homedepot 01
+homedns.org 08
+homeftp.net 08
+homeftp.org 08
+homegoods 01
+homeip.net 08
+homelinux.com 08
+homelinux.net 08
+homelinux.org 08
+homes 01
+homesecuritymac.com 08
+homesecuritypc.com 08
+homesense 01
+homesklep.pl 08
+homeunix.com 08
+homeunix.net 08
+homeunix.org 08
+honai.ehime.jp 01
+honbetsu.hokkaido.jp 01
+honda 01
+honefoss.no 01
+hongo.hiroshima.jp 01
+honjo.akita.jp 01
+honjo.saitama.jp 01
+honjyo.akita.jp 01
+hoplix.shop 08
+hopto.me 08
+hopto.org 08
+hornindal.no 01
+horokanai.hokkaido.jp 01
+horonobe.hokkaido.jp 01
+horse 01
+horten.no 01
+hosp.uk 08
+hospital 01
+host 01
+hosted.app 10
+hostedpi.com 08
+hosting 01
+hosting-cluster.nl 08
+hosting.myjino.ru 10
+hosting.ovh.net 10
+hostyhosting.io 08
+hot 01
+hotel 01
+hotel.hu 01
+hotel.lk 01
+hotel.tz 01
+hotels 01
+hotelwithflight.com 08
+hotmail 01
+house 01
+how 01
+hoyanger.no 01
+hoylandet.no 01
+hr 01
+hr.eu.org 08
+hra.health 08
+hrsn.dev 08
+hs.kr 01
+hsbc 01
+ht 01
+httpbin.org 08
+hu 01
+hu.eu.org 08
+hu.net 08
+hughes 01
+huissier-justice.fr 01
+hungry.jp 08
+hungyen.vn 01
+hurdal.no 01
+hurum.no 01
+hvaler.no 01
+hyatt 01
+hyllestad.no 01
+hyogo.jp 01
+hypernode.io 08
+hyuga.miyazaki.jp 01
+hyundai 01
+hzc.io 08
+i.bg 01
+i.ng 01
+i.ph 01
+i.se 01
+i234.me 08
+ia.br 01
+ia.us 01
+ia.ve 01
+iamallama.com 08
+ibara.okayama.jp 01
+ibaraki.ibaraki.jp 01
+ibaraki.jp 01
+ibaraki.osaka.jp 01
+ibestad.no 01
+ibigawa.gifu.jp 01
+ibm 01
+ibr.ec 01
+ibxos.it 08
+ic.gov.pl 01
+icbc 01
+ice 01
+ichiba.tokushima.jp 01
+ichihara.chiba.jp 01
+ichikai.tochigi.jp 01
+ichikawa.chiba.jp 01
+ichikawa.hyogo.jp 01
+ichikawamisato.yamanashi.jp 01
+ichinohe.iwate.jp 01
+ichinomiya.aichi.jp 01
+ichinomiya.chiba.jp 01
+ichinoseki.iwate.jp 01
+icp.net 10
+icp0.io 08
+icp1.io 08
+icu 01
+icurus.jp 08
+id 01
+id.au 01
+id.bd 01
+id.cv 01
+id.firewalledreplit.co 08
+id.fj 01
+id.forgerock.io 08
+id.ir 01
+id.lv 01
+id.ly 01
+id.pub 10
+id.repl.co 08
+id.replit.app 08
+id.replit.dev 08
+id.us 01
+id.vn 01
+ide.kyoto.jp 01
+idf.il 01
+idrett.no 01
+idv.hk 01
+idv.tw 01
+ie 01
+ie.eu.org 08
+ieee 01
+if.ua 01
+ifm 01
+ifr.fr-par.scw.cloud 08
+ifr.nl-ams.scw.cloud 08
+ifr.pl-waw.scw.cloud 08
+iglesias-carbonia.it 01
+iglesiascarbonia.it 01
+iheya.okinawa.jp 01
+iida.nagano.jp 01
+iide.yamagata.jp 01
+iijima.nagano.jp 01
+iitate.fukushima.jp 01
+iiyama.nagano.jp 01
+iizuka.fukuoka.jp 01
+iizuna.nagano.jp 01
+ikano 01
+ikaruga.nara.jp 01
+ikata.ehime.jp 01
+ikawa.akita.jp 01
+ikeda.fukui.jp 01
+ikeda.gifu.jp 01
+ikeda.hokkaido.jp 01
+ikeda.nagano.jp 01
+ikeda.osaka.jp 01
+iki.fi 08
+iki.nagasaki.jp 01
+ikoma.nara.jp 01
+ikusaka.nagano.jp 01
+il 01
+il-central-1.airflow.amazonaws.com 10
+il-central-1.elasticbeanstalk.com 08
+il-central-1.rds.amazonaws.com 10
+il.eu.org 08
+il.us 01
+ilawa.pl 01
+iliadboxos.it 08
+ilovecollege.info 08
+im 01
+im.it 01
+imabari.ehime.jp 01
+imagine-proxy.work 08
+imakane.hokkaido.jp 01
+imamat 01
+imari.saga.jp 01
+imb.br 01
+imdb 01
+imizu.toyama.jp 01
+immo 01
+immobilien 01
+imperia.it 01
+in 01
+in-addr.arpa 01
+in-berlin.de 08
+in-brb.de 08
+in-butter.de 08
+in-dsl.de 08
+in-dsl.net 08
+in-dsl.org 08
+in-the-band.net 08
+in-vpn.de 08
+in-vpn.net 08
+in-vpn.org 08
+in.eu.org 08
+in.futurecms.at 10
+in.net 08
+in.ngrok.io 08
+in.ni 01
+in.rs 01
+in.th 01
+in.ua 01
+in.us 01
+ina.ibaraki.jp 01
+ina.nagano.jp 01
+ina.saitama.jp 01
+inabe.mie.jp 01
+inagawa.hyogo.jp 01
+inagi.tokyo.jp 01
+inami.toyama.jp 01
+inami.wakayama.jp 01
+inashiki.ibaraki.jp 01
+inatsuki.fukuoka.jp 01
+inawashiro.fukushima.jp 01
+inazawa.aichi.jp 01
+inbrowser.dev 10
+inbrowser.link 10
+inc 01
+inc.hk 08
+incheon.kr 01
+ind.br 01
+ind.gt 01
+ind.in 01
+ind.kw 01
+ind.tn 01
+independent-commission.uk 08
+independent-inquest.uk 08
+independent-inquiry.uk 08
+independent-panel.uk 08
+independent-review.uk 08
+inderoy.no 01
+indevs.in 08
+indigena.bo 01
+industria.bo 01
+industries 01
+ine.kyoto.jp 01
+inf.br 01
+inf.cu 01
+inf.mk 01
+inf.ua 08
+infiniti 01
+info 01
+info.at 08
+info.az 01
+info.bb 01
+info.bd 01
+info.bj 01
+info.bo 01
+info.cx 08
+info.ec 01
+info.eg 01
+info.et 01
+info.fj 01
+info.gu 01
+info.ht 01
+info.hu 01
+info.in 01
+info.ke 01
+info.ki 01
+info.la 01
+info.ls 01
+info.ml 01
+info.mv 01
+info.nf 01
+info.ni 01
+info.nr 01
+info.pl 01
+info.pr 01
+info.ro 01
+info.sd 01
+info.tn 01
+info.tr 01
+info.tt 01
+info.tz 01
+info.ve 01
+info.vn 01
+info.zm 01
+ing 01
+ing.pa 01
+ingatlan.hu 01
+ink 01
+ino.kochi.jp 01
+inst.ml 01
+instance.datadetect.com 08
+instances.spawn.cc 08
+institute 01
+insurance 01
+insurance.aero 01
+insure 01
+int 01
+int.apple 08
+int.ar 01
+int.az 01
+int.bo 01
+int.ci 01
+int.cv 01
+int.eu.org 08
+int.in 01
+int.la 01
+int.lk 01
+int.mv 01
+int.mw 01
+int.ni 01
+int.pt 01
+int.ru 08
+int.tj 01
+int.ve 01
+int.vn 01
+international 01
+internet-dns.de 08
+internet.in 01
+intl.tn 01
+intuit 01
+inuyama.aichi.jp 01
+investments 01
+inzai.chiba.jp 01
+io 01
+io.in 01
+io.kr 01
+io.noc.ruhr-uni-bochum.de 08
+io.vn 01
+iobb.net 08
+iopsys.se 08
+ip-ddns.com 08
+ip-dynamic.org 08
+ip.linodeusercontent.com 08
+ip6.arpa 01
+ipfs.nftstorage.link 08
+ipfs.storacha.link 08
+ipfs.w3s.link 08
+ipifony.net 08
+ipiranga 01
+iq 01
+ir 01
+ir.md 08
+iran.liara.run 08
+iris.arpa 01
+irish 01
+iruma.saitama.jp 01
+is 01
+is-a-anarchist.com 08
+is-a-blogger.com 08
+is-a-bookkeeper.com 08
+is-a-bruinsfan.org 08
+is-a-bulls-fan.com 08
+is-a-candidate.org 08
+is-a-caterer.com 08
+is-a-celticsfan.org 08
+is-a-chef.com 08
+is-a-chef.net 08
+is-a-chef.org 08
+is-a-conservative.com 08
+is-a-cpa.com 08
+is-a-cubicle-slave.com 08
+is-a-democrat.com 08
+is-a-designer.com 08
+is-a-doctor.com 08
+is-a-financialadvisor.com 08
+is-a-fullstack.dev 08
+is-a-geek.com 08
+is-a-geek.net 08
+is-a-geek.org 08
+is-a-good.dev 08
+is-a-green.com 08
+is-a-guru.com 08
+is-a-hard-worker.com 08
+is-a-hunter.com 08
+is-a-knight.org 08
+is-a-landscaper.com 08
+is-a-lawyer.com 08
+is-a-liberal.com 08
+is-a-libertarian.com 08
+is-a-linux-user.org 08
+is-a-llama.com 08
+is-a-musician.com 08
+is-a-nascarfan.com 08
+is-a-nurse.com 08
+is-a-painter.com 08
+is-a-patsfan.org 08
+is-a-personaltrainer.com 08
+is-a-photographer.com 08
+is-a-player.com 08
+is-a-republican.com 08
+is-a-rockstar.com 08
+is-a-socialist.com 08
+is-a-soxfan.org 08
+is-a-student.com 08
+is-a-teacher.com 08
+is-a-techie.com 08
+is-a-therapist.com 08
+is-a.dev 08
+is-an-accountant.com 08
+is-an-actor.com 08
+is-an-actress.com 08
+is-an-anarchist.com 08
+is-an-artist.com 08
+is-an-engineer.com 08
+is-an-entertainer.com 08
+is-by.us 08
+is-certified.com 08
+is-cool.dev 08
+is-found.org 08
+is-gone.com 08
+is-into-anime.com 08
+is-into-cars.com 08
+is-into-cartoons.com 08
+is-into-games.com 08
+is-leet.com 08
+is-local.org 08
+is-lost.org 08
+is-not-a.dev 08
+is-not-certified.com 08
+is-saved.org 08
+is-slick.com 08
+is-uberleet.com 08
+is-very-bad.org 08
+is-very-evil.org 08
+is-very-good.org 08
+is-very-nice.org 08
+is-very-sweet.org 08
+is-with-theband.com 08
+is.eu.org 08
+is.gov.pl 01
+is.it 01
+isa-geek.com 08
+isa-geek.net 08
+isa-geek.org 08
+isa-hockeynut.com 08
+isa.kagoshima.jp 01
+isa.us 01
+isahaya.nagasaki.jp 01
+ise.mie.jp 01
+isehara.kanagawa.jp 01
+isen.kagoshima.jp 01
+isernia.it 01
+iserv.dev 08
+iserv.host 08
+iservschule.de 08
+isesaki.gunma.jp 01
+ishigaki.okinawa.jp 01
+ishikari.hokkaido.jp 01
+ishikawa.fukushima.jp 01
+ishikawa.jp 01
+ishikawa.okinawa.jp 01
+ishinomaki.miyagi.jp 01
+isla.pr 01
+ismaili 01
+ispmanager.name 08
+isshiki.aichi.jp 01
+issmarterthanyou.com 08
+ist 01
+istanbul 01
+isteingeek.de 08
+istmein.de 08
+isumi.chiba.jp 01
+it 01
+it.ao 01
+it.bd 01
+it.com 08
+it.eu.org 08
+it.kr 01
+it1.eur.aruba.jenv-aruba.cloud 08
+it1.jenv-aruba.cloud 08
+itabashi.tokyo.jp 01
+itako.ibaraki.jp 01
+itakura.gunma.jp 01
+itami.hyogo.jp 01
+itano.tokushima.jp 01
+itau 01
+itayanagi.aomori.jp 01
+itcouldbewor.se 08
+itigo.jp 08
+ito.shizuoka.jp 01
+itoigawa.niigata.jp 01
+itoman.okinawa.jp 01
+its.me 01
+itv 01
+ivano-frankivsk.ua 01
+ivanovo.su 08
+iveland.no 01
+ivgu.no 01
+ivory.ne.jp 08
+iwade.wakayama.jp 01
+iwafune.tochigi.jp 01
+iwaizumi.iwate.jp 01
+iwaki.fukushima.jp 01
+iwakuni.yamaguchi.jp 01
+iwakura.aichi.jp 01
+iwama.ibaraki.jp 01
+iwamizawa.hokkaido.jp 01
+iwanai.hokkaido.jp 01
+iwanuma.miyagi.jp 01
+iwata.shizuoka.jp 01
+iwate.iwate.jp 01
+iwate.jp 01
+iwatsuki.saitama.jp 01
+iwi.nz 01
+iyo.ehime.jp 01
+iz.hr 01
+izena.okinawa.jp 01
+izu.shizuoka.jp 01
+izumi.kagoshima.jp 01
+izumi.osaka.jp 01
+izumiotsu.osaka.jp 01
+izumisano.osaka.jp 01
+izumizaki.fukushima.jp 01
+izumo.shimane.jp 01
+izumozaki.niigata.jp 01
+izunokuni.shizuoka.jp 01
+j.bg 01
+j.layershift.co.uk 08
+j.scaleforce.com.cy 08
+j.scaleforce.net 08
+jab.br 01
+jaguar 01
+jambyl.su 08
+jampa.br 01
+jan-mayen.no 01
+janeway.replit.dev 08
+java 01
+jaworzno.pl 01
+jc.neen.it 08
+jcb 01
+jcloud-ver-jpc.ik-server.com 08
+jcloud.ik-server.com 08
+jcloud.kz 08
+jdevcloud.com 08
+jdf.br 01
+je 01
+jed.wafaicloud.com 08
+jeep 01
+jeez.jp 08
+jeju.kr 01
+jelastic.dogado.eu 08
+jelastic.saveincloud.net 08
+jelastic.team 08
+jele.cloud 08
+jele.club 08
+jele.host 08
+jele.io 08
+jele.site 08
+jelenia-gora.pl 01
+jellybean.jp 08
+jeonbuk.kr 01
+jeonnam.kr 01
+jessheim.no 01
+jetzt 01
+jevnaker.no 01
+jewelry 01
+jgora.pl 01
+jinsekikogen.hiroshima.jp 01
+jio 01
+jl.cn 01
+jll 01
+jls-sto1.elastx.net 08
+jls-sto2.elastx.net 08
+jls-sto3.elastx.net 08
+jm 02
+jmp 01
+jnj 01
+jo 01
+joboji.iwate.jp 01
+jobs 01
+joburg 01
+joetsu.niigata.jp 01
+jogasz.hu 01
+johana.toyama.jp 01
+joinmc.link 08
+joinville.br 01
+jolster.no 01
+jondal.no 01
+jor.br 01
+jorpeland.no 01
+joso.ibaraki.jp 01
+jot 01
+jote.cloud 08
+jotelulu.cloud 08
+journal.aero 01
+journalist.aero 01
+jouwweb.site 08
+joy 01
+joyo.kyoto.jp 01
+jozi.biz 08
+jp 01
+jp.eu.org 08
+jp.net 08
+jp.ngrok.io 08
+jpmorgan 01
+jpn.com 08
+jpn.org 08
+jprs 01
+js.cn 01
+js.org 08
+js.wpenginepowered.com 08
+ju.mp 08
+juegos 01
+juniper 01
+jur.pro 01
+jus.br 01
+jx.cn 01
+k.bg 01
+k.se 01
+k12.ak.us 01
+k12.al.us 01
+k12.ar.us 01
+k12.as.us 01
+k12.az.us 01
+k12.ca.us 01
+k12.co.us 01
+k12.ct.us 01
+k12.dc.us 01
+k12.ec 01
+k12.fl.us 01
+k12.ga.us 01
+k12.gu.us 01
+k12.ia.us 01
+k12.id.us 01
+k12.il 01
+k12.il.us 01
+k12.in.us 01
+k12.ks.us 01
+k12.ky.us 01
+k12.la.us 01
+k12.ma.us 01
+k12.md.us 01
+k12.me.us 01
+k12.mi.us 01
+k12.mn.us 01
+k12.mo.us 01
+k12.ms.us 01
+k12.mt.us 01
+k12.nc.us 01
+k12.ne.us 01
+k12.nh.us 01
+k12.nj.us 01
+k12.nm.us 01
+k12.nv.us 01
+k12.ny.us 01
+k12.oh.us 01
+k12.ok.us 01
+k12.or.us 01
+k12.pa.us 01
+k12.pr.us 01
+k12.sc.us 01
+k12.tn.us 01
+k12.tr 01
+k12.tx.us 01
+k12.ut.us 01
+k12.va.us 01
+k12.vi 01
+k12.vi.us 01
+k12.vt.us 01
+k12.wa.us 01
+k12.wi.us 01
+k12.wy.us 01
+k8s.fr-par.scw.cloud 08
+k8s.nl-ams.scw.cloud 08
+k8s.pl-waw.scw.cloud 08
+k8s.scw.cloud 08
+kaas.gg 08
+kadena.okinawa.jp 01
+kadogawa.miyazaki.jp 01
+kadoma.osaka.jp 01
+kafjord.no 01
+kafk.fr-par.scw.cloud 08
+kafk.nl-ams.scw.cloud 08
+kafk.pl-waw.scw.cloud 08
+kaga.ishikawa.jp 01
+kagami.kochi.jp 01
+kagamiishi.fukushima.jp 01
+kagamino.okayama.jp 01
+kagawa.jp 01
+kagoshima.jp 01
+kagoshima.kagoshima.jp 01
+kaho.fukuoka.jp 01
+kahoku.ishikawa.jp 01
+kahoku.yamagata.jp 01
+kai.yamanashi.jp 01
+kainan.tokushima.jp 01
+kainan.wakayama.jp 01
+kaisei.kanagawa.jp 01
+kaita.hiroshima.jp 01
+kaizuka.osaka.jp 01
+kakamigahara.gifu.jp 01
+kakegawa.shizuoka.jp 01
+kakinoki.shimane.jp 01
+kakogawa.hyogo.jp 01
+kakuda.miyagi.jp 01
+kalisz.pl 01
+kalmykia.ru 08
+kalmykia.su 08
+kaluga.su 08
+kamagaya.chiba.jp 01
+kamaishi.iwate.jp 01
+kamakura.kanagawa.jp 01
+kameoka.kyoto.jp 01
+kameyama.mie.jp 01
+kami.kochi.jp 01
+kami.miyagi.jp 01
+kamiamakusa.kumamoto.jp 01
+kamifurano.hokkaido.jp 01
+kamigori.hyogo.jp 01
+kamiichi.toyama.jp 01
+kamiizumi.saitama.jp 01
+kamijima.ehime.jp 01
+kamikawa.hokkaido.jp 01
+kamikawa.hyogo.jp 01
+kamikawa.saitama.jp 01
+kamikitayama.nara.jp 01
+kamikoani.akita.jp 01
+kamimine.saga.jp 01
+kaminokawa.tochigi.jp 01
+kaminoyama.yamagata.jp 01
+kamioka.akita.jp 01
+kamisato.saitama.jp 01
+kamishihoro.hokkaido.jp 01
+kamisu.ibaraki.jp 01
+kamisunagawa.hokkaido.jp 01
+kamitonda.wakayama.jp 01
+kamitsue.oita.jp 01
+kamo.kyoto.jp 01
+kamo.niigata.jp 01
+kamoenai.hokkaido.jp 01
+kamogawa.chiba.jp 01
+kanagawa.jp 01
+kanan.osaka.jp 01
+kanazawa.ishikawa.jp 01
+kanegasaki.iwate.jp 01
+kaneyama.fukushima.jp 01
+kaneyama.yamagata.jp 01
+kani.gifu.jp 01
+kanie.aichi.jp 01
+kanmaki.nara.jp 01
+kanna.gunma.jp 01
+kannami.shizuoka.jp 01
+kanonji.kagawa.jp 01
+kanoya.kagoshima.jp 01
+kanra.gunma.jp 01
+kanuma.tochigi.jp 01
+kanzaki.saga.jp 01
+kapsi.fi 08
+karacol.su 08
+karaganda.su 08
+karasjohka.no 01
+karasjok.no 01
+karasuyama.tochigi.jp 01
+karatsu.saga.jp 01
+karelia.su 08
+kariwa.niigata.jp 01
+kariya.aichi.jp 01
+karlsoy.no 01
+karmoy.no 01
+karpacz.pl 01
+kartuzy.pl 01
+karuizawa.nagano.jp 01
+karumai.iwate.jp 01
+kasahara.gifu.jp 01
+kasai.hyogo.jp 01
+kasama.ibaraki.jp 01
+kasamatsu.gifu.jp 01
+kasaoka.okayama.jp 01
+kashiba.nara.jp 01
+kashihara.nara.jp 01
+kashima.ibaraki.jp 01
+kashima.saga.jp 01
+kashiwa.chiba.jp 01
+kashiwara.osaka.jp 01
+kashiwazaki.niigata.jp 01
+kasserver.com 08
+kasuga.fukuoka.jp 01
+kasuga.hyogo.jp 01
+kasugai.aichi.jp 01
+kasukabe.saitama.jp 01
+kasumigaura.ibaraki.jp 01
+kasuya.fukuoka.jp 01
+kaszuby.pl 01
+katagami.akita.jp 01
+katano.osaka.jp 01
+katashina.gunma.jp 01
+katori.chiba.jp 01
+katowice.pl 01
+katsuragi.nara.jp 01
+katsuragi.wakayama.jp 01
+katsushika.tokyo.jp 01
+katsuura.chiba.jp 01
+katsuyama.fukui.jp 01
+kaufen 01
+kautokeino.no 01
+kawaba.gunma.jp 01
+kawachinagano.osaka.jp 01
+kawagoe.mie.jp 01
+kawagoe.saitama.jp 01
+kawaguchi.saitama.jp 01
+kawahara.tottori.jp 01
+kawai.iwate.jp 01
+kawai.nara.jp 01
+kawaiishop.jp 08
+kawajima.saitama.jp 01
+kawakami.nagano.jp 01
+kawakami.nara.jp 01
+kawakita.ishikawa.jp 01
+kawamata.fukushima.jp 01
+kawaminami.miyazaki.jp 01
+kawanabe.kagoshima.jp 01
+kawanehon.shizuoka.jp 01
+kawanishi.hyogo.jp 01
+kawanishi.nara.jp 01
+kawanishi.yamagata.jp 01
+kawara.fukuoka.jp 01
+kawasaki.jp 02
+kawasaki.miyagi.jp 01
+kawatana.nagasaki.jp 01
+kawaue.gifu.jp 01
+kawazu.shizuoka.jp 01
+kayabe.hokkaido.jp 01
+kazimierz-dolny.pl 01
+kazo.saitama.jp 01
+kazuno.akita.jp 01
+kddi 01
+ke 01
+keenetic.io 08
+keenetic.link 08
+keenetic.name 08
+keenetic.pro 08
+keisen.fukuoka.jp 01
+keliweb.cloud 08
+kembuchi.hokkaido.jp 01
+kep.tr 01
+kepno.pl 01
+kerryhotels 01
+kerryproperties 01
+ketrzyn.pl 01
+keymachine.de 08
+keyword-on.net 08
+kfh 01
+kg 01
+kg.kr 01
+kh 02
+kh.ua 01
+khakassia.su 08
+khanhhoa.vn 01
+kharkiv.ua 01
+kharkov.ua 01
+kherson.ua 01
+khmelnitskiy.ua 01
+khmelnytskyi.ua 01
+khplay.nl 08
+ki 01
+kia 01
+kibichuo.okayama.jp 01
+kicks-ass.net 08
+kicks-ass.org 08
+kids 01
+kiengiang.vn 01
+kiev.ua 01
+kiho.mie.jp 01
+kihoku.ehime.jp 01
+kijo.miyazaki.jp 01
+kikirara.jp 08
+kikonai.hokkaido.jp 01
+kikuchi.kumamoto.jp 01
+kikugawa.shizuoka.jp 01
+kill.jp 08
+kilo.jp 08
+kiloapps.ai 08
+kiloapps.io 08
+kim 01
+kim.replit.dev 08
+kimino.wakayama.jp 01
+kimitsu.chiba.jp 01
+kimobetsu.hokkaido.jp 01
+kin.okinawa.jp 01
+kin.one 10
+kin.pub 10
+kindle 01
+kinghost.net 08
+kinko.kagoshima.jp 01
+kinokawa.wakayama.jp 01
+kira.aichi.jp 01
+kira.replit.dev 08
+kirara.st 08
+kirk.replit.dev 08
+kirkenes.no 01
+kirovograd.ua 01
+kiryu.gunma.jp 01
+kisarazu.chiba.jp 01
+kishiwada.osaka.jp 01
+kiso.nagano.jp 01
+kisofukushima.nagano.jp 01
+kisosaki.mie.jp 01
+kita.kyoto.jp 01
+kita.osaka.jp 01
+kita.tokyo.jp 01
+kitaaiki.nagano.jp 01
+kitaakita.akita.jp 01
+kitadaito.okinawa.jp 01
+kitagata.gifu.jp 01
+kitagata.saga.jp 01
+kitagawa.kochi.jp 01
+kitagawa.miyazaki.jp 01
+kitahata.saga.jp 01
+kitahiroshima.hokkaido.jp 01
+kitakami.iwate.jp 01
+kitakata.fukushima.jp 01
+kitakata.miyazaki.jp 01
+kitakyushu.jp 02
+kitami.hokkaido.jp 01
+kitamoto.saitama.jp 01
+kitanakagusuku.okinawa.jp 01
+kitashiobara.fukushima.jp 01
+kitaura.miyazaki.jp 01
+kitayama.wakayama.jp 01
+kitchen 01
+kiwa.mie.jp 01
+kiwi 01
+kiwi.nz 01
+kiyama.saga.jp 01
+kiyokawa.kanagawa.jp 01
+kiyosato.hokkaido.jp 01
+kiyose.tokyo.jp 01
+kiyosu.aichi.jp 01
+kizu.kyoto.jp 01
+klabu.no 01
+klepp.no 01
+klodzko.pl 01
+km 01
+km.ua 01
+kmpsp.gov.pl 01
+kn 01
+knightpoint.systems 08
+knowsitall.info 08
+knx-server.net 08
+kobayashi.miyazaki.jp 01
+kobe.jp 02
+kobierzyce.pl 01
+kochi.jp 01
+kochi.kochi.jp 01
+kodaira.tokyo.jp 01
+koeln 01
+kofu.yamanashi.jp 01
+koga.fukuoka.jp 01
+koga.ibaraki.jp 01
+koganei.tokyo.jp 01
+koge.tottori.jp 01
+koka.shiga.jp 01
+kokonoe.oita.jp 01
+kokubunji.tokyo.jp 01
+kolobrzeg.pl 01
+komae.tokyo.jp 01
+komagane.nagano.jp 01
+komaki.aichi.jp 01
+komatsu 01
+komatsu.ishikawa.jp 01
+komatsushima.tokushima.jp 01
+komforb.se 01
+kommunalforbund.se 01
+kommune.no 01
+komono.mie.jp 01
+komoro.nagano.jp 01
+komvux.se 01
+konan.aichi.jp 01
+konan.shiga.jp 01
+kongsberg.no 01
+kongsvinger.no 01
+konin.pl 01
+konskowola.pl 01
+konsulat.gov.pl 01
+kontum.vn 01
+konyvelo.hu 01
+koobin.events 08
+koori.fukushima.jp 01
+kop.id 01
+kopervik.no 01
+koriyama.fukushima.jp 01
+koryo.nara.jp 01
+kosai.shizuoka.jp 01
+kosaka.akita.jp 01
+kosei.shiga.jp 01
+kosher 01
+koshigaya.saitama.jp 01
+koshimizu.hokkaido.jp 01
+koshu.yamanashi.jp 01
+kosuge.yamanashi.jp 01
+kota.aichi.jp 01
+koto.shiga.jp 01
+koto.tokyo.jp 01
+kotohira.kagawa.jp 01
+kotoura.tottori.jp 01
+kouhoku.saga.jp 01
+kounosu.saitama.jp 01
+kouyama.kagoshima.jp 01
+kouzushima.tokyo.jp 01
+koya.wakayama.jp 01
+koza.wakayama.jp 01
+kozagawa.wakayama.jp 01
+kozaki.chiba.jp 01
+kozow.com 08
+kp 01
+kpmg 01
+kpn 01
+kppsp.gov.pl 01
+kr 01
+kr.eu.org 08
+kr.it 01
+kr.ua 01
+kraanghke.no 01
+kragero.no 01
+krakow.pl 08
+krasnik.pl 08
+krasnodar.su 08
+krd 01
+kred 01
+krellian.net 08
+kristiansand.no 01
+kristiansund.no 01
+krodsherad.no 01
+krokstadelva.no 01
+kropyvnytskyi.ua 01
+krym.ua 01
+ks.ua 01
+ks.us 01
+kuchinotsu.nagasaki.jp 01
+kudamatsu.yamaguchi.jp 01
+kudoyama.wakayama.jp 01
+kui.hiroshima.jp 01
+kuji.iwate.jp 01
+kuju.oita.jp 01
+kujukuri.chiba.jp 01
+kuki.saitama.jp 01
+kuleuven.cloud 08
+kumagaya.saitama.jp 01
+kumakogen.ehime.jp 01
+kumamoto.jp 01
+kumamoto.kumamoto.jp 01
+kumano.hiroshima.jp 01
+kumano.mie.jp 01
+kumatori.osaka.jp 01
+kumejima.okinawa.jp 01
+kumenan.okayama.jp 01
+kumiyama.kyoto.jp 01
+kunden.ortsinfo.at 10
+kunigami.okinawa.jp 01
+kunimi.fukushima.jp 01
+kunisaki.oita.jp 01
+kunitachi.tokyo.jp 01
+kunitomi.miyazaki.jp 01
+kunneppu.hokkaido.jp 01
+kunohe.iwate.jp 01
+kuokgroup 01
+kurashiki.okayama.jp 01
+kurate.fukuoka.jp 01
+kure.hiroshima.jp 01
+kurgan.su 08
+kuriyama.hokkaido.jp 01
+kurobe.toyama.jp 01
+kurogi.fukuoka.jp 01
+kuroishi.aomori.jp 01
+kuroiso.tochigi.jp 01
+kuromatsunai.hokkaido.jp 01
+kuron.jp 08
+kurotaki.nara.jp 01
+kurume.fukuoka.jp 01
+kusatsu.gunma.jp 01
+kusatsu.shiga.jp 01
+kushima.miyazaki.jp 01
+kushimoto.wakayama.jp 01
+kushiro.hokkaido.jp 01
+kustanai.ru 08
+kustanai.su 08
+kusu.oita.jp 01
+kutchan.hokkaido.jp 01
+kutno.pl 01
+kuwana.mie.jp 01
+kuzumaki.iwate.jp 01
+kv.ua 01
+kvafjord.no 01
+kvalsund.no 01
+kvam.no 01
+kvanangen.no 01
+kvinesdal.no 01
+kvinnherad.no 01
+kviteseid.no 01
+kvitsoy.no 01
+kw 01
+kwp.gov.pl 01
+kwpsp.gov.pl 01
+ky 01
+ky.us 01
+kyiv.ua 01
+kyonan.chiba.jp 01
+kyotamba.kyoto.jp 01
+kyotanabe.kyoto.jp 01
+kyotango.kyoto.jp 01
+kyoto 01
+kyoto.jp 01
+kyowa.akita.jp 01
+kyowa.hokkaido.jp 01
+kyuragi.saga.jp 01
+kz 01
+l-o-g-i-n.de 08
+l.bg 01
+l.se 01
+la 01
+la-spezia.it 01
+la.us 01
+la1-plenit.com 08
+laakesvuemie.no 01
+labeling.ap-northeast-1.sagemaker.aws 08
+labeling.ap-northeast-2.sagemaker.aws 08
+labeling.ap-south-1.sagemaker.aws 08
+labeling.ap-southeast-1.sagemaker.aws 08
+labeling.ap-southeast-2.sagemaker.aws 08
+labeling.ca-central-1.sagemaker.aws 08
+labeling.eu-central-1.sagemaker.aws 08
+labeling.eu-west-1.sagemaker.aws 08
+labeling.eu-west-2.sagemaker.aws 08
+labeling.us-east-1.sagemaker.aws 08
+labeling.us-east-2.sagemaker.aws 08
+labeling.us-west-2.sagemaker.aws 08
+lacaixa 01
+ladesk.com 08
+lahppi.no 01
+laichau.vn 01
+lakas.hu 01
+lambda-url.af-south-1.on.aws 08
+lambda-url.ap-east-1.on.aws 08
+lambda-url.ap-northeast-1.on.aws 08
+lambda-url.ap-northeast-2.on.aws 08
+lambda-url.ap-northeast-3.on.aws 08
+lambda-url.ap-south-1.on.aws 08
+lambda-url.ap-southeast-1.on.aws 08
+lambda-url.ap-southeast-2.on.aws 08
+lambda-url.ap-southeast-3.on.aws 08
+lambda-url.ca-central-1.on.aws 08
+lambda-url.eu-central-1.on.aws 08
+lambda-url.eu-north-1.on.aws 08
+lambda-url.eu-south-1.on.aws 08
+lambda-url.eu-west-1.on.aws 08
+lambda-url.eu-west-2.on.aws 08
+lambda-url.eu-west-3.on.aws 08
+lambda-url.me-south-1.on.aws 08
+lambda-url.sa-east-1.on.aws 08
+lambda-url.us-east-1.on.aws 08
+lambda-url.us-east-2.on.aws 08
+lambda-url.us-west-1.on.aws 08
+lambda-url.us-west-2.on.aws 08
+lamborghini 01
+lamdong.vn 01
+lamer 01
+lanbib.se 01
+land 01
+land-4-sale.us 08
+landing.myjino.ru 10
+landrover 01
+langevag.no 01
+langson.vn 01
+lanxess 01
+laocai.vn 01
+lapy.pl 01
+laquila.it 01
+laravel.cloud 08
+lardal.no 01
+larvik.no 01
+lasalle 01
+laspezia.it 01
+lat 01
+lat.ec 01
+latina.it 01
+latino 01
+latrobe 01
+lavagis.no 01
+lavangen.no 01
+law 01
+law.pro 01
+law.za 01
+lawyer 01
+laz.it 01
+lazio.it 01
+lb 01
+lc 01
+lc.it 01
+lcl.dev 10
+lclstage.dev 10
+lcube-server.de 08
+lds 01
+le.it 01
+leadpages.co 08
+leangaviika.no 01
+leapcell.app 08
+leapcell.dev 08
+leapcell.online 08
+lease 01
+leasing.aero 01
+lebesby.no 01
+lebork.pl 01
+lebtimnetz.de 08
+lecce.it 01
+lecco.it 01
+leclerc 01
+leczna.pl 08
+lefrak 01
+leg.br 01
+legal 01
+legnica.pl 01
+lego 01
+leikanger.no 01
+leilao.br 01
+leirfjord.no 01
+leirvik.no 01
+leitungsen.de 08
+leka.no 01
+leksvik.no 01
+lel.br 01
+lenug.su 08
+lenvik.no 01
+lerdal.no 01
+lesja.no 01
+levanger.no 01
+lexus 01
+lezajsk.pl 01
+lg.biz.ng 08
+lg.jp 01
+lg.ua 01
+lgbt 01
+li 01
+li.it 01
+liara.run 08
+lib.ak.us 01
+lib.al.us 01
+lib.ar.us 01
+lib.as.us 01
+lib.az.us 01
+lib.ca.us 01
+lib.co.us 01
+lib.ct.us 01
+lib.dc.us 01
+lib.ee 01
+lib.fl.us 01
+lib.ga.us 01
+lib.gu.us 01
+lib.hi.us 01
+lib.ia.us 01
+lib.id.us 01
+lib.il.us 01
+lib.in.us 01
+lib.ks.us 01
+lib.ky.us 01
+lib.la.us 01
+lib.ma.us 01
+lib.md.us 01
+lib.me.us 01
+lib.mi.us 01
+lib.mn.us 01
+lib.mo.us 01
+lib.mt.us 01
+lib.nc.us 01
+lib.nd.us 01
+lib.ne.us 01
+lib.nh.us 01
+lib.nj.us 01
+lib.nm.us 01
+lib.nv.us 01
+lib.ny.us 01
+lib.oh.us 01
+lib.ok.us 01
+lib.or.us 01
+lib.pa.us 01
+lib.pr.us 01
+lib.ri.us 01
+lib.sc.us 01
+lib.sd.us 01
+lib.tn.us 01
+lib.tx.us 01
+lib.ut.us 01
+lib.va.us 01
+lib.vi.us 01
+lib.vt.us 01
+lib.wa.us 01
+lib.wi.us 01
+lib.wy.us 01
+libp2p.direct 08
+lidl 01
+lier.no 01
+lierne.no 01
+life 01
+lifeinsurance 01
+lifestyle 01
+lig.it 01
+lighting 01
+liguria.it 01
+like 01
+likes-pie.com 08
+likescandy.com 08
+lillehammer.no 01
+lillesand.no 01
+lilly 01
+lima-city.at 08
+lima-city.ch 08
+lima-city.de 08
+lima-city.rocks 08
+lima.zone 08
+limanowa.pl 01
+limited 01
+limo 01
+lincoln 01
+lindas.no 01
+lindesnes.no 01
+link 01
+linkyard-cloud.ch 08
+linkyard.cloud 08
+linodeobjects.com 10
+littlestar.jp 08
+live 01
+live-on.net 08
+live-website.com 08
+living 01
+livorno.it 01
+lk 01
+llc 01
+llp 01
+ln.cn 01
+lo.it 01
+loabat.no 01
+loan 01
+loans 01
+localcert.net 08
+localplayer.dev 08
+localto.net 10
+localtonet.com 08
+locker 01
+locus 01
+lodi.it 01
+lodingen.no 01
+lodz.pl 08
+log.br 01
+loginline.app 08
+loginline.dev 08
+loginline.io 08
+loginline.services 08
+loginline.site 08
+loginto.me 08
+logistics.aero 01
+logoip.com 08
+logoip.de 08
+lohmus.me 08
+loisirs.bj 01
+loj.ec 01
+lol 01
+lolipop.io 08
+lolipopmc.jp 08
+lolitapunk.jp 08
+lom.it 01
+lom.no 01
+lombardia.it 01
+lombardy.it 01
+lomo.jp 08
+lomza.pl 01
+lon-1.paas.massivegrid.net 08
+lon-2.paas.massivegrid.net 08
+london 01
+london.cloudapps.digital 08
+londrina.br 01
+longan.vn 01
+loppa.no 01
+lorenskog.no 01
+loseyourip.com 08
+loten.no 01
+lotte 01
+lotto 01
+lovable.app 08
+lovable.run 08
+lovable.sh 08
+lovableproject.com 08
+love 01
+lovepop.jp 08
+lovesick.jp 08
+lowicz.pl 01
+lp.dev 08
+lpages.co 08
+lpg.objectstorage.ch 08
+lpl 01
+lplfinancial 01
+lpusercontent.com 08
+lr 01
+ls 01
+lt 01
+lt.eu.org 08
+lt.it 01
+lt.ua 01
+ltd 01
+ltd.co.im 01
+ltd.cy 01
+ltd.gi 01
+ltd.hk 08
+ltd.lk 01
+ltd.ng 08
+ltd.ua 08
+ltd.uk 01
+ltda 01
+lu 01
+lu.eu.org 08
+lu.it 01
+lubartow.pl 08
+lubin.pl 01
+lublin.pl 08
+lucania.it 01
+lucca.it 01
+lug.org.uk 08
+lugansk.ua 01
+lugs.org.uk 08
+luhansk.ua 01
+lukow.pl 01
+lund.no 01
+lundbeck 01
+lunner.no 01
+luroy.no 01
+luster.no 01
+lutrausercontent.com 10
+lutsk.ua 01
+luxe 01
+luxury 01
+luyani.app 08
+luyani.net 08
+lv 01
+lv.eu.org 08
+lv.ua 01
+lviv.ua 01
+ly 01
+lyngdal.no 01
+lyngen.no 01
+lynx.mythic-beasts.com 08
+m.bg 01
+m.se 01
+ma 01
+ma.gov.br 01
+ma.leg.br 08
+ma.us 01
+macapa.br 01
+maceio.br 01
+macerata.it 01
+machida.tokyo.jp 01
+madethis.site 08
+madrid 01
+maebashi.gunma.jp 01
+mafelo.net 08
+magazine.aero 01
+magentosite.cloud 10
+magicpatterns.app 08
+magicpatternsapp.com 08
+maibara.shiga.jp 01
+maif 01
+mail-box.ne.jp 08
+mail.pl 01
+main.jp 08
+maintenance.aero 01
+maison 01
+maizuru.kyoto.jp 01
+makeup 01
+makinohara.shizuoka.jp 01
+makurazaki.kagoshima.jp 01
+malatvuopmi.no 01
+malbork.pl 01
+malopolska.pl 01
+malselv.no 01
+malvik.no 01
+mamurogawa.yamagata.jp 01
+man 01
+management 01
+manaus.br 01
+mandal.no 01
+mango 01
+mangyshlak.su 08
+maniwa.okayama.jp 01
+manno.kagawa.jp 01
+mantova.it 01
+maori.nz 01
+map 01
+map.fastly.net 08
+map.fastlylb.net 08
+mar.it 01
+marche.it 01
+marine.ru 08
+maringa.br 01
+marker.no 01
+market 01
+marketing 01
+marketplace.aero 01
+markets 01
+marnardal.no 01
+marriott 01
+marshalls 01
+marugame.kagawa.jp 01
+marumori.miyagi.jp 01
+masaki.ehime.jp 01
+masfjorden.no 01
+mashike.hokkaido.jp 01
+mashiki.kumamoto.jp 01
+mashiko.tochigi.jp 01
+masoy.no 01
+massa-carrara.it 01
+massacarrara.it 01
+masuda.shimane.jp 01
+mat.br 01
+matera.it 01
+matlab.cloud 08
+matrix.jp 08
+matsubara.osaka.jp 01
+matsubushi.saitama.jp 01
+matsuda.kanagawa.jp 01
+matsudo.chiba.jp 01
+matsue.shimane.jp 01
+matsukawa.nagano.jp 01
+matsumae.hokkaido.jp 01
+matsumoto.kagoshima.jp 01
+matsumoto.nagano.jp 01
+matsuno.ehime.jp 01
+matsusaka.mie.jp 01
+matsushige.tokushima.jp 01
+matsushima.miyagi.jp 01
+matsuura.nagasaki.jp 01
+matsuyama.ehime.jp 01
+matsuzaki.shizuoka.jp 01
+matta-varjjat.no 01
+mattel 01
+mayfirst.info 08
+mayfirst.org 08
+mazeplay.com 08
+mazowsze.pl 01
+mazury.pl 01
+mb.ca 01
+mb.it 01
+mba 01
+mc 01
+mc.it 01
+mcdir.me 08
+mcdir.ru 08
+mckinsey 01
+mcpre.ru 08
+md 01
+md.us 01
+me 01
+me-central-1.airflow.amazonaws.com 10
+me-central-1.elasticbeanstalk.com 08
+me-central-1.rds.amazonaws.com 10
+me-south-1.airflow.amazonaws.com 10
+me-south-1.elasticbeanstalk.com 08
+me-south-1.rds.amazonaws.com 10
+me.eg 01
+me.eu.org 08
+me.in 01
+me.it 01
+me.ke 01
+me.kr 01
+me.scot 08
+me.so 01
+me.ss 01
+me.tz 01
+me.uk 01
+me.us 01
+med 01
+med.br 01
+med.ec 01
+med.ee 01
+med.ht 01
+med.ly 01
+med.om 01
+med.pa 01
+med.pl 08
+med.pro 01
+med.sa 01
+med.sd 01
+medecin.fr 08
+medecin.km 01
+media 01
+media.aero 01
+media.hu 01
+media.pl 01
+media.strapiapp.com 08
+mediatech.by 08
+mediatech.dev 08
+medicina.bo 01
+medio-campidano.it 01
+mediocampidano.it 01
+medusajs.app 08
+meet 01
+meguro.tokyo.jp 01
+mein-iserv.de 08
+meinforum.net 08
+meiwa.gunma.jp 01
+meiwa.mie.jp 01
+mel.cloudlets.com.au 08
+meland.no 01
+melbourne 01
+meldal.no 01
+melhus.no 01
+meloy.no 01
+members.linode.com 08
+meme 01
+memorial 01
+memset.net 08
+men 01
+menu 01
+meraker.no 01
+merck 01
+merckmsd 01
+merseine.nu 08
+messerli.app 08
+messina.it 01
+messwithdns.com 08
+meteorapp.com 08
+mex.com 08
+mg 01
+mg.gov.br 01
+mg.leg.br 08
+mgdb.fr-par.scw.cloud 08
+mgdb.nl-ams.scw.cloud 08
+mgdb.pl-waw.scw.cloud 08
+mh 01
+mi.it 01
+mi.th 01
+mi.us 01
+miami 01
+miasa.nagano.jp 01
+miasta.pl 01
+mibu.tochigi.jp 01
+microlight.aero 01
+microsoft 01
+midori.chiba.jp 01
+midori.gunma.jp 01
+midsund.no 01
+midtre-gauldal.no 01
+mie.jp 01
+mielec.pl 01
+mielno.pl 01
+mifune.kumamoto.jp 01
+migration.run 10
+mihama.aichi.jp 01
+mihama.chiba.jp 01
+mihama.fukui.jp 01
+mihama.mie.jp 01
+mihama.wakayama.jp 01
+mihara.hiroshima.jp 01
+mihara.kochi.jp 01
+miharu.fukushima.jp 01
+miho.ibaraki.jp 01
+mikasa.hokkaido.jp 01
+mikawa.yamagata.jp 01
+miki.hyogo.jp 01
+mil 01
+mil.ac 01
+mil.ae 01
+mil.al 01
+mil.ar 01
+mil.az 01
+mil.ba 01
+mil.bd 01
+mil.bo 01
+mil.br 01
+mil.by 01
+mil.cl 01
+mil.cn 01
+mil.co 01
+mil.cy 01
+mil.do 01
+mil.ec 01
+mil.eg 01
+mil.fj 01
+mil.gh 01
+mil.gt 01
+mil.hn 01
+mil.id 01
+mil.in 01
+mil.io 01
+mil.iq 01
+mil.jo 01
+mil.kg 01
+mil.km 01
+mil.kr 01
+mil.kz 01
+mil.lv 01
+mil.mg 01
+mil.mv 01
+mil.my 01
+mil.mz 01
+mil.ng 01
+mil.ni 01
+mil.no 01
+mil.nz 01
+mil.pe 01
+mil.ph 01
+mil.pl 01
+mil.py 01
+mil.qa 01
+mil.ru 08
+mil.rw 01
+mil.sh 01
+mil.st 01
+mil.sy 01
+mil.tj 01
+mil.tm 01
+mil.to 01
+mil.tr 01
+mil.tt 01
+mil.tw 01
+mil.tz 01
+mil.ug 01
+mil.uy 01
+mil.vc 01
+mil.ve 01
+mil.ye 01
+mil.za 01
+mil.zm 01
+mil.zw 01
+milan.it 01
+milano.it 01
+mima.tokushima.jp 01
+mimata.miyazaki.jp 01
+mimoza.jp 08
+minakami.gunma.jp 01
+minamata.kumamoto.jp 01
+minami-alps.yamanashi.jp 01
+minami.fukuoka.jp 01
+minami.kyoto.jp 01
+minami.tokushima.jp 01
+minamiaiki.nagano.jp 01
+minamiashigara.kanagawa.jp 01
+minamiawaji.hyogo.jp 01
+minamiboso.chiba.jp 01
+minamidaito.okinawa.jp 01
+minamiechizen.fukui.jp 01
+minamifurano.hokkaido.jp 01
+minamiise.mie.jp 01
+minamiizu.shizuoka.jp 01
+minamimaki.nagano.jp 01
+minamiminowa.nagano.jp 01
+minamioguni.kumamoto.jp 01
+minamisanriku.miyagi.jp 01
+minamitane.kagoshima.jp 01
+minamiuonuma.niigata.jp 01
+minamiyamashiro.kyoto.jp 01
+minano.saitama.jp 01
+minato.osaka.jp 01
+minato.tokyo.jp 01
+mincom.tn 01
+mine.nu 08
+mini 01
+miniserver.com 08
+minisite.ms 08
+mino.gifu.jp 01
+minobu.yamanashi.jp 01
+minoh.osaka.jp 01
+minokamo.gifu.jp 01
+minowa.nagano.jp 01
+mint 01
+mints.ne.jp 08
+mircloud.host 08
+mircloud.ru 08
+mircloud.us 08
+miren.app 08
+miren.systems 08
+misaki.okayama.jp 01
+misaki.osaka.jp 01
+misasa.tottori.jp 01
+misato.akita.jp 01
+misato.miyagi.jp 01
+misato.saitama.jp 01
+misato.shimane.jp 01
+misato.wakayama.jp 01
+misawa.aomori.jp 01
+misconfused.org 08
+mishima.fukushima.jp 01
+mishima.shizuoka.jp 01
+misugi.mie.jp 01
+mit 01
+mitaka.tokyo.jp 01
+mitake.gifu.jp 01
+mitane.akita.jp 01
+mito.ibaraki.jp 01
+mitou.yamaguchi.jp 01
+mitoyo.kagawa.jp 01
+mitsubishi 01
+mitsue.nara.jp 01
+mitsuke.niigata.jp 01
+mittwald.info 08
+mittwaldserver.info 08
+miura.kanagawa.jp 01
+miyada.nagano.jp 01
+miyagi.jp 01
+miyake.nara.jp 01
+miyako.fukuoka.jp 01
+miyako.iwate.jp 01
+miyakonojo.miyazaki.jp 01
+miyama.fukuoka.jp 01
+miyama.mie.jp 01
+miyashiro.saitama.jp 01
+miyawaka.fukuoka.jp 01
+miyazaki.jp 01
+miyazaki.miyazaki.jp 01
+miyazu.kyoto.jp 01
+miyoshi.aichi.jp 01
+miyoshi.hiroshima.jp 01
+miyoshi.saitama.jp 01
+miyoshi.tokushima.jp 01
+miyota.nagano.jp 01
+mizuho.tokyo.jp 01
+mizumaki.fukuoka.jp 01
+mizunami.gifu.jp 01
+mizusawa.iwate.jp 01
+mjondalen.no 01
+mk 01
+mk.eu.org 08
+mk.ua 01
+mktg.ec 01
+ml 01
+mlb 01
+mlbfan.org 08
+mls 01
+mm 02
+mma 01
+mmafan.biz 08
+mmv.kr 08
+mn 01
+mn.it 01
+mn.us 01
+mo 01
+mo-i-rana.no 01
+mo-siemens.io 08
+mo.cn 01
+mo.it 01
+mo.us 01
+moareke.no 01
+mobara.chiba.jp 01
+mobi 01
+mobi.gp 01
+mobi.ke 01
+mobi.ng 01
+mobi.tz 01
+mobile 01
+mocha-sandbox.dev 08
+mocha.app 08
+mochausercontent.com 08
+mochizuki.nagano.jp 01
+mock.pstmn.io 08
+mod.gi 01
+moda 01
+modalen.no 01
+modelling.aero 01
+modelscape.com 08
+modena.it 01
+mods.jp 08
+modum.no 01
+modx.dev 08
+moe 01
+moi 01
+moka.tochigi.jp 01
+mokuren.ne.jp 08
+mol.it 01
+molde.no 01
+molise.it 01
+mom 01
+mombetsu.hokkaido.jp 01
+mon.ec 01
+monash 01
+mond.jp 08
+money 01
+money.bj 01
+mongolian.jp 08
+monster 01
+monza-brianza.it 01
+monza-e-della-brianza.it 01
+monza.it 01
+monzabrianza.it 01
+monzaebrianza.it 01
+monzaedellabrianza.it 01
+moo.jp 08
+moonscale.io 10
+moonscale.net 08
+mordovia.ru 08
+mordovia.su 08
+morena.br 01
+moriguchi.osaka.jp 01
+morimachi.shizuoka.jp 01
+morioka.iwate.jp 01
+moriya.ibaraki.jp 01
+moriyama.shiga.jp 01
+moriyoshi.akita.jp 01
+mormon 01
+morotsuka.miyazaki.jp 01
+moroyama.saitama.jp 01
+mortgage 01
+moscow 01
+moseushi.hokkaido.jp 01
+mosjoen.no 01
+moskenes.no 01
+moss.no 01
+motegi.tochigi.jp 01
+moto 01
+motobu.okinawa.jp 01
+motorcycles 01
+motosu.gifu.jp 01
+motoyama.kochi.jp 01
+mov 01
+movie 01
+movimiento.bo 01
+mp 01
+mp.br 01
+mq 01
+mr 01
+mr.no 01
+mragowo.pl 01
+mrap.accesspoint.s3-global.amazonaws.com 08
+ms 01
+ms.gov.br 01
+ms.it 01
+ms.kr 01
+ms.leg.br 08
+ms.us 01
+msd 01
+msk.ru 08
+msk.su 08
+mt 01
+mt.eu.org 08
+mt.gov.br 01
+mt.it 01
+mt.leg.br 08
+mt.us 01
+mtls.run.app 10
+mtn 01
+mtr 01
+mu 01
+mugi.tokushima.jp 01
+muika.niigata.jp 01
+mukawa.hokkaido.jp 01
+muko.kyoto.jp 01
+munakata.fukuoka.jp 01
+muni.il 01
+muosat.no 01
+mup.gov.pl 01
+murakami.niigata.jp 01
+murata.miyagi.jp 01
+murayama.yamagata.jp 01
+murmansk.su 08
+muroran.hokkaido.jp 01
+muroto.kochi.jp 01
+mus.br 01
+mus.mi.us 01
+musashimurayama.tokyo.jp 01
+musashino.tokyo.jp 01
+museum 01
+museum.mv 01
+museum.no 01
+museum.om 01
+music 01
+musica.ar 01
+musica.bo 01
+musician.io 08
+mutsu.aomori.jp 01
+mutsuzawa.chiba.jp 01
+mutual.ar 01
+mv 01
+mw 01
+mw.gov.pl 01
+mwcloudnonprod.com 08
+mx 01
+mx-central-1.rds.amazonaws.com 10
+my 01
+my-firewall.org 08
+my-gateway.de 08
+my-router.de 08
+my.at 08
+my.canva.site 08
+my.canvasite.cn 08
+my.de 08
+my.eu.org 08
+my.id 01
+myactivedirectory.com 08
+myaddr.dev 08
+myaddr.io 08
+myaddr.tools 08
+myamaze.net 08
+myasustor.com 08
+mycloudnas.com 08
+mydatto.com 08
+mydatto.net 08
+mydbserver.com 08
+myddns.rocks 08
+mydissent.net 08
+mydns.bz 08
+mydns.jp 08
+mydns.tw 08
+mydns.vc 08
+mydobiss.com 08
+myds.me 08
+myeffect.net 08
+myfast.host 08
+myfast.space 08
+myfirewall.org 08
+myforum.community 08
+myfritz.link 08
+myfritz.net 08
+myftp.biz 08
+myftp.org 08
+myhome-server.de 08
+myiphost.com 08
+myjino.ru 08
+mykolaiv.ua 01
+mymailer.com.tw 08
+mymediapc.net 08
+mynascloud.com 08
+myoko.niigata.jp 01
+mypep.link 08
+mypets.ws 08
+myphotos.cc 08
+mypi.co 08
+mypsx.net 08
+myqnapcloud.cn 08
+myqnapcloud.com 08
+myradweb.net 08
+myrdbx.io 08
+mysecuritycamera.com 08
+mysecuritycamera.net 08
+mysecuritycamera.org 08
+myshopblocks.com 08
+myshopify.com 08
+myspreadshop.at 08
+myspreadshop.be 08
+myspreadshop.ca 08
+myspreadshop.ch 08
+myspreadshop.co.uk 08
+myspreadshop.com 08
+myspreadshop.com.au 08
+myspreadshop.de 08
+myspreadshop.dk 08
+myspreadshop.es 08
+myspreadshop.fi 08
+myspreadshop.fr 08
+myspreadshop.ie 08
+myspreadshop.it 08
+myspreadshop.net 08
+myspreadshop.nl 08
+myspreadshop.no 08
+myspreadshop.pl 08
+myspreadshop.se 08
+mysynology.net 08
+mytabit.co.il 08
+mytabit.com 08
+mytis.ru 08
+mytuleap.com 08
+myvnc.com 08
+mywire.org 08
+mz 01
+n.bg 01
+n.se 01
+na 01
+na.it 01
+na4u.ru 08
+naamesjevuemie.no 01
+nab 01
+nabari.mie.jp 01
+nachikatsuura.wakayama.jp 01
+nagahama.shiga.jp 01
+nagai.yamagata.jp 01
+nagano.jp 01
+nagano.nagano.jp 01
+naganohara.gunma.jp 01
+nagaoka.niigata.jp 01
+nagaokakyo.kyoto.jp 01
+nagara.chiba.jp 01
+nagareyama.chiba.jp 01
+nagasaki.jp 01
+nagasaki.nagasaki.jp 01
+nagasu.kumamoto.jp 01
+nagato.yamaguchi.jp 01
+nagatoro.saitama.jp 01
+nagawa.nagano.jp 01
+nagi.okayama.jp 01
+nagiso.nagano.jp 01
+nago.okinawa.jp 01
+nagoya 01
+nagoya.jp 02
+naha.okinawa.jp 01
+nahari.kochi.jp 01
+naie.hokkaido.jp 01
+naka.hiroshima.jp 01
+naka.ibaraki.jp 01
+nakadomari.aomori.jp 01
+nakagawa.fukuoka.jp 01
+nakagawa.hokkaido.jp 01
+nakagawa.nagano.jp 01
+nakagawa.tokushima.jp 01
+nakagusuku.okinawa.jp 01
+nakagyo.kyoto.jp 01
+nakai.kanagawa.jp 01
+nakama.fukuoka.jp 01
+nakamichi.yamanashi.jp 01
+nakamura.kochi.jp 01
+nakaniikawa.toyama.jp 01
+nakano.nagano.jp 01
+nakano.tokyo.jp 01
+nakanojo.gunma.jp 01
+nakanoto.ishikawa.jp 01
+nakasatsunai.hokkaido.jp 01
+nakatane.kagoshima.jp 01
+nakatombetsu.hokkaido.jp 01
+nakatsugawa.gifu.jp 01
+nakayama.yamagata.jp 01
+nakijin.okinawa.jp 01
+naklo.pl 01
+nalchik.ru 08
+nalchik.su 08
+namaste.jp 08
+namdalseid.no 01
+namdinh.vn 01
+name 01
+name.az 01
+name.eg 01
+name.et 01
+name.fj 01
+name.hr 01
+name.mk 01
+name.mv 01
+name.my 01
+name.ng 01
+name.pm 08
+name.pr 01
+name.qa 01
+name.tj 01
+name.tr 01
+name.tt 01
+name.vn 01
+namegata.ibaraki.jp 01
+namegawa.saitama.jp 01
+namerikawa.toyama.jp 01
+namie.fukushima.jp 01
+namikata.ehime.jp 01
+namsos.no 01
+namsskogan.no 01
+nanae.hokkaido.jp 01
+nanao.ishikawa.jp 01
+nanbu.tottori.jp 01
+nanbu.yamanashi.jp 01
+nango.fukushima.jp 01
+nanjo.okinawa.jp 01
+nankoku.kochi.jp 01
+nanmoku.gunma.jp 01
+nannestad.no 01
+nanporo.hokkaido.jp 01
+nantan.kyoto.jp 01
+nanto.toyama.jp 01
+nanyo.yamagata.jp 01
+naoshima.kagawa.jp 01
+naples.it 01
+napoli.it 01
+nara.jp 01
+nara.nara.jp 01
+narashino.chiba.jp 01
+narita.chiba.jp 01
+naroy.no 01
+narusawa.yamanashi.jp 01
+naruto.tokushima.jp 01
+narviika.no 01
+narvik.no 01
+nasu.tochigi.jp 01
+nasushiobara.tochigi.jp 01
+nat.cu 01
+nat.tn 01
+natal.br 01
+natori.miyagi.jp 01
+natural.bo 01
+naturbruksgymn.se 01
+naustdal.no 01
+navigation.aero 01
+navoi.su 08
+navuotna.no 01
+navy 01
+nayoro.hokkaido.jp 01
+nb.ca 01
+nba 01
+nc 01
+nc.tr 01
+nc.us 01
+nd.us 01
+ne 01
+ne.jp 01
+ne.ke 01
+ne.kr 01
+ne.tz 01
+ne.ug 01
+ne.us 01
+neat-url.com 08
+nec 01
+nedre-eiker.no 01
+needle.run 08
+nemuro.hokkaido.jp 01
+nerdpol.ovh 08
+nerima.tokyo.jp 01
+nes.akershus.no 01
+nes.buskerud.no 01
+nesna.no 01
+nesodden.no 01
+nesoddtangen.no 01
+nesseby.no 01
+nesset.no 01
+net 01
+net-freaks.com 08
+net.ac 01
+net.ae 01
+net.af 01
+net.ag 01
+net.ai 01
+net.al 01
+net.am 01
+net.ar 01
+net.au 01
+net.az 01
+net.ba 01
+net.bb 01
+net.bd 01
+net.bh 01
+net.bj 01
+net.bm 01
+net.bn 01
+net.bo 01
+net.br 01
+net.bs 01
+net.bt 01
+net.bw 01
+net.bz 01
+net.ci 01
+net.cm 01
+net.cn 01
+net.co 01
+net.cu 01
+net.cv 01
+net.cw 01
+net.cy 01
+net.dm 01
+net.do 01
+net.dz 01
+net.ec 01
+net.eg 01
+net.et 01
+net.eu.org 08
+net.fj 01
+net.fm 01
+net.ge 01
+net.gg 01
+net.gh 01
+net.gl 01
+net.gn 01
+net.gp 01
+net.gr 01
+net.gt 01
+net.gu 01
+net.gy 01
+net.hk 01
+net.hn 01
+net.ht 01
+net.id 01
+net.il 01
+net.im 01
+net.in 01
+net.io 01
+net.iq 01
+net.ir 01
+net.je 01
+net.jo 01
+net.kg 01
+net.ki 01
+net.kn 01
+net.kw 01
+net.ky 01
+net.kz 01
+net.la 01
+net.lb 01
+net.lc 01
+net.lk 01
+net.lr 01
+net.ls 01
+net.lv 01
+net.ly 01
+net.ma 01
+net.me 01
+net.mk 01
+net.ml 01
+net.mo 01
+net.ms 01
+net.mt 01
+net.mu 01
+net.mv 01
+net.mw 01
+net.mx 01
+net.my 01
+net.mz 01
+net.na 01
+net.nf 01
+net.ng 01
+net.ni 01
+net.nr 01
+net.nz 01
+net.om 01
+net.pa 01
+net.pe 01
+net.ph 01
+net.pk 01
+net.pl 01
+net.pn 01
+net.pr 01
+net.ps 01
+net.pt 01
+net.py 01
+net.qa 01
+net.ru 08
+net.rw 01
+net.sa 01
+net.sb 01
+net.sc 01
+net.sd 01
+net.sg 01
+net.sh 01
+net.sl 01
+net.so 01
+net.ss 01
+net.st 01
+net.sy 01
+net.th 01
+net.tj 01
+net.tm 01
+net.tn 01
+net.to 01
+net.tr 01
+net.tt 01
+net.tw 01
+net.ua 01
+net.uk 01
+net.uy 01
+net.uz 01
+net.vc 01
+net.ve 01
+net.vi 01
+net.vn 01
+net.vu 01
+net.ws 01
+net.ye 01
+net.za 01
+net.zm 01
+netbank 01
+netflix 01
+netgamers.jp 08
+netlib.re 08
+netlify.app 08
+nett.to 08
+network 01
+neustar 01
+new 01
+news 01
+news.hu 01
+next 01
+nextdirect 01
+nexus 01
+neyagawa.osaka.jp 01
+nf 01
+nf.ca 01
+nfl 01
+nflfan.org 08
+nfshost.com 08
+ng 01
+ng.eu.org 08
+nghean.vn 01
+ngo 01
+ngo.lk 01
+ngo.ng 08
+ngo.ph 01
+ngo.us 08
+ngo.za 01
+ngrok-free.app 08
+ngrok-free.dev 08
+ngrok.app 08
+ngrok.dev 08
+ngrok.io 08
+ngrok.pizza 08
+ngrok.pro 08
+nh-serv.co.uk 08
+nh.us 01
+nhk 01
+nhlfan.net 08
+nhs.uk 01
+ni 01
+nic.in 01
+nic.tj 01
+nic.za 01
+nichinan.miyazaki.jp 01
+nichinan.tottori.jp 01
+nico 01
+nieruchomosci.pl 01
+niigata.jp 01
+niigata.niigata.jp 01
+niihama.ehime.jp 01
+niikappu.hokkaido.jp 01
+niimi.okayama.jp 01
+niiza.saitama.jp 01
+nikaho.akita.jp 01
+nike 01
+niki.hokkaido.jp 01
+nikita.jp 08
+nikko.tochigi.jp 01
+nikolaev.ua 01
+nikon 01
+nimsite.uk 08
+ninhbinh.vn 01
+ninhthuan.vn 01
+ninja 01
+ninohe.iwate.jp 01
+ninomiya.kanagawa.jp 01
+nirasaki.yamanashi.jp 01
+nis.za 01
+nishi.fukuoka.jp 01
+nishi.osaka.jp 01
+nishiaizu.fukushima.jp 01
+nishiarita.saga.jp 01
+nishiawakura.okayama.jp 01
+nishiazai.shiga.jp 01
+nishigo.fukushima.jp 01
+nishihara.kumamoto.jp 01
+nishihara.okinawa.jp 01
+nishiizu.shizuoka.jp 01
+nishikata.tochigi.jp 01
+nishikatsura.yamanashi.jp 01
+nishikawa.yamagata.jp 01
+nishimera.miyazaki.jp 01
+nishinomiya.hyogo.jp 01
+nishinoomote.kagoshima.jp 01
+nishinoshima.shimane.jp 01
+nishio.aichi.jp 01
+nishiokoppe.hokkaido.jp 01
+nishitosa.kochi.jp 01
+nishiwaki.hyogo.jp 01
+nissan 01
+nissay 01
+nissedal.no 01
+nisshin.aichi.jp 01
+niteroi.br 01
+nittedal.no 01
+niyodogawa.kochi.jp 01
+nj.us 01
+njs.jelastic.vps-host.net 08
+nl 01
+nl-ams-1.baremetal.scw.cloud 08
+nl.ca 01
+nl.eu.org 08
+nl.no 01
+nm.cn 01
+nm.us 01
+no 01
+no-ip.biz 08
+no-ip.ca 08
+no-ip.co.uk 08
+no-ip.info 08
+no-ip.net 08
+no-ip.org 08
+no.eu.org 08
+no.it 01
+nobeoka.miyazaki.jp 01
+noboribetsu.hokkaido.jp 01
+nobushi.jp 08
+noda.chiba.jp 01
+noda.iwate.jp 01
+nodebalancer.linode.com 10
+nodes.k8s.fr-par.scw.cloud 08
+nodes.k8s.nl-ams.scw.cloud 08
+nodes.k8s.pl-waw.scw.cloud 08
+nog.community 08
+nogata.fukuoka.jp 01
+nogi.tochigi.jp 01
+noheji.aomori.jp 01
+noho.st 08
+nohost.me 08
+noip.me 08
+noip.us 08
+nokia 01
+nom.ag 01
+nom.br 02
+nom.co 01
+nom.es 01
+nom.fr 01
+nom.io 01
+nom.km 01
+nom.mg 01
+nom.nc 01
+nom.ni 01
+nom.pa 01
+nom.pe 01
+nom.pl 01
+nom.ro 01
+nom.tm 01
+nom.ve 01
+nom.za 01
+nombre.bo 01
+nome.cv 01
+nome.pt 01
+nomi.ishikawa.jp 01
+nonoichi.ishikawa.jp 01
+noop.app 08
+noor.jp 08
+nord-aurdal.no 01
+nord-fron.no 01
+nord-odal.no 01
+norddal.no 01
+nordeste-idc.saveincloud.net 08
+nordkapp.no 01
+nordre-land.no 01
+nordreisa.no 01
+nore-og-uvdal.no 01
+north-kazakhstan.su 08
+northflank.app 10
+norton 01
+nose.osaka.jp 01
+nosegawa.nara.jp 01
+noshiro.akita.jp 01
+not.br 01
+notaires.fr 08
+notaires.km 01
+notebook-fips.ca-central-1.sagemaker.aws 08
+notebook-fips.ca-west-1.sagemaker.aws 08
+notebook-fips.us-east-1.sagemaker.aws 08
+notebook-fips.us-east-2.sagemaker.aws 08
+notebook-fips.us-gov-east-1.sagemaker.aws 08
+notebook-fips.us-gov-west-1.sagemaker.aws 08
+notebook-fips.us-west-1.sagemaker.aws 08
+notebook-fips.us-west-2.sagemaker.aws 08
+notebook.af-south-1.sagemaker.aws 08
+notebook.ap-east-1.sagemaker.aws 08
+notebook.ap-northeast-1.sagemaker.aws 08
+notebook.ap-northeast-2.sagemaker.aws 08
+notebook.ap-northeast-3.sagemaker.aws 08
+notebook.ap-south-1.sagemaker.aws 08
+notebook.ap-south-2.sagemaker.aws 08
+notebook.ap-southeast-1.sagemaker.aws 08
+notebook.ap-southeast-2.sagemaker.aws 08
+notebook.ap-southeast-3.sagemaker.aws 08
+notebook.ap-southeast-4.sagemaker.aws 08
+notebook.ca-central-1.sagemaker.aws 08
+notebook.ca-west-1.sagemaker.aws 08
+notebook.cn-north-1.sagemaker.com.cn 08
+notebook.cn-northwest-1.sagemaker.com.cn 08
+notebook.eu-central-1.sagemaker.aws 08
+notebook.eu-central-2.sagemaker.aws 08
+notebook.eu-north-1.sagemaker.aws 08
+notebook.eu-south-1.sagemaker.aws 08
+notebook.eu-south-2.sagemaker.aws 08
+notebook.eu-west-1.sagemaker.aws 08
+notebook.eu-west-2.sagemaker.aws 08
+notebook.eu-west-3.sagemaker.aws 08
+notebook.il-central-1.sagemaker.aws 08
+notebook.me-central-1.sagemaker.aws 08
+notebook.me-south-1.sagemaker.aws 08
+notebook.sa-east-1.sagemaker.aws 08
+notebook.us-east-1.sagemaker.aws 08
+notebook.us-east-2.sagemaker.aws 08
+notebook.us-gov-east-1.sagemaker.aws 08
+notebook.us-gov-west-1.sagemaker.aws 08
+notebook.us-west-1.sagemaker.aws 08
+notebook.us-west-2.sagemaker.aws 08
+noticeable.news 08
+noticias.bo 01
+notion.site 08
+noto.ishikawa.jp 01
+notodden.no 01
+notogawa.shiga.jp 01
+notteroy.no 01
+nov.ru 08
+nov.su 08
+novara.it 01
+novecore.site 08
+now 01
+now-dns.net 08
+now-dns.org 08
+now.sh 08
+nowaruda.pl 01
+nowruz 01
+nowtv 01
+nozawaonsen.nagano.jp 01
+np 02
+nr 01
+nra 01
+nrw 01
+ns.ca 01
+nsn.us 01
+nsupdate.info 08
+nsw.au 01
+nsw.edu.au 01
+nt.au 01
+nt.ca 01
+nt.edu.au 01
+nt.no 01
+nt.ro 01
+ntdll.top 08
+ntr.br 01
+ntr.ec 01
+ntt 01
+nu 01
+nu.ca 01
+nu.it 01
+numata.gunma.jp 01
+numata.hokkaido.jp 01
+numazu.shizuoka.jp 01
+nuoro.it 01
+nv.us 01
+nx.cn 01
+nx.gw 08
+nxa.eu 10
+ny-1.paas.massivegrid.net 08
+ny-2.paas.massivegrid.net 08
+ny.us 01
+nyanta.jp 08
+nyat.app 08
+nyc 01
+nyc.mn 08
+nysa.pl 01
+nyuzen.toyama.jp 01
+nz 01
+nz.basketball 08
+nz.eu.org 08
+o.bg 01
+o.se 01
+o0o0.jp 08
+o365.cloud.nospamproxy.com 08
+oaiusercontent.com 10
+oamishirasato.chiba.jp 01
+oarai.ibaraki.jp 01
+obama.fukui.jp 01
+obama.nagasaki.jp 01
+obanazawa.yamagata.jp 01
+obi 01
+obihiro.hokkaido.jp 01
+obira.hokkaido.jp 01
+obj.ag 08
+objects.lp.dev 08
+objects.lpg.cloudscale.ch 08
+objects.rma.cloudscale.ch 08
+obninsk.su 08
+observablehq.cloud 08
+observer 01
+obu.aichi.jp 01
+obuse.nagano.jp 01
+ocelot.mythic-beasts.com 08
+ochi.kochi.jp 01
+oci.customer-oci.com 10
+ocp.customer-oci.com 10
+ocs.customer-oci.com 10
+od.ua 01
+odate.akita.jp 01
+odawara.kanagawa.jp 01
+odda.no 01
+odesa.ua 01
+odessa.ua 01
+odo.br 01
+odo.replit.dev 08
+odont.ec 01
+oe.yamagata.jp 01
+of.by 01
+of.je 08
+of.no 01
+off.ai 01
+office 01
+office-on-the.net 08
+official.academy 08
+official.ec 08
+ofunato.iwate.jp 01
+og.ao 01
+og.it 01
+oga.akita.jp 01
+ogaki.gifu.jp 01
+ogano.saitama.jp 01
+ogasawara.tokyo.jp 01
+ogata.akita.jp 01
+ogawa.ibaraki.jp 01
+ogawa.nagano.jp 01
+ogawa.saitama.jp 01
+ogawara.miyagi.jp 01
+ogi.saga.jp 01
+ogimi.okinawa.jp 01
+ogliastra.it 01
+ogori.fukuoka.jp 01
+ogose.saitama.jp 01
+oguchi.aichi.jp 01
+oguni.kumamoto.jp 01
+oguni.yamagata.jp 01
+oh.us 01
+oharu.aichi.jp 01
+ohda.shimane.jp 01
+ohi.fukui.jp 01
+ohira.miyagi.jp 01
+ohira.tochigi.jp 01
+ohkura.yamagata.jp 01
+ohtawara.tochigi.jp 01
+oi.kanagawa.jp 01
+oia.gov.pl 01
+oirase.aomori.jp 01
+oirm.gov.pl 01
+oishida.yamagata.jp 01
+oiso.kanagawa.jp 01
+oita.jp 01
+oita.oita.jp 01
+oizumi.gunma.jp 01
+oji.nara.jp 01
+ojiya.niigata.jp 01
+ok.us 01
+okagaki.fukuoka.jp 01
+okawa.fukuoka.jp 01
+okawa.kochi.jp 01
+okaya.nagano.jp 01
+okayama.jp 01
+okayama.okayama.jp 01
+okazaki.aichi.jp 01
+oke.gov.pl 01
+okegawa.saitama.jp 01
+oketo.hokkaido.jp 01
+oki.fukuoka.jp 01
+okinawa 01
+okinawa.jp 01
+okinawa.okinawa.jp 01
+okinoshima.shimane.jp 01
+okoppe.hokkaido.jp 01
+oksnes.no 01
+okuizumo.shimane.jp 01
+okuma.fukushima.jp 01
+okutama.tokyo.jp 01
+ol.no 01
+olawa.pl 01
+olayan 01
+olayangroup 01
+olbia-tempio.it 01
+olbiatempio.it 01
+olecko.pl 01
+olkusz.pl 01
+ollo 01
+olsztyn.pl 01
+om 01
+omachi.nagano.jp 01
+omachi.saga.jp 01
+omaezaki.shizuoka.jp 01
+omasvuotna.no 01
+ome.tokyo.jp 01
+omega 01
+omg.lol 08
+omi.nagano.jp 01
+omi.niigata.jp 01
+omigawa.chiba.jp 01
+omihachiman.shiga.jp 01
+omitama.ibaraki.jp 01
+omiya.saitama.jp 01
+omniwe.site 08
+omotego.fukushima.jp 01
+omura.nagasaki.jp 01
+omuta.fukuoka.jp 01
+on-acorn.io 10
+on-aptible.com 08
+on-fleek.app 08
+on-forge.com 08
+on-k3s.io 10
+on-rancher.cloud 10
+on-rio.io 10
+on-the-web.tv 08
+on-vapor.com 08
+on-web.fr 08
+on.biz.ng 08
+on.ca 01
+on.crisp.email 08
+onagawa.miyagi.jp 01
+oncilla.mythic-beasts.com 08
+ondigitalocean.app 08
+one 01
+onfabrica.com 08
+ong 01
+ong.br 01
+onga.fukuoka.jp 01
+onhercules.app 08
+onid.ca 08
+oninferno.net 08
+onion 01
+onjuku.chiba.jp 01
+onl 01
+online 01
+online.th 08
+onna.okinawa.jp 01
+ono.fukui.jp 01
+ono.fukushima.jp 01
+ono.hyogo.jp 01
+onojo.fukuoka.jp 01
+onomichi.hiroshima.jp 01
+onporter.run 08
+onrender.com 08
+onthewifi.com 08
+onza.mythic-beasts.com 08
+ooguy.com 08
+ookuwa.nagano.jp 01
+ooo 01
+oops.jp 08
+ooshika.nagano.jp 01
+oow.gov.pl 01
+opal.ne.jp 08
+open 01
+opencraft.hosting 08
+opensocial.site 08
+operaunite.com 08
+opik.net 08
+opoczno.pl 01
+opole.pl 01
+oppdal.no 01
+oppegard.no 01
+or.at 01
+or.bi 01
+or.ci 01
+or.cr 01
+or.id 01
+or.it 01
+or.jp 01
+or.ke 01
+or.kr 01
+or.mu 01
+or.th 01
+or.tz 01
+or.ug 01
+or.us 01
+ora.gunma.jp 01
+oracle 01
+oraclecloudapps.com 10
+oraclegovcloudapps.com 10
+oraclegovcloudapps.uk 10
+orange 01
+orangecloud.tn 08
+org 01
+org.ac 01
+org.ae 01
+org.af 01
+org.ag 01
+org.ai 01
+org.al 01
+org.am 01
+org.ao 01
+org.ar 01
+org.au 01
+org.az 01
+org.ba 01
+org.bb 01
+org.bd 01
+org.bh 01
+org.bi 01
+org.bj 01
+org.bm 01
+org.bn 01
+org.bo 01
+org.br 01
+org.bs 01
+org.bt 01
+org.bw 01
+org.bz 01
+org.ci 01
+org.cn 01
+org.co 01
+org.cu 01
+org.cv 01
+org.cw 01
+org.cy 01
+org.dm 01
+org.do 01
+org.dz 01
+org.ec 01
+org.ee 01
+org.eg 01
+org.es 01
+org.et 01
+org.fj 01
+org.fm 01
+org.ge 01
+org.gg 01
+org.gh 01
+org.gi 01
+org.gl 01
+org.gn 01
+org.gp 01
+org.gr 01
+org.gt 01
+org.gu 01
+org.gy 01
+org.hk 01
+org.hn 01
+org.ht 01
+org.hu 01
+org.il 01
+org.im 01
+org.in 01
+org.io 01
+org.iq 01
+org.ir 01
+org.je 01
+org.jo 01
+org.kg 01
+org.ki 01
+org.km 01
+org.kn 01
+org.kp 01
+org.kw 01
+org.ky 01
+org.kz 01
+org.la 01
+org.lb 01
+org.lc 01
+org.lk 01
+org.lr 01
+org.ls 01
+org.lv 01
+org.ly 01
+org.ma 01
+org.me 01
+org.mg 01
+org.mk 01
+org.ml 01
+org.mn 01
+org.mo 01
+org.ms 01
+org.mt 01
+org.mu 01
+org.mv 01
+org.mw 01
+org.mx 01
+org.my 01
+org.mz 01
+org.na 01
+org.ng 01
+org.ni 01
+org.nr 01
+org.nz 01
+org.om 01
+org.pa 01
+org.pe 01
+org.pf 01
+org.ph 01
+org.pk 01
+org.pl 01
+org.pn 01
+org.pr 01
+org.ps 01
+org.pt 01
+org.py 01
+org.qa 01
+org.ro 01
+org.rs 01
+org.ru 08
+org.rw 01
+org.sa 01
+org.sb 01
+org.sc 01
+org.scot 08
+org.sd 01
+org.se 01
+org.sg 01
+org.sh 01
+org.sk 01
+org.sl 01
+org.sn 01
+org.so 01
+org.ss 01
+org.st 01
+org.sv 01
+org.sy 01
+org.sz 01
+org.tj 01
+org.tm 01
+org.tn 01
+org.to 01
+org.tr 01
+org.tt 01
+org.tw 01
+org.ua 01
+org.ug 01
+org.uk 01
+org.uy 01
+org.uz 01
+org.vc 01
+org.ve 01
+org.vi 01
+org.vn 01
+org.vu 01
+org.ws 01
+org.ye 01
+org.yt 08
+org.za 01
+org.zm 01
+org.zw 01
+organic 01
+origins 01
+oristano.it 01
+orkanger.no 01
+orkdal.no 01
+orland.no 01
+orsites.com 08
+orskog.no 01
+orsta.no 01
+orx.biz 08
+os.hedmark.no 01
+os.hordaland.no 01
+osaka 01
+osaka.jp 01
+osakasayama.osaka.jp 01
+osaki.miyagi.jp 01
+osakikamijima.hiroshima.jp 01
+osasco.br 01
+oschr.gov.pl 01
+osen.no 01
+oseto.nagasaki.jp 01
+oshima.tokyo.jp 01
+oshima.yamaguchi.jp 01
+oshino.yamanashi.jp 01
+oshu.iwate.jp 01
+oslo.no 01
+osoyro.no 01
+osteroy.no 01
+ostre-toten.no 01
+ostroda.pl 01
+ostroleka.pl 01
+ostrowiec.pl 01
+ostrowwlkp.pl 01
+ot.it 01
+ota.gunma.jp 01
+ota.tokyo.jp 01
+otake.hiroshima.jp 01
+otaki.chiba.jp 01
+otaki.nagano.jp 01
+otaki.saitama.jp 01
+otama.fukushima.jp 01
+otap.co 10
+otari.nagano.jp 01
+otaru.hokkaido.jp 01
+ote.bj 01
+other.nf 01
+oto.fukuoka.jp 01
+otobe.hokkaido.jp 01
+otofuke.hokkaido.jp 01
+otoineppu.hokkaido.jp 01
+otoyo.kochi.jp 01
+otsu.shiga.jp 01
+otsuchi.iwate.jp 01
+otsuka 01
+otsuki.kochi.jp 01
+otsuki.yamanashi.jp 01
+ott 01
+ouchi.saga.jp 01
+ouda.nara.jp 01
+oum.gov.pl 01
+oumu.hokkaido.jp 01
+outsystemscloud.com 08
+overhalla.no 01
+ovh 01
+ovre-eiker.no 01
+owani.aomori.jp 01
+owariasahi.aichi.jp 01
+own.pm 08
+ownip.net 08
+ownprovider.com 08
+owo.codes 10
+ox.rs 08
+oxa.cloud 08
+oy.lc 08
+oya.to 08
+oyabe.toyama.jp 01
+oyama.tochigi.jp 01
+oyamazaki.kyoto.jp 01
+oyer.no 01
+oygarden.no 01
+oyodo.nara.jp 01
+oystre-slidre.no 01
+oz.au 01
+ozora.hokkaido.jp 01
+ozu.ehime.jp 01
+ozu.kumamoto.jp 01
+p.bg 01
+p.se 01
+p.tawk.email 08
+p.tawkto.email 08
+pa 01
+pa.crm.dev 10
+pa.gov.br 01
+pa.gov.pl 01
+pa.it 01
+pa.leg.br 08
+pa.us 01
+paas.beebyte.io 08
+paas.datacenter.fi 08
+paas.hosted-by-previder.com 08
+paas.massivegrid.com 08
+pabianice.pl 08
+padova.it 01
+padua.it 01
+page 01
+pages-research.it.hs-heilbronn.de 08
+pages.dev 08
+pages.gay 08
+pages.it.hs-heilbronn.de 08
+pages.torproject.net 08
+pages.wiardweb.com 08
+pagespeedmobilizer.com 08
+pagexl.com 08
+palermo.it 01
+palmas.br 01
+panasonic 01
+panel.dev 08
+panel.gg 08
+pantheonsite.io 08
+parachuting.aero 01
+paragliding.aero 01
+parallel.jp 08
+parasite.jp 08
+paris 01
+paris.replit.dev 08
+parliament.nz 01
+parma.it 01
+paroch.k12.ma.us 01
+pars 01
+parti.se 01
+partners 01
+parts 01
+party 01
+passenger-association.aero 01
+patria.bo 01
+pavia.it 01
+pay 01
+paynow.cx 08
+paywhirl.com 10
+pb.ao 01
+pb.crm.dev 10
+pb.gov.br 01
+pb.leg.br 08
+pc.crm.dev 10
+pc.it 01
+pc.pl 01
+pccw 01
+pd.crm.dev 10
+pd.it 01
+pdns.page 08
+pe 01
+pe.ca 01
+pe.crm.dev 10
+pe.gov.br 01
+pe.it 01
+pe.kr 01
+pe.leg.br 08
+pecori.jp 08
+peewee.jp 08
+penne.jp 08
+penza.su 08
+pepper.jp 08
+per.jo 01
+per.la 01
+per.nf 01
+perma.jp 08
+perso.ht 01
+perso.tn 01
+perspecta.cloud 08
+perugia.it 01
+pesaro-urbino.it 01
+pesarourbino.it 01
+pescara.it 01
+pet 01
+pf 01
+pf.crm.dev 10
+pfizer 01
+pg 02
+pg.in 01
+pg.it 01
+pgafan.net 08
+pgfog.com 08
+pgw.jp 08
+ph 01
+pharmacien.fr 08
+pharmaciens.km 01
+pharmacy 01
+phd 01
+phd.jo 01
+philips 01
+phone 01
+photo 01
+photography 01
+photos 01
+phutho.vn 01
+phuyen.vn 01
+phx.enscaled.us 08
+physio 01
+pi.gov.br 01
+pi.it 01
+pi.leg.br 08
+piacenza.it 01
+picard.replit.dev 08
+pics 01
+pictet 01
+pictures 01
+pid 01
+piedmont.it 01
+piemonte.it 01
+pigboat.jp 08
+pike.replit.dev 08
+pila.pl 01
+pilot.aero 01
+pimienta.org 08
+pin 01
+pinb.gov.pl 01
+ping 01
+pink 01
+pinoko.jp 08
+pioneer 01
+pippu.hokkaido.jp 01
+pisa.it 01
+pistoia.it 01
+pisz.pl 01
+pivohosting.com 08
+piw.gov.pl 01
+pixolino.com 08
+pizza 01
+pk 01
+pl 01
+pl.eu.org 08
+pl.ua 01
+place 01
+platformsh.site 10
+platter-app.dev 08
+play 01
+playit.plus 08
+playstation 01
+playstation-cloud.com 08
+plc.co.im 01
+plc.ly 01
+plc.ng 08
+plc.uk 01
+plesk.page 08
+pleskns.com 08
+pley.games 08
+plo.ps 01
+plock.pl 08
+plumbing 01
+plurinacional.bo 01
+plus 01
+pm 01
+pmn.it 01
+pn 01
+pn.it 01
+pnc 01
+po.gov.pl 01
+po.it 01
+poa.br 01
+podhale.pl 01
+podlasie.pl 01
+podzone.net 08
+podzone.org 08
+pohl 01
+point2this.com 08
+pointto.us 08
+poivron.org 08
+poker 01
+pokrovsk.su 08
+pol.dz 01
+pol.ht 01
+pol.tr 01
+police.uk 01
+politica.bo 01
+politie 01
+polkowice.pl 01
+poltava.ua 01
+polyspace.com 08
+pomorskie.pl 01
+pomorze.pl 01
+poniatowa.pl 08
+ponpes.id 01
+pordenone.it 01
+porn 01
+porsanger.no 01
+porsangu.no 01
+porsgrunn.no 01
+port.fr 08
+post 01
+post.in 01
+postman-echo.com 08
+potager.org 08
+potenza.it 01
+powiat.pl 01
+poznan.pl 08
+pp.az 01
+pp.ru 08
+pp.se 01
+pp.ua 08
+ppg.br 01
+pr 01
+pr.gov.br 01
+pr.gov.pl 01
+pr.it 01
+pr.leg.br 08
+pr.ml 01
+pr.us 01
+prato.it 01
+praxi 01
+prd.fr 01
+prd.km 01
+prd.mg 01
+prequalifyme.today 08
+prerelease.replit.dev 08
+press 01
+press.aero 01
+press.cy 01
+press.ma 01
+press.se 01
+presse.km 01
+presse.ml 01
+preview.csb.app 08
+preview.emergentagent.com 08
+preview.site 08
+pri.ee 01
+prime 01
+primetel.cloud 08
+principe.st 01
+priv.at 08
+priv.hu 01
+priv.instances.scw.cloud 08
+priv.me 01
+priv.no 01
+priv.pl 01
+private.repost.aws 10
+privatelink.snowflake.app 10
+privatizehealthinsurance.net 08
+pro 01
+pro.az 01
+pro.br 01
+pro.cy 01
+pro.ec 01
+pro.fj 01
+pro.ht 01
+pro.in 01
+pro.mv 01
+pro.om 01
+pro.pr 01
+pro.tt 01
+pro.typeform.com 08
+pro.vn 01
+prochowice.pl 01
+prod 01
+production.aero 01
+productions 01
+prof 01
+prof.ec 01
+prof.pr 01
+profesional.bo 01
+progressive 01
+project.space 08
+promo 01
+properties 01
+property 01
+protection 01
+protonet.io 08
+pru 01
+prudential 01
+pruszkow.pl 01
+prvcy.page 08
+prvw.eu 08
+przeworsk.pl 01
+ps 01
+psc.br 01
+psi.br 01
+psic.ec 01
+psiq.ec 01
+psp.gov.pl 01
+psse.gov.pl 01
+pstmn.io 08
+pt 01
+pt.eu.org 08
+pt.it 01
+pu.it 01
+pub 01
+pub.ec 01
+pub.instances.scw.cloud 08
+pub.sa 01
+publ.cv 01
+publ.pt 01
+public-inquiry.uk 08
+pubtls.org 08
+pueblo.bo 01
+pug.it 01
+puglia.it 01
+pulawy.pl 01
+punyu.jp 08
+pup.gov.pl 01
+pupu.jp 08
+pussycat.jp 08
+pv.it 01
+pvh.br 01
+pvt.ge 01
+pvt.k12.ma.us 01
+pw 01
+pwc 01
+py 01
+pya.jp 08
+pyatigorsk.ru 08
+pymnt.uk 08
+pythonanywhere.com 08
+pz.it 01
+q.bg 01
+qa 01
+qa2.com 08
+qbuser.com 08
+qc.ca 01
+qcx.io 08
+qh.cn 01
+qld.au 01
+qld.edu.au 01
+qld.gov.au 01
+qoto.io 08
+qpon 01
+qsl.br 01
+qualifioapp.com 08
+qualyhqpartner.com 10
+qualyhqportal.com 10
+quangbinh.vn 01
+quangnam.vn 01
+quangngai.vn 01
+quangninh.vn 01
+quangtri.vn 01
+quebec 01
+quest 01
+quicksytes.com 08
+quipelements.com 10
+quizzes.cx 08
+qzz.io 08
+r.appspot.com 10
+r.bg 01
+r.cdn77.net 08
+r.cloud.int.apple 10
+r.se 01
+r2.dev 08
+ra.it 01
+racing 01
+rackmaze.com 08
+rackmaze.net 08
+rade.no 01
+radio 01
+radio.am 08
+radio.br 01
+radio.fm 08
+radom.pl 01
+radoy.no 01
+raffleentry.org.uk 08
+rag-cloud-ch.hosteur.com 08
+rag-cloud.hosteur.com 08
+ragusa.it 01
+rahkkeravju.no 01
+raholt.no 01
+raindrop.jp 08
+raisa.no 01
+rakkestad.no 01
+ralingen.no 01
+rana.no 01
+randaberg.no 01
+rankoshi.hokkaido.jp 01
+ranzan.saitama.jp 01
+rar.ve 01
+ras.ru 08
+rauma.no 01
+ravendb.cloud 08
+ravendb.community 08
+ravendb.run 08
+ravenna.it 01
+ravpage.co.il 08
+raw.icp0.io 10
+raw.icp1.io 10
+rawa-maz.pl 01
+rc.it 01
+rdb.fr-par.scw.cloud 08
+rdb.nl-ams.scw.cloud 08
+rdb.pl-waw.scw.cloud 08
+rds.cn-north-1.amazonaws.com.cn 10
+rds.cn-northwest-1.amazonaws.com.cn 10
+rdy.jp 08
+re 01
+re.it 01
+re.kr 01
+read 01
+read-books.org 08
+readmyblog.org 08
+readthedocs-hosted.com 08
+readthedocs.io 08
+readymade.jp 08
+realestate 01
+realestate.pl 01
+realm.cz 08
+realtime.supabase.co 08
+realtor 01
+realty 01
+rebun.hokkaido.jp 01
+rec.br 01
+rec.nf 01
+rec.ro 01
+rec.ve 01
+recht.pro 01
+recife.br 01
+recipes 01
+recreation.aero 01
+red 01
+red.sv 01
+redirectme.net 08
+redumbrella 01
+reed.replit.dev 08
+reg.dk 08
+reggio-calabria.it 01
+reggio-emilia.it 01
+reggiocalabria.it 01
+reggioemilia.it 01
+rehab 01
+reise 01
+reisen 01
+reit 01
+reklam.hu 01
+rel.ht 01
+rel.pl 01
+relay.evervault.app 08
+relay.evervault.dev 08
+reliance 01
+remotewd.com 08
+ren 01
+rendalen.no 01
+rennebu.no 01
+rennesoy.no 01
+rent 01
+rentals 01
+rep.br 01
+rep.kp 01
+repair 01
+repbody.aero 01
+repl.co 08
+repl.run 08
+replit.app 08
+replit.dev 08
+report 01
+republican 01
+res.aero 01
+res.in 01
+research.aero 01
+researched.cx 08
+reservd.com 08
+reservd.dev.thingdust.io 08
+reservd.disrec.thingdust.io 08
+reservd.testing.thingdust.io 08
+reserve-online.com 08
+reserve-online.net 08
+resindevice.io 08
+rest 01
+restaurant 01
+restaurant.bj 01
+resto.bj 01
+review 01
+reviews 01
+revista.bo 01
+rexroth 01
+rg.it 01
+rgr.jp 08
+rhcloud.com 08
+ri.it 01
+ri.us 01
+ribeirao.br 01
+ric.jelastic.vps-host.net 08
+rice-labs.com 08
+rich 01
+richardli 01
+ricoh 01
+rieti.it 01
+rifu.miyagi.jp 01
+riik.ee 01
+riker.replit.dev 08
+rikubetsu.hokkaido.jp 01
+rikuzentakata.iwate.jp 01
+ril 01
+rimini.it 01
+rindal.no 01
+ringebu.no 01
+ringerike.no 01
+ringsaker.no 01
+rio 01
+rio.br 01
+rio.ec 01
+riobranco.br 01
+riopreto.br 01
+rip 01
+rishiri.hokkaido.jp 01
+rishirifuji.hokkaido.jp 01
+risor.no 01
+rissa.no 01
+ritto.shiga.jp 01
+rivne.ua 01
+rj.gov.br 01
+rj.leg.br 08
+rl.no 01
+rm.it 01
+rma.objectstorage.ch 08
+rn.gov.br 01
+rn.it 01
+rn.leg.br 08
+ro 01
+ro.eu.org 08
+ro.gov.br 01
+ro.it 01
+ro.leg.br 08
+roan.no 01
+rocks 01
+rocky.page 08
+rodeo 01
+rodoy.no 01
+rogers 01
+rokunohe.aomori.jp 01
+rollag.no 01
+roma.it 01
+rome.it 01
+romsa.no 01
+romskog.no 01
+room 01
+roros.no 01
+rost.no 01
+rotorcraft.aero 01
+routingthecloud.com 08
+routingthecloud.net 08
+routingthecloud.org 08
+rovigo.it 01
+rovno.ua 01
+roxa.org 08
+royal-commission.uk 08
+royken.no 01
+royrvik.no 01
+rr.gov.br 01
+rr.leg.br 08
+rrpp.ec 01
+rs 01
+rs.ba 08
+rs.gov.br 01
+rs.leg.br 08
+rs.webaccel.jp 08
+rsc.cdn77.org 08
+rsc.contentproxy9.cz 08
+rsvp 01
+rt.ht 08
+ru 01
+ru.com 08
+ru.eu.org 08
+ru.net 08
+rub.de 08
+rugby 01
+ruhr 01
+ruhr-uni-bochum.de 08
+rulez.jp 08
+run 01
+run.app 10
+runcontainers.dev 08
+runs.onstackit.cloud 08
+ruovat.no 01
+rv.ua 01
+rw 01
+rwe 01
+rybnik.pl 01
+ryd.wafaicloud.com 08
+rygge.no 01
+ryokami.saitama.jp 01
+ryugasaki.ibaraki.jp 01
+ryukyu 01
+ryuoh.shiga.jp 01
+rzeszow.pl 01
+rzgw.gov.pl 01
+s.bg 01
+s.brave.app 10
+s.brave.dev 10
+s.brave.io 10
+s.se 01
+s3-1.amazonaws.com 08
+s3-accesspoint-fips.ca-central-1.amazonaws.com 08
+s3-accesspoint-fips.ca-west-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.ca-central-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.ca-west-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-east-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-east-2.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-gov-east-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-gov-west-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-west-1.amazonaws.com 08
+s3-accesspoint-fips.dualstack.us-west-2.amazonaws.com 08
+s3-accesspoint-fips.us-east-1.amazonaws.com 08
+s3-accesspoint-fips.us-east-2.amazonaws.com 08
+s3-accesspoint-fips.us-gov-east-1.amazonaws.com 08
+s3-accesspoint-fips.us-gov-west-1.amazonaws.com 08
+s3-accesspoint-fips.us-west-1.amazonaws.com 08
+s3-accesspoint-fips.us-west-2.amazonaws.com 08
+s3-accesspoint.af-south-1.amazonaws.com 08
+s3-accesspoint.ap-east-1.amazonaws.com 08
+s3-accesspoint.ap-northeast-1.amazonaws.com 08
+s3-accesspoint.ap-northeast-2.amazonaws.com 08
+s3-accesspoint.ap-northeast-3.amazonaws.com 08
+s3-accesspoint.ap-south-1.amazonaws.com 08
+s3-accesspoint.ap-south-2.amazonaws.com 08
+s3-accesspoint.ap-southeast-1.amazonaws.com 08
+s3-accesspoint.ap-southeast-2.amazonaws.com 08
+s3-accesspoint.ap-southeast-3.amazonaws.com 08
+s3-accesspoint.ap-southeast-4.amazonaws.com 08
+s3-accesspoint.ap-southeast-5.amazonaws.com 08
+s3-accesspoint.ca-central-1.amazonaws.com 08
+s3-accesspoint.ca-west-1.amazonaws.com 08
+s3-accesspoint.cn-north-1.amazonaws.com.cn 08
+s3-accesspoint.cn-northwest-1.amazonaws.com.cn 08
+s3-accesspoint.dualstack.af-south-1.amazonaws.com 08
+s3-accesspoint.dualstack.ap-east-1.amazonaws.com 08
+s3-accesspoint.dualstack.ap-northeast-1.amazonaws.com 08
+s3-accesspoint.dualstack.ap-northeast-2.amazonaws.com 08
+s3-accesspoint.dualstack.ap-northeast-3.amazonaws.com 08
+s3-accesspoint.dualstack.ap-south-1.amazonaws.com 08
+s3-accesspoint.dualstack.ap-south-2.amazonaws.com 08
+s3-accesspoint.dualstack.ap-southeast-1.amazonaws.com 08
+s3-accesspoint.dualstack.ap-southeast-2.amazonaws.com 08
+s3-accesspoint.dualstack.ap-southeast-3.amazonaws.com 08
+s3-accesspoint.dualstack.ap-southeast-4.amazonaws.com 08
+s3-accesspoint.dualstack.ap-southeast-5.amazonaws.com 08
+s3-accesspoint.dualstack.ca-central-1.amazonaws.com 08
+s3-accesspoint.dualstack.ca-west-1.amazonaws.com 08
+s3-accesspoint.dualstack.cn-north-1.amazonaws.com.cn 08
+s3-accesspoint.dualstack.cn-northwest-1.amazonaws.com.cn 08
+s3-accesspoint.dualstack.eu-central-1.amazonaws.com 08
+s3-accesspoint.dualstack.eu-central-2.amazonaws.com 08
+s3-accesspoint.dualstack.eu-north-1.amazonaws.com 08
+s3-accesspoint.dualstack.eu-south-1.amazonaws.com 08
+s3-accesspoint.dualstack.eu-south-2.amazonaws.com 08
+s3-accesspoint.dualstack.eu-west-1.amazonaws.com 08
+s3-accesspoint.dualstack.eu-west-2.amazonaws.com 08
+s3-accesspoint.dualstack.eu-west-3.amazonaws.com 08
+s3-accesspoint.dualstack.il-central-1.amazonaws.com 08
+s3-accesspoint.dualstack.me-central-1.amazonaws.com 08
+s3-accesspoint.dualstack.me-south-1.amazonaws.com 08
+s3-accesspoint.dualstack.sa-east-1.amazonaws.com 08
+s3-accesspoint.dualstack.us-east-1.amazonaws.com 08
+s3-accesspoint.dualstack.us-east-2.amazonaws.com 08
+s3-accesspoint.dualstack.us-gov-east-1.amazonaws.com 08
+s3-accesspoint.dualstack.us-gov-west-1.amazonaws.com 08
+s3-accesspoint.dualstack.us-west-1.amazonaws.com 08
+s3-accesspoint.dualstack.us-west-2.amazonaws.com 08
+s3-accesspoint.eu-central-1.amazonaws.com 08
+s3-accesspoint.eu-central-2.amazonaws.com 08
+s3-accesspoint.eu-north-1.amazonaws.com 08
+s3-accesspoint.eu-south-1.amazonaws.com 08
+s3-accesspoint.eu-south-2.amazonaws.com 08
+s3-accesspoint.eu-west-1.amazonaws.com 08
+s3-accesspoint.eu-west-2.amazonaws.com 08
+s3-accesspoint.eu-west-3.amazonaws.com 08
+s3-accesspoint.il-central-1.amazonaws.com 08
+s3-accesspoint.me-central-1.amazonaws.com 08
+s3-accesspoint.me-south-1.amazonaws.com 08
+s3-accesspoint.sa-east-1.amazonaws.com 08
+s3-accesspoint.us-east-1.amazonaws.com 08
+s3-accesspoint.us-east-2.amazonaws.com 08
+s3-accesspoint.us-gov-east-1.amazonaws.com 08
+s3-accesspoint.us-gov-west-1.amazonaws.com 08
+s3-accesspoint.us-west-1.amazonaws.com 08
+s3-accesspoint.us-west-2.amazonaws.com 08
+s3-ap-east-1.amazonaws.com 08
+s3-ap-northeast-1.amazonaws.com 08
+s3-ap-northeast-2.amazonaws.com 08
+s3-ap-northeast-3.amazonaws.com 08
+s3-ap-south-1.amazonaws.com 08
+s3-ap-southeast-1.amazonaws.com 08
+s3-ap-southeast-2.amazonaws.com 08
+s3-ca-central-1.amazonaws.com 08
+s3-deprecated.ap-southeast-5.amazonaws.com 08
+s3-deprecated.cn-north-1.amazonaws.com.cn 08
+s3-deprecated.eu-west-1.amazonaws.com 08
+s3-deprecated.us-east-1.amazonaws.com 08
+s3-deprecated.us-east-2.amazonaws.com 08
+s3-deprecated.us-west-2.amazonaws.com 08
+s3-eu-central-1.amazonaws.com 08
+s3-eu-north-1.amazonaws.com 08
+s3-eu-west-1.amazonaws.com 08
+s3-eu-west-2.amazonaws.com 08
+s3-eu-west-3.amazonaws.com 08
+s3-external-1.amazonaws.com 08
+s3-fips-us-gov-east-1.amazonaws.com 08
+s3-fips-us-gov-west-1.amazonaws.com 08
+s3-fips.ca-central-1.amazonaws.com 08
+s3-fips.ca-west-1.amazonaws.com 08
+s3-fips.dualstack.ca-central-1.amazonaws.com 08
+s3-fips.dualstack.ca-west-1.amazonaws.com 08
+s3-fips.dualstack.us-east-1.amazonaws.com 08
+s3-fips.dualstack.us-east-2.amazonaws.com 08
+s3-fips.dualstack.us-gov-east-1.amazonaws.com 08
+s3-fips.dualstack.us-gov-west-1.amazonaws.com 08
+s3-fips.dualstack.us-west-1.amazonaws.com 08
+s3-fips.dualstack.us-west-2.amazonaws.com 08
+s3-fips.us-east-1.amazonaws.com 08
+s3-fips.us-east-2.amazonaws.com 08
+s3-fips.us-gov-east-1.amazonaws.com 08
+s3-fips.us-gov-west-1.amazonaws.com 08
+s3-fips.us-west-1.amazonaws.com 08
+s3-fips.us-west-2.amazonaws.com 08
+s3-me-south-1.amazonaws.com 08
+s3-object-lambda.af-south-1.amazonaws.com 08
+s3-object-lambda.ap-east-1.amazonaws.com 08
+s3-object-lambda.ap-northeast-1.amazonaws.com 08
+s3-object-lambda.ap-northeast-2.amazonaws.com 08
+s3-object-lambda.ap-northeast-3.amazonaws.com 08
+s3-object-lambda.ap-south-1.amazonaws.com 08
+s3-object-lambda.ap-south-2.amazonaws.com 08
+s3-object-lambda.ap-southeast-1.amazonaws.com 08
+s3-object-lambda.ap-southeast-2.amazonaws.com 08
+s3-object-lambda.ap-southeast-3.amazonaws.com 08
+s3-object-lambda.ap-southeast-4.amazonaws.com 08
+s3-object-lambda.ap-southeast-5.amazonaws.com 08
+s3-object-lambda.ca-central-1.amazonaws.com 08
+s3-object-lambda.ca-west-1.amazonaws.com 08
+s3-object-lambda.cn-north-1.amazonaws.com.cn 08
+s3-object-lambda.cn-northwest-1.amazonaws.com.cn 08
+s3-object-lambda.eu-central-1.amazonaws.com 08
+s3-object-lambda.eu-central-2.amazonaws.com 08
+s3-object-lambda.eu-north-1.amazonaws.com 08
+s3-object-lambda.eu-south-1.amazonaws.com 08
+s3-object-lambda.eu-south-2.amazonaws.com 08
+s3-object-lambda.eu-west-1.amazonaws.com 08
+s3-object-lambda.eu-west-2.amazonaws.com 08
+s3-object-lambda.eu-west-3.amazonaws.com 08
+s3-object-lambda.il-central-1.amazonaws.com 08
+s3-object-lambda.me-central-1.amazonaws.com 08
+s3-object-lambda.me-south-1.amazonaws.com 08
+s3-object-lambda.sa-east-1.amazonaws.com 08
+s3-object-lambda.us-east-1.amazonaws.com 08
+s3-object-lambda.us-east-2.amazonaws.com 08
+s3-object-lambda.us-gov-east-1.amazonaws.com 08
+s3-object-lambda.us-gov-west-1.amazonaws.com 08
+s3-object-lambda.us-west-1.amazonaws.com 08
+s3-object-lambda.us-west-2.amazonaws.com 08
+s3-sa-east-1.amazonaws.com 08
+s3-us-east-2.amazonaws.com 08
+s3-us-gov-east-1.amazonaws.com 08
+s3-us-gov-west-1.amazonaws.com 08
+s3-us-west-1.amazonaws.com 08
+s3-us-west-2.amazonaws.com 08
+s3-website-ap-northeast-1.amazonaws.com 08
+s3-website-ap-southeast-1.amazonaws.com 08
+s3-website-ap-southeast-2.amazonaws.com 08
+s3-website-eu-west-1.amazonaws.com 08
+s3-website-sa-east-1.amazonaws.com 08
+s3-website-us-east-1.amazonaws.com 08
+s3-website-us-gov-west-1.amazonaws.com 08
+s3-website-us-west-1.amazonaws.com 08
+s3-website-us-west-2.amazonaws.com 08
+s3-website.af-south-1.amazonaws.com 08
+s3-website.ap-east-1.amazonaws.com 08
+s3-website.ap-northeast-1.amazonaws.com 08
+s3-website.ap-northeast-2.amazonaws.com 08
+s3-website.ap-northeast-3.amazonaws.com 08
+s3-website.ap-south-1.amazonaws.com 08
+s3-website.ap-south-2.amazonaws.com 08
+s3-website.ap-southeast-1.amazonaws.com 08
+s3-website.ap-southeast-2.amazonaws.com 08
+s3-website.ap-southeast-3.amazonaws.com 08
+s3-website.ap-southeast-4.amazonaws.com 08
+s3-website.ap-southeast-5.amazonaws.com 08
+s3-website.ca-central-1.amazonaws.com 08
+s3-website.ca-west-1.amazonaws.com 08
+s3-website.cn-north-1.amazonaws.com.cn 08
+s3-website.cn-northwest-1.amazonaws.com.cn 08
+s3-website.dualstack.af-south-1.amazonaws.com 08
+s3-website.dualstack.ap-northeast-1.amazonaws.com 08
+s3-website.dualstack.ap-northeast-2.amazonaws.com 08
+s3-website.dualstack.ap-northeast-3.amazonaws.com 08
+s3-website.dualstack.ap-south-1.amazonaws.com 08
+s3-website.dualstack.ap-south-2.amazonaws.com 08
+s3-website.dualstack.ap-southeast-1.amazonaws.com 08
+s3-website.dualstack.ap-southeast-2.amazonaws.com 08
+s3-website.dualstack.ap-southeast-3.amazonaws.com 08
+s3-website.dualstack.ap-southeast-4.amazonaws.com 08
+s3-website.dualstack.ap-southeast-5.amazonaws.com 08
+s3-website.dualstack.ca-central-1.amazonaws.com 08
+s3-website.dualstack.ca-west-1.amazonaws.com 08
+s3-website.dualstack.cn-north-1.amazonaws.com.cn 08
+s3-website.dualstack.eu-central-1.amazonaws.com 08
+s3-website.dualstack.eu-central-2.amazonaws.com 08
+s3-website.dualstack.eu-south-1.amazonaws.com 08
+s3-website.dualstack.eu-south-2.amazonaws.com 08
+s3-website.dualstack.eu-west-1.amazonaws.com 08
+s3-website.dualstack.eu-west-3.amazonaws.com 08
+s3-website.dualstack.il-central-1.amazonaws.com 08
+s3-website.dualstack.me-central-1.amazonaws.com 08
+s3-website.dualstack.sa-east-1.amazonaws.com 08
+s3-website.dualstack.us-east-1.amazonaws.com 08
+s3-website.dualstack.us-east-2.amazonaws.com 08
+s3-website.dualstack.us-gov-east-1.amazonaws.com 08
+s3-website.dualstack.us-gov-west-1.amazonaws.com 08
+s3-website.dualstack.us-west-1.amazonaws.com 08
+s3-website.dualstack.us-west-2.amazonaws.com 08
+s3-website.eu-central-1.amazonaws.com 08
+s3-website.eu-central-2.amazonaws.com 08
+s3-website.eu-north-1.amazonaws.com 08
+s3-website.eu-south-1.amazonaws.com 08
+s3-website.eu-south-2.amazonaws.com 08
+s3-website.eu-west-1.amazonaws.com 08
+s3-website.eu-west-2.amazonaws.com 08
+s3-website.eu-west-3.amazonaws.com 08
+s3-website.fr-par.scw.cloud 08
+s3-website.il-central-1.amazonaws.com 08
+s3-website.me-central-1.amazonaws.com 08
+s3-website.me-south-1.amazonaws.com 08
+s3-website.nl-ams.scw.cloud 08
+s3-website.pl-waw.scw.cloud 08
+s3-website.sa-east-1.amazonaws.com 08
+s3-website.us-east-1.amazonaws.com 08
+s3-website.us-east-2.amazonaws.com 08
+s3-website.us-gov-east-1.amazonaws.com 08
+s3-website.us-gov-west-1.amazonaws.com 08
+s3-website.us-west-1.amazonaws.com 08
+s3-website.us-west-2.amazonaws.com 08
+s3.af-south-1.amazonaws.com 08
+s3.amazonaws.com 08
+s3.ap-east-1.amazonaws.com 08
+s3.ap-northeast-1.amazonaws.com 08
+s3.ap-northeast-2.amazonaws.com 08
+s3.ap-northeast-3.amazonaws.com 08
+s3.ap-south-1.amazonaws.com 08
+s3.ap-south-2.amazonaws.com 08
+s3.ap-southeast-1.amazonaws.com 08
+s3.ap-southeast-2.amazonaws.com 08
+s3.ap-southeast-3.amazonaws.com 08
+s3.ap-southeast-4.amazonaws.com 08
+s3.ap-southeast-5.amazonaws.com 08
+s3.ca-central-1.amazonaws.com 08
+s3.ca-west-1.amazonaws.com 08
+s3.cn-north-1.amazonaws.com.cn 08
+s3.cn-northwest-1.amazonaws.com.cn 08
+s3.dualstack.af-south-1.amazonaws.com 08
+s3.dualstack.ap-east-1.amazonaws.com 08
+s3.dualstack.ap-northeast-1.amazonaws.com 08
+s3.dualstack.ap-northeast-2.amazonaws.com 08
+s3.dualstack.ap-northeast-3.amazonaws.com 08
+s3.dualstack.ap-south-1.amazonaws.com 08
+s3.dualstack.ap-south-2.amazonaws.com 08
+s3.dualstack.ap-southeast-1.amazonaws.com 08
+s3.dualstack.ap-southeast-2.amazonaws.com 08
+s3.dualstack.ap-southeast-3.amazonaws.com 08
+s3.dualstack.ap-southeast-4.amazonaws.com 08
+s3.dualstack.ap-southeast-5.amazonaws.com 08
+s3.dualstack.ca-central-1.amazonaws.com 08
+s3.dualstack.ca-west-1.amazonaws.com 08
+s3.dualstack.cn-north-1.amazonaws.com.cn 08
+s3.dualstack.cn-northwest-1.amazonaws.com.cn 08
+s3.dualstack.eu-central-1.amazonaws.com 08
+s3.dualstack.eu-central-2.amazonaws.com 08
+s3.dualstack.eu-north-1.amazonaws.com 08
+s3.dualstack.eu-south-1.amazonaws.com 08
+s3.dualstack.eu-south-2.amazonaws.com 08
+s3.dualstack.eu-west-1.amazonaws.com 08
+s3.dualstack.eu-west-2.amazonaws.com 08
+s3.dualstack.eu-west-3.amazonaws.com 08
+s3.dualstack.il-central-1.amazonaws.com 08
+s3.dualstack.me-central-1.amazonaws.com 08
+s3.dualstack.me-south-1.amazonaws.com 08
+s3.dualstack.sa-east-1.amazonaws.com 08
+s3.dualstack.us-east-1.amazonaws.com 08
+s3.dualstack.us-east-2.amazonaws.com 08
+s3.dualstack.us-gov-east-1.amazonaws.com 08
+s3.dualstack.us-gov-west-1.amazonaws.com 08
+s3.dualstack.us-west-1.amazonaws.com 08
+s3.dualstack.us-west-2.amazonaws.com 08
+s3.eu-central-1.amazonaws.com 08
+s3.eu-central-2.amazonaws.com 08
+s3.eu-north-1.amazonaws.com 08
+s3.eu-south-1.amazonaws.com 08
+s3.eu-south-2.amazonaws.com 08
+s3.eu-west-1.amazonaws.com 08
+s3.eu-west-2.amazonaws.com 08
+s3.eu-west-3.amazonaws.com 08
+s3.fr-par.scw.cloud 08
+s3.il-central-1.amazonaws.com 08
+s3.isk01.sakurastorage.jp 08
+s3.isk02.sakurastorage.jp 08
+s3.me-central-1.amazonaws.com 08
+s3.me-south-1.amazonaws.com 08
+s3.nl-ams.scw.cloud 08
+s3.pl-waw.scw.cloud 08
+s3.sa-east-1.amazonaws.com 08
+s3.teckids.org 08
+s3.us-east-1.amazonaws.com 08
+s3.us-east-2.amazonaws.com 08
+s3.us-gov-east-1.amazonaws.com 08
+s3.us-gov-west-1.amazonaws.com 08
+s3.us-west-1.amazonaws.com 08
+s3.us-west-2.amazonaws.com 08
+sa 01
+sa-east-1.airflow.amazonaws.com 10
+sa-east-1.elasticbeanstalk.com 08
+sa-east-1.rds.amazonaws.com 10
+sa.au 01
+sa.com 08
+sa.cr 01
+sa.edu.au 01
+sa.gov.au 01
+sa.gov.pl 01
+sa.it 01
+sa.ngrok.io 08
+saarland 01
+sabae.fukui.jp 01
+sadist.jp 08
+sado.niigata.jp 01
+safe 01
+safety 01
+safety.aero 01
+saga.jp 01
+saga.saga.jp 01
+sagae.yamagata.jp 01
+sagamihara.kanagawa.jp 01
+saigawa.fukuoka.jp 01
+saijo.ehime.jp 01
+saikai.nagasaki.jp 01
+saiki.oita.jp 01
+saitama.jp 01
+saitama.saitama.jp 01
+saito.miyazaki.jp 01
+saka.hiroshima.jp 01
+sakado.saitama.jp 01
+sakae.chiba.jp 01
+sakae.nagano.jp 01
+sakahogi.gifu.jp 01
+sakai.fukui.jp 01
+sakai.ibaraki.jp 01
+sakai.osaka.jp 01
+sakaiminato.tottori.jp 01
+sakaki.nagano.jp 01
+sakata.yamagata.jp 01
+sakawa.kochi.jp 01
+sakegawa.yamagata.jp 01
+saku.nagano.jp 01
+sakuho.nagano.jp 01
+sakura 01
+sakura.chiba.jp 01
+sakura.ne.jp 08
+sakura.tochigi.jp 01
+sakura.tv 08
+sakuragawa.ibaraki.jp 01
+sakurai.nara.jp 01
+sakuratan.com 08
+sakuraweb.com 08
+sakyo.kyoto.jp 01
+sal.ec 01
+salangen.no 01
+salat.no 01
+sale 01
+salerno.it 01
+salon 01
+saloon.jp 08
+saltdal.no 01
+salud.bo 01
+salvador.br 01
+same-app.com 08
+same-preview.com 08
+samegawa.fukushima.jp 01
+samnanger.no 01
+sampa.br 01
+samsclub 01
+samsung 01
+samukawa.kanagawa.jp 01
+sanagochi.tokushima.jp 01
+sanda.hyogo.jp 01
+sandcats.io 08
+sande.more-og-romsdal.no 01
+sande.vestfold.no 01
+sande.xn--mre-og-romsdal-qqb.no 01
+sandefjord.no 01
+sandnes.no 01
+sandnessjoen.no 01
+sandoy.no 01
+sandvik 01
+sandvikcoromant 01
+sango.nara.jp 01
+sanjo.niigata.jp 01
+sannan.hyogo.jp 01
+sannohe.aomori.jp 01
+sano.tochigi.jp 01
+sanofi 01
+sanok.pl 01
+santamaria.br 01
+santoandre.br 01
+sanuki.kagawa.jp 01
+saobernardo.br 01
+saogonca.br 01
+saotome.st 01
+sap 01
+sapporo.jp 02
+sar.it 01
+sardegna.it 01
+sardinia.it 01
+sarl 01
+saroma.hokkaido.jp 01
+sarpsborg.no 01
+sarufutsu.hokkaido.jp 01
+sas 01
+sasaguri.fukuoka.jp 01
+sasayama.hyogo.jp 01
+sasebo.nagasaki.jp 01
+sassari.it 01
+satosho.okayama.jp 01
+satsumasendai.kagoshima.jp 01
+satte.saitama.jp 01
+sauda.no 01
+sauherad.no 01
+sav.case 08
+save 01
+saves-the-whales.com 08
+savona.it 01
+saxo 01
+sayama.osaka.jp 01
+sayama.saitama.jp 01
+sayo.hyogo.jp 01
+sb 01
+sb.ua 01
+sbi 01
+sblo.jp 08
+sbs 01
+sc 01
+sc.cn 01
+sc.gov.br 01
+sc.ke 01
+sc.kr 01
+sc.leg.br 08
+sc.ls 01
+sc.tz 01
+sc.ug 01
+sc.us 01
+scalebook.scw.cloud 08
+scb 01
+scbl.fr-par.scw.cloud 08
+scbl.nl-ams.scw.cloud 08
+scbl.pl-waw.scw.cloud 08
+sch.ae 01
+sch.bd 01
+sch.id 01
+sch.ir 01
+sch.jo 01
+sch.lk 01
+sch.ly 01
+sch.ng 01
+sch.qa 01
+sch.sa 01
+sch.ss 01
+sch.tf 08
+sch.uk 02
+sch.wf 08
+sch.zm 01
+schaeffler 01
+schmidt 01
+schokokeks.net 08
+scholarships 01
+school 01
+school.ge 01
+school.nz 01
+school.za 01
+schoolbus.jp 08
+schuldock.de 08
+schule 01
+schulplattform.de 08
+schulserver.de 08
+schwarz 01
+sci.eg 01
+science 01
+scientist.aero 01
+scot 01
+scrapper-site.net 08
+scrapping.cc 08
+scrysec.com 08
+sd 01
+sd.cn 01
+sd.us 01
+sdn.gov.pl 01
+sdscloud.pl 08
+se 01
+se.eu.org 08
+se.gov.br 01
+se.leg.br 08
+se.net 08
+search 01
+seat 01
+sebastopol.ua 01
+sec.ps 01
+secret.jp 08
+secure 01
+security 01
+securitytactics.com 08
+seek 01
+seg.ar 01
+seg.br 01
+seidat.net 08
+seihi.nagasaki.jp 01
+seika.kyoto.jp 01
+seiro.niigata.jp 01
+seirou.niigata.jp 01
+seiyo.ehime.jp 01
+sejny.pl 01
+sekd1.beebyteapp.io 08
+seki.gifu.jp 01
+sekigahara.gifu.jp 01
+sekikawa.niigata.jp 01
+sel.no 01
+selbu.no 01
+select 01
+selfip.biz 08
+selfip.com 08
+selfip.info 08
+selfip.net 08
+selfip.org 08
+selje.no 01
+seljord.no 01
+sellfy.store 08
+sells-for-less.com 08
+sells-for-u.com 08
+sells-it.net 08
+sellsyourhome.org 08
+semboku.akita.jp 01
+semine.miyagi.jp 01
+senasa.ar 01
+sendai.jp 02
+sener 01
+sennan.osaka.jp 01
+senseering.net 08
+seoul.kr 01
+sera.hiroshima.jp 01
+seranishi.hiroshima.jp 01
+servebbs.com 08
+servebbs.net 08
+servebbs.org 08
+servebeer.com 08
+serveblog.net 08
+servebolt.cloud 08
+servecounterstrike.com 08
+serveexchange.com 08
+serveftp.com 08
+serveftp.net 08
+serveftp.org 08
+servegame.com 08
+servegame.org 08
+servehalflife.com 08
+servehttp.com 08
+servehumour.com 08
+serveirc.com 08
+serveminecraft.net 08
+servemp3.com 08
+servep2p.com 08
+servepics.com 08
+servequake.com 08
+server-on.net 08
+servername.us 08
+servesarcasm.com 08
+service.gov.scot 08
+service.gov.uk 08
+service.one 08
+servicebus.usgovcloudapi.net 08
+servicebus.windows.net 08
+services 01
+services.aero 01
+services.clever-cloud.com 10
+setagaya.tokyo.jp 01
+seto.aichi.jp 01
+setouchi.okayama.jp 01
+settsu.osaka.jp 01
+sevastopol.ua 01
+seven 01
+sew 01
+sex 01
+sex.hu 01
+sex.pl 01
+sexy 01
+sf.no 01
+sfr 01
+sg 01
+sg-1.paas.massivegrid.net 08
+sh 01
+sh.cn 01
+shacknet.nu 08
+shakotan.hokkaido.jp 01
+shangrila 01
+shari.hokkaido.jp 01
+sharp 01
+sheezy.games 08
+shell 01
+shia 01
+shibata.miyagi.jp 01
+shibata.niigata.jp 01
+shibecha.hokkaido.jp 01
+shibetsu.hokkaido.jp 01
+shibukawa.gunma.jp 01
+shibuya.tokyo.jp 01
+shichikashuku.miyagi.jp 01
+shichinohe.aomori.jp 01
+shiga.jp 01
+shiiba.miyazaki.jp 01
+shijonawate.osaka.jp 01
+shika.ishikawa.jp 01
+shikabe.hokkaido.jp 01
+shikama.miyagi.jp 01
+shikaoi.hokkaido.jp 01
+shikatsu.aichi.jp 01
+shiki.saitama.jp 01
+shikokuchuo.ehime.jp 01
+shiksha 01
+shima.mie.jp 01
+shimabara.nagasaki.jp 01
+shimada.shizuoka.jp 01
+shimamaki.hokkaido.jp 01
+shimamoto.osaka.jp 01
+shimane.jp 01
+shimane.shimane.jp 01
+shimizu.hokkaido.jp 01
+shimizu.shizuoka.jp 01
+shimoda.shizuoka.jp 01
+shimodate.ibaraki.jp 01
+shimofusa.chiba.jp 01
+shimogo.fukushima.jp 01
+shimoichi.nara.jp 01
+shimoji.okinawa.jp 01
+shimokawa.hokkaido.jp 01
+shimokitayama.nara.jp 01
+shimonita.gunma.jp 01
+shimonoseki.yamaguchi.jp 01
+shimosuwa.nagano.jp 01
+shimotsuke.tochigi.jp 01
+shimotsuma.ibaraki.jp 01
+shinagawa.tokyo.jp 01
+shinanomachi.nagano.jp 01
+shingo.aomori.jp 01
+shingu.fukuoka.jp 01
+shingu.hyogo.jp 01
+shingu.wakayama.jp 01
+shinichi.hiroshima.jp 01
+shinjo.nara.jp 01
+shinjo.okayama.jp 01
+shinjo.yamagata.jp 01
+shinjuku.tokyo.jp 01
+shinkamigoto.nagasaki.jp 01
+shinonsen.hyogo.jp 01
+shinshinotsu.hokkaido.jp 01
+shinshiro.aichi.jp 01
+shinto.gunma.jp 01
+shintoku.hokkaido.jp 01
+shintomi.miyazaki.jp 01
+shinyoshitomi.fukuoka.jp 01
+shiogama.miyagi.jp 01
+shiojiri.nagano.jp 01
+shioya.tochigi.jp 01
+shiptoday.app 08
+shiptoday.build 08
+shirahama.wakayama.jp 01
+shirakawa.fukushima.jp 01
+shirakawa.gifu.jp 01
+shirako.chiba.jp 01
+shiranuka.hokkaido.jp 01
+shiraoi.hokkaido.jp 01
+shiraoka.saitama.jp 01
+shirataka.yamagata.jp 01
+shiriuchi.hokkaido.jp 01
+shiroi.chiba.jp 01
+shiroishi.miyagi.jp 01
+shiroishi.saga.jp 01
+shirosato.ibaraki.jp 01
+shishikui.tokushima.jp 01
+shiso.hyogo.jp 01
+shisui.chiba.jp 01
+shitara.aichi.jp 01
+shiwa.iwate.jp 01
+shizukuishi.iwate.jp 01
+shizuoka.jp 01
+shizuoka.shizuoka.jp 01
+shobara.hiroshima.jp 01
+shoes 01
+shonai.fukuoka.jp 01
+shonai.yamagata.jp 01
+shoo.okayama.jp 01
+shop 01
+shop.brendly.ba 08
+shop.brendly.hr 08
+shop.brendly.rs 08
+shop.ht 01
+shop.hu 01
+shop.pl 01
+shop.ro 08
+shop.th 08
+shoparena.pl 08
+shopitsite.com 08
+shopping 01
+shopselect.net 08
+shopware.shop 08
+shopware.store 08
+shouji 01
+show 01
+show.aero 01
+showa.fukushima.jp 01
+showa.gunma.jp 01
+showa.yamanashi.jp 01
+shunan.yamaguchi.jp 01
+si 01
+si.eu.org 08
+si.it 01
+sic.it 01
+sicilia.it 01
+sicily.it 01
+siellak.no 01
+siena.it 01
+sieradz.pl 08
+sigdal.no 01
+siiites.com 08
+siljan.no 01
+silk 01
+simple-url.com 08
+simplesite.com 08
+simplesite.com.br 08
+simplesite.gr 08
+simplesite.pl 08
+sina 01
+sinaapp.com 08
+singles 01
+siracusa.it 01
+sirdal.no 01
+sisko.replit.dev 08
+site 01
+site.rb-hosting.io 08
+site.tb-hosting.com 08
+site.transip.me 08
+siteleaf.net 08
+sj 01
+sjc.br 01
+sk 01
+sk.ca 01
+sk.eu.org 08
+skanit.no 01
+skanland.no 01
+skaun.no 01
+skedsmo.no 01
+skedsmokorset.no 01
+ski 01
+ski.no 01
+skien.no 01
+skierniewice.pl 08
+skierva.no 01
+skin 01
+skiptvet.no 01
+skjak.no 01
+skjervoy.no 01
+sklep.pl 01
+sko.gov.pl 01
+skoczow.pl 01
+skodje.no 01
+skr.jp 08
+sky 01
+skydiving.aero 01
+skype 01
+sl 01
+slask.pl 01
+slattum.no 01
+sld.do 01
+sld.pa 01
+slg.br 01
+sling 01
+slupsk.pl 01
+slz.br 01
+sm 01
+sm.ua 01
+small-web.org 08
+smart 01
+smartlabeling.scw.cloud 08
+smile 01
+smola.no 01
+smushcdn.com 08
+sn 01
+sn.cn 01
+sn.mynetname.net 08
+snaase.no 01
+snasa.no 01
+sncf 01
+snillfjord.no 01
+snoasa.no 01
+snowflake.app 10
+so 01
+so.gov.pl 01
+so.it 01
+sobetsu.hokkaido.jp 01
+soc.dz 01
+soc.lk 01
+soc.srcf.net 08
+soccer 01
+sochi.su 08
+social 01
+social.br 01
+soctrang.vn 01
+sodegaura.chiba.jp 01
+soeda.fukuoka.jp 01
+softbank 01
+software 01
+software.aero 01
+sogndal.no 01
+sogne.no 01
+sohu 01
+soja.okayama.jp 01
+soka.saitama.jp 01
+sokndal.no 01
+sol.site 08
+sola.no 01
+solar 01
+solund.no 01
+solutions 01
+soma.fukushima.jp 01
+somna.no 01
+sondre-land.no 01
+sondrio.it 01
+song 01
+songdalen.no 01
+soni.nara.jp 01
+sonla.vn 01
+sony 01
+soo.kagoshima.jp 01
+sopot.pl 08
+sor-aurdal.no 01
+sor-fron.no 01
+sor-odal.no 01
+sor-varanger.no 01
+sorfold.no 01
+sorocaba.br 01
+sorreisa.no 01
+sortland.no 01
+sorum.no 01
+sos.pl 01
+sosa.chiba.jp 01
+sosnowiec.pl 01
+soundcast.me 08
+sourcecraft.site 08
+sowa.ibaraki.jp 01
+soy 01
+sp.gov.br 01
+sp.it 01
+sp.leg.br 08
+spa 01
+space 01
+space-to-rent.com 08
+spawnbase.app 08
+spb.ru 08
+spb.su 08
+spdns.de 08
+spdns.eu 08
+spdns.org 08
+spectrum.myjino.ru 10
+sphinx.mythic-beasts.com 08
+spjelkavik.no 01
+spock.replit.dev 08
+sport 01
+sport.eg 01
+sport.hu 01
+spot 01
+sprites.app 08
+spryt.net 08
+spydeberg.no 01
+square.site 08
+square7.ch 08
+square7.de 08
+square7.net 08
+squares.net 08
+sr 01
+sr.gov.pl 01
+sr.it 01
+srht.site 08
+srl 01
+srv.br 01
+srv.us 08
+srvrless.rdpa.co 10
+ss 01
+ss.it 01
+ssl.origin.cdn77-secure.org 08
+st 01
+st.no 01
+staba.jp 08
+stackhero-network.com 08
+stackit.gg 08
+stackit.rocks 08
+stackit.run 08
+stackit.zone 08
+stada 01
+stage.nodeart.io 08
+staging.expo.app 08
+staging.replit.dev 08
+stalowa-wola.pl 01
+stange.no 01
+staples 01
+star 01
+starachowice.pl 01
+stargard.pl 01
+starostwo.gov.pl 01
+stat.no 01
+statebank 01
+statefarm 01
+stathelle.no 01
+static-access.net 08
+static.hf.space 08
+static.observableusercontent.com 08
+statichost.page 08
+statics.cloud 10
+stavanger.no 01
+stavern.no 01
+stc 01
+stcgroup 01
+steigen.no 01
+steinkjer.no 01
+stg-builder.code.com 10
+stg.dev 10
+stgstage.dev 10
+sth.ac.at 01
+stjordal.no 01
+stjordalshalsen.no 01
+stockholm 01
+stokke.no 01
+stolos.io 10
+stor-elvdal.no 01
+storage 01
+storage.supabase.co 08
+storage.yandexcloud.net 08
+stord.no 01
+stordal.no 01
+store 01
+store.bb 01
+store.dk 08
+store.nf 01
+store.ro 01
+store.st 01
+store.ve 01
+storebase.store 08
+storfjord.no 01
+storj.farm 08
+strand.no 01
+stranda.no 01
+strapiapp.com 08
+streak-link.com 08
+streaklinks.com 08
+streakusercontent.com 08
+stream 01
+streamlit.app 08
+streamlitapp.com 08
+stripper.jp 08
+stryn.no 01
+student.aero 01
+studio 01
+studio-fips.us-gov-east-1.sagemaker.aws 08
+studio-fips.us-gov-west-1.sagemaker.aws 08
+studio.af-south-1.sagemaker.aws 08
+studio.ap-east-1.sagemaker.aws 08
+studio.ap-northeast-1.sagemaker.aws 08
+studio.ap-northeast-2.sagemaker.aws 08
+studio.ap-northeast-3.sagemaker.aws 08
+studio.ap-south-1.sagemaker.aws 08
+studio.ap-southeast-1.sagemaker.aws 08
+studio.ap-southeast-2.sagemaker.aws 08
+studio.ap-southeast-3.sagemaker.aws 08
+studio.ca-central-1.sagemaker.aws 08
+studio.cn-north-1.sagemaker.com.cn 08
+studio.cn-northwest-1.sagemaker.com.cn 08
+studio.eu-central-1.sagemaker.aws 08
+studio.eu-central-2.sagemaker.aws 08
+studio.eu-north-1.sagemaker.aws 08
+studio.eu-south-1.sagemaker.aws 08
+studio.eu-south-2.sagemaker.aws 08
+studio.eu-west-1.sagemaker.aws 08
+studio.eu-west-2.sagemaker.aws 08
+studio.eu-west-3.sagemaker.aws 08
+studio.il-central-1.sagemaker.aws 08
+studio.me-central-1.sagemaker.aws 08
+studio.me-south-1.sagemaker.aws 08
+studio.sa-east-1.sagemaker.aws 08
+studio.us-east-1.sagemaker.aws 08
+studio.us-east-2.sagemaker.aws 08
+studio.us-gov-east-1.sagemaker.aws 08
+studio.us-gov-west-1.sagemaker.aws 08
+studio.us-west-1.sagemaker.aws 08
+studio.us-west-2.sagemaker.aws 08
+study 01
+stuff-4-sale.org 08
+stuff-4-sale.us 08
+stufftoread.com 08
+style 01
+su 01
+sub.jp 08
+subsc-pay.com 08
+subsc-pay.net 08
+sucks 01
+sue.fukuoka.jp 01
+suedtirol.it 01
+suginami.tokyo.jp 01
+sugito.saitama.jp 01
+suifu.ibaraki.jp 01
+suita.osaka.jp 01
+sukagawa.fukushima.jp 01
+sukumo.kochi.jp 01
+sula.no 01
+suldal.no 01
+suli.hu 01
+sulu.replit.dev 08
+sumida.tokyo.jp 01
+sumita.iwate.jp 01
+sumomo.ne.jp 08
+sumoto.hyogo.jp 01
+sumoto.kumamoto.jp 01
+sumy.ua 01
+sunagawa.hokkaido.jp 01
+sund.no 01
+sunndal.no 01
+sunnyday.jp 08
+supabase.co 08
+supabase.in 08
+supabase.net 08
+supersale.jp 08
+supplies 01
+supply 01
+support 01
+support.site 08
+surf 01
+surgery 01
+surnadal.no 01
+surveys.so 08
+susaki.kochi.jp 01
+susono.shizuoka.jp 01
+suwa.nagano.jp 01
+suwalki.pl 01
+suzaka.nagano.jp 01
+suzu.ishikawa.jp 01
+suzuka.mie.jp 01
+suzuki 01
+sv 01
+sv.it 01
+svalbard.no 01
+svc.firenet.ch 10
+sveio.no 01
+svelvik.no 01
+svn-repos.de 08
+swatch 01
+sweetpepper.org 08
+swidnica.pl 01
+swidnik.pl 08
+swiebodzin.pl 01
+swinoujscie.pl 01
+swiss 01
+sx 01
+sx.cn 01
+sy 01
+sydney 01
+sykkylven.no 01
+syncloud.it 08
+synology.me 08
+sys.qcx.io 10
+systems 01
+sytes.net 08
+sz 01
+szczecin.pl 01
+szczytno.pl 01
+szex.hu 01
+szkola.pl 01
+t.bg 01
+t.se 01
+ta.it 01
+taa.it 01
+tab 01
+tabayama.yamanashi.jp 01
+tabitorder.co.il 08
+tabuse.yamaguchi.jp 01
+tachiarai.fukuoka.jp 01
+tachikawa.tokyo.jp 01
+tadaoka.osaka.jp 01
+tado.mie.jp 01
+tadotsu.kagawa.jp 01
+tagajo.miyagi.jp 01
+tagami.niigata.jp 01
+tagawa.fukuoka.jp 01
+tahara.aichi.jp 01
+taifun-dns.de 08
+taiji.wakayama.jp 01
+taiki.hokkaido.jp 01
+taiki.mie.jp 01
+tainai.niigata.jp 01
+taipei 01
+taira.toyama.jp 01
+taishi.hyogo.jp 01
+taishi.osaka.jp 01
+taishin.fukushima.jp 01
+taito.tokyo.jp 01
+taiwa.miyagi.jp 01
+tajimi.gifu.jp 01
+tajiri.osaka.jp 01
+taka.hyogo.jp 01
+takagi.nagano.jp 01
+takahagi.ibaraki.jp 01
+takahama.aichi.jp 01
+takahama.fukui.jp 01
+takaharu.miyazaki.jp 01
+takahashi.okayama.jp 01
+takahata.yamagata.jp 01
+takaishi.osaka.jp 01
+takamatsu.kagawa.jp 01
+takamori.kumamoto.jp 01
+takamori.nagano.jp 01
+takanabe.miyazaki.jp 01
+takanezawa.tochigi.jp 01
+takaoka.toyama.jp 01
+takarazuka.hyogo.jp 01
+takasago.hyogo.jp 01
+takasaki.gunma.jp 01
+takashima.shiga.jp 01
+takasu.hokkaido.jp 01
+takata.fukuoka.jp 01
+takatori.nara.jp 01
+takatsuki.osaka.jp 01
+takatsuki.shiga.jp 01
+takayama.gifu.jp 01
+takayama.gunma.jp 01
+takayama.nagano.jp 01
+takazaki.miyazaki.jp 01
+takehara.hiroshima.jp 01
+taketa.oita.jp 01
+taketomi.okinawa.jp 01
+taki.mie.jp 01
+takikawa.hokkaido.jp 01
+takino.hyogo.jp 01
+takinoue.hokkaido.jp 01
+takko.aomori.jp 01
+tako.chiba.jp 01
+taku.saga.jp 01
+talk 01
+tama.tokyo.jp 01
+tamakawa.fukushima.jp 01
+tamaki.mie.jp 01
+tamamura.gunma.jp 01
+tamano.okayama.jp 01
+tamatsukuri.ibaraki.jp 01
+tamayu.shimane.jp 01
+tamba.hyogo.jp 01
+tana.no 01
+tanabe.kyoto.jp 01
+tanabe.wakayama.jp 01
+tanagura.fukushima.jp 01
+tananger.no 01
+tank.jp 08
+tanohata.iwate.jp 01
+taobao 01
+tara.saga.jp 01
+tarama.okinawa.jp 01
+taranto.it 01
+target 01
+targi.pl 01
+tarnobrzeg.pl 01
+tarpit.replit.dev 08
+tarui.gifu.jp 01
+tarumizu.kagoshima.jp 01
+tas.au 01
+tas.edu.au 01
+tas.gov.au 01
+tashkent.su 08
+tatamotors 01
+tatar 01
+tatebayashi.gunma.jp 01
+tateshina.nagano.jp 01
+tateyama.chiba.jp 01
+tateyama.toyama.jp 01
+tatsuno.hyogo.jp 01
+tatsuno.nagano.jp 01
+tattoo 01
+taveusercontent.com 08
+tawaramoto.nara.jp 01
+tax 01
+taxi 01
+taxi.aero 01
+taxi.br 01
+tayninh.vn 01
+tc 01
+tc.br 01
+tche.br 08
+tci 01
+tcp4.me 08
+td 01
+tdk 01
+te.it 01
+te.ua 01
+teaches-yoga.com 08
+team 01
+teams.replit.dev 08
+tec.br 01
+tec.mi.us 01
+tec.ve 01
+tech 01
+tech.ec 01
+tech.orange 08
+technology 01
+tecnologia.bo 01
+tel 01
+tel.tr 01
+telebit.app 08
+telebit.io 08
+telebit.xyz 10
+teleport.sh 08
+temasek 01
+temp-dns.com 08
+tempio-olbia.it 01
+tempioolbia.it 01
+tempurl.host 08
+tendo.yamagata.jp 01
+tenei.fukushima.jp 01
+tenkawa.nara.jp 01
+tennis 01
+tenri.nara.jp 01
+teo.br 01
+teramo.it 01
+termez.su 08
+terni.it 01
+ternopil.ua 01
+teshikaga.hokkaido.jp 01
+test-iserv.de 08
+test.tj 01
+tests.cx 08
+teva 01
+tf 01
+tg 01
+tgory.pl 01
+th 01
+thaibinh.vn 01
+thainguyen.vn 01
+thanhhoa.vn 01
+thanhphohochiminh.vn 01
+thd 01
+the.br 01
+theater 01
+theatre 01
+theshop.jp 08
+theworkpc.com 08
+thick.jp 08
+thingdustdata.com 08
+thruhere.net 08
+thuathienhue.vn 01
+tiaa 01
+tickets 01
+tickets.io 08
+tienda 01
+tiengiang.vn 01
+time.no 01
+tingvoll.no 01
+tinn.no 01
+tips 01
+tires 01
+tirol 01
+tj 01
+tj.cn 01
+tjeldsund.no 01
+tjmaxx 01
+tjome.no 01
+tjx 01
+tk 01
+tkmaxx 01
+tksat.bo 01
+tl 01
+tlon.network 08
+tm 01
+tm.cy 01
+tm.dz 01
+tm.fr 01
+tm.hu 01
+tm.km 01
+tm.mc 01
+tm.no 01
+tm.pl 01
+tm.ro 01
+tm.se 01
+tm.za 01
+tmall 01
+tmp.br 01
+tn 01
+tn.it 01
+tn.oxa.cloud 08
+tn.us 01
+to 01
+to.gov.br 01
+to.it 01
+to.leg.br 08
+toba.mie.jp 01
+tobe.ehime.jp 01
+tobetsu.hokkaido.jp 01
+tobishima.aichi.jp 01
+tochigi.jp 01
+tochigi.tochigi.jp 01
+tochio.niigata.jp 01
+toda.saitama.jp 01
+today 01
+toei.aichi.jp 01
+toga.toyama.jp 01
+togakushi.nagano.jp 01
+togane.chiba.jp 01
+togitsu.nagasaki.jp 01
+togliatti.su 08
+togo.aichi.jp 01
+togura.nagano.jp 01
+tohma.hokkaido.jp 01
+tohnosho.chiba.jp 01
+toho.fukuoka.jp 01
+tokai.aichi.jp 01
+tokai.ibaraki.jp 01
+tokamachi.niigata.jp 01
+tokashiki.okinawa.jp 01
+toki.gifu.jp 01
+tokigawa.saitama.jp 01
+tokke.no 01
+tokoname.aichi.jp 01
+tokorozawa.saitama.jp 01
+tokushima.jp 01
+tokushima.tokushima.jp 01
+tokuyama.yamaguchi.jp 01
+tokyo 01
+tokyo.jp 01
+tolga.no 01
+tomakomai.hokkaido.jp 01
+tomari.hokkaido.jp 01
+tome.miyagi.jp 01
+tomi.nagano.jp 01
+tomigusuku.okinawa.jp 01
+tomika.gifu.jp 01
+tomioka.gunma.jp 01
+tomisato.chiba.jp 01
+tomiya.miyagi.jp 01
+tomobe.ibaraki.jp 01
+tonaki.okinawa.jp 01
+tonami.toyama.jp 01
+tondabayashi.osaka.jp 01
+tone.ibaraki.jp 01
+tonkotsu.jp 08
+tono.iwate.jp 01
+tonosho.kagawa.jp 01
+tonsberg.no 01
+toolforge.org 08
+tools 01
+toon.ehime.jp 01
+top 01
+topaz.ne.jp 08
+torahime.shiga.jp 01
+toray 01
+toride.ibaraki.jp 01
+torino.it 01
+torproject.net 08
+torsken.no 01
+torun.pl 08
+tos.it 01
+tosa.kochi.jp 01
+tosashimizu.kochi.jp 01
+toscana.it 01
+toshiba 01
+toshima.tokyo.jp 01
+tosu.saga.jp 01
+total 01
+tottori.jp 01
+tottori.tottori.jp 01
+tourism.bj 01
+tourism.pl 01
+tourism.tn 01
+tours 01
+towada.aomori.jp 01
+town 01
+townnews-staging.com 08
+toya.hokkaido.jp 01
+toyako.hokkaido.jp 01
+toyama.jp 01
+toyama.toyama.jp 01
+toyo.kochi.jp 01
+toyoake.aichi.jp 01
+toyohashi.aichi.jp 01
+toyokawa.aichi.jp 01
+toyonaka.osaka.jp 01
+toyone.aichi.jp 01
+toyono.osaka.jp 01
+toyooka.hyogo.jp 01
+toyosato.shiga.jp 01
+toyota 01
+toyota.aichi.jp 01
+toyota.yamaguchi.jp 01
+toyotomi.hokkaido.jp 01
+toyotsu.fukuoka.jp 01
+toyoura.hokkaido.jp 01
+toys 01
+tozawa.yamagata.jp 01
+tozsde.hu 01
+tp.it 01
+tr 01
+tr.eu.org 08
+tr.it 01
+tr.no 01
+tra.kp 01
+trade 01
+trader.aero 01
+trading 01
+trading.aero 01
+traeumtgerade.de 08
+trafficmanager.net 08
+trafficplex.cloud 08
+trainer.aero 01
+training 01
+trana.no 01
+tranby.no 01
+trani-andria-barletta.it 01
+trani-barletta-andria.it 01
+traniandriabarletta.it 01
+tranibarlettaandria.it 01
+tranoy.no 01
+transfer-webapp-fips.us-gov-east-1.on.aws 08
+transfer-webapp-fips.us-gov-west-1.on.aws 08
+transfer-webapp.af-south-1.on.aws 08
+transfer-webapp.ap-east-1.on.aws 08
+transfer-webapp.ap-northeast-1.on.aws 08
+transfer-webapp.ap-northeast-2.on.aws 08
+transfer-webapp.ap-northeast-3.on.aws 08
+transfer-webapp.ap-south-1.on.aws 08
+transfer-webapp.ap-south-2.on.aws 08
+transfer-webapp.ap-southeast-1.on.aws 08
+transfer-webapp.ap-southeast-2.on.aws 08
+transfer-webapp.ap-southeast-3.on.aws 08
+transfer-webapp.ap-southeast-4.on.aws 08
+transfer-webapp.ap-southeast-5.on.aws 08
+transfer-webapp.ap-southeast-7.on.aws 08
+transfer-webapp.ca-central-1.on.aws 08
+transfer-webapp.ca-west-1.on.aws 08
+transfer-webapp.cn-north-1.on.amazonwebservices.com.cn 08
+transfer-webapp.cn-northwest-1.on.amazonwebservices.com.cn 08
+transfer-webapp.eu-central-1.on.aws 08
+transfer-webapp.eu-central-2.on.aws 08
+transfer-webapp.eu-north-1.on.aws 08
+transfer-webapp.eu-south-1.on.aws 08
+transfer-webapp.eu-south-2.on.aws 08
+transfer-webapp.eu-west-1.on.aws 08
+transfer-webapp.eu-west-2.on.aws 08
+transfer-webapp.eu-west-3.on.aws 08
+transfer-webapp.il-central-1.on.aws 08
+transfer-webapp.me-central-1.on.aws 08
+transfer-webapp.me-south-1.on.aws 08
+transfer-webapp.mx-central-1.on.aws 08
+transfer-webapp.sa-east-1.on.aws 08
+transfer-webapp.us-east-1.on.aws 08
+transfer-webapp.us-east-2.on.aws 08
+transfer-webapp.us-gov-east-1.on.aws 08
+transfer-webapp.us-gov-west-1.on.aws 08
+transfer-webapp.us-west-1.on.aws 08
+transfer-webapp.us-west-2.on.aws 08
+translate.goog 08
+transporte.bo 01
+transurl.be 10
+transurl.eu 10
+transurl.nl 10
+trapani.it 01
+travel 01
+travel.in 01
+travel.pl 01
+travelers 01
+travelersinsurance 01
+travinh.vn 01
+trd.br 01
+trentin-sud-tirol.it 01
+trentin-sudtirol.it 01
+trentin-sued-tirol.it 01
+trentin-suedtirol.it 01
+trentino-a-adige.it 01
+trentino-aadige.it 01
+trentino-alto-adige.it 01
+trentino-altoadige.it 01
+trentino-s-tirol.it 01
+trentino-stirol.it 01
+trentino-sud-tirol.it 01
+trentino-sudtirol.it 01
+trentino-sued-tirol.it 01
+trentino-suedtirol.it 01
+trentino.it 01
+trentinoa-adige.it 01
+trentinoaadige.it 01
+trentinoalto-adige.it 01
+trentinoaltoadige.it 01
+trentinos-tirol.it 01
+trentinostirol.it 01
+trentinosud-tirol.it 01
+trentinosudtirol.it 01
+trentinosued-tirol.it 01
+trentinosuedtirol.it 01
+trentinsud-tirol.it 01
+trentinsudtirol.it 01
+trentinsued-tirol.it 01
+trentinsuedtirol.it 01
+trento.it 01
+treviso.it 01
+trieste.it 01
+triton.zone 10
+troandin.no 01
+trogstad.no 01
+troitsk.su 08
+tromsa.no 01
+tromso.no 01
+trondheim.no 01
+trust 01
+trv 01
+try-snowplow.com 08
+trycloudflare.com 08
+trysil.no 01
+ts.it 01
+ts.net 08
+tselinograd.su 08
+tsk.tr 01
+tst.site 10
+tsu.mie.jp 01
+tsubame.niigata.jp 01
+tsubata.ishikawa.jp 01
+tsubetsu.hokkaido.jp 01
+tsuchiura.ibaraki.jp 01
+tsuga.tochigi.jp 01
+tsugaru.aomori.jp 01
+tsuiki.fukuoka.jp 01
+tsukigata.hokkaido.jp 01
+tsukiyono.gunma.jp 01
+tsukuba.ibaraki.jp 01
+tsukui.kanagawa.jp 01
+tsukumi.oita.jp 01
+tsumagoi.gunma.jp 01
+tsunan.niigata.jp 01
+tsuno.kochi.jp 01
+tsuno.miyazaki.jp 01
+tsuru.yamanashi.jp 01
+tsuruga.fukui.jp 01
+tsurugashima.saitama.jp 01
+tsurugi.ishikawa.jp 01
+tsuruoka.yamagata.jp 01
+tsuruta.aomori.jp 01
+tsushima.aichi.jp 01
+tsushima.nagasaki.jp 01
+tsuwano.shimane.jp 01
+tsuyama.okayama.jp 01
+tt 01
+tt.im 01
+tube 01
+tucker.replit.dev 08
+tui 01
+tul.ec 01
+tula.su 08
+tuleap-partners.com 08
+tunes 01
+tunk.org 08
+tunnelmole.net 08
+tur.ar 01
+tur.br 01
+tur.ec 01
+turek.pl 01
+turin.it 01
+turystyka.pl 01
+tuscany.it 01
+tushu 01
+tuva.su 08
+tuxfamily.org 08
+tuyenquang.vn 01
+tv 01
+tv.bb 01
+tv.bd 01
+tv.bo 01
+tv.br 01
+tv.eg 01
+tv.im 01
+tv.in 01
+tv.it 01
+tv.jo 01
+tv.sd 01
+tv.tr 01
+tv.tz 01
+tvedestrand.no 01
+tvs 01
+tw 01
+tw.cn 01
+twmail.cc 08
+twmail.net 08
+twmail.org 08
+tx.us 01
+tychy.pl 01
+tydal.no 01
+tynset.no 01
+typedream.app 08
+typo3server.info 08
+tysfjord.no 01
+tysnes.no 01
+tysvar.no 01
+tz 01
+u.bg 01
+u.channelsdvr.net 08
+u.se 01
+u2-local.xnbay.com 08
+u2.xnbay.com 08
+ua 01
+ubank 01
+ube.yamaguchi.jp 01
+uber.space 08
+ubs 01
+uchihara.ibaraki.jp 01
+uchiko.ehime.jp 01
+uchinada.ishikawa.jp 01
+uchinomi.kagawa.jp 01
+ud.it 01
+uda.nara.jp 01
+udi.br 01
+udine.it 01
+udono.mie.jp 01
+ueda.nagano.jp 01
+ueno.gunma.jp 01
+uenohara.yamanashi.jp 01
+ufcfan.org 08
+ug 01
+ug.gov.pl 01
+ugim.gov.pl 01
+uh-oh.jp 08
+ui.nabu.casa 08
+uio.ec 01
+uji.kyoto.jp 01
+ujiie.tochigi.jp 01
+ujitawara.kyoto.jp 01
+uk 01
+uk.cc 08
+uk.com 08
+uk.eu.org 08
+uk.in 01
+uk.net 08
+uk.oxa.cloud 08
+uk.primetel.cloud 08
+uk.reclaim.cloud 08
+uk0.bigv.io 08
+uki.kumamoto.jp 01
+ukiha.fukuoka.jp 01
+ullensaker.no 01
+ullensvang.no 01
+ulsan.kr 01
+ulvik.no 01
+um.gov.pl 01
+umaji.kochi.jp 01
+umb.it 01
+umbria.it 01
+umi.fukuoka.jp 01
+umig.gov.pl 01
+umso.co 08
+unazuki.toyama.jp 01
+under.jp 08
+undo.jp 08
+uni5.net 08
+unicloud.pl 08
+unicom 01
+union.aero 01
+unison-services.cloud 08
+univ.bj 01
+univ.sn 01
+university 01
+unjarga.no 01
+unnan.shimane.jp 01
+uno 01
+unusualperson.com 08
+unzen.nagasaki.jp 01
+uol 01
+uonuma.niigata.jp 01
+uozu.toyama.jp 01
+up.in 01
+up.railway.app 08
+upow.gov.pl 01
+upper.jp 08
+uppo.gov.pl 01
+ups 01
+upsun.app 10
+upsunapp.com 08
+urakawa.hokkaido.jp 01
+urasoe.okinawa.jp 01
+urausu.hokkaido.jp 01
+urawa.saitama.jp 01
+urayasu.chiba.jp 01
+urbino-pesaro.it 01
+urbinopesaro.it 01
+ureshino.mie.jp 01
+uri.arpa 01
+url.tw 08
+urn.arpa 01
+urown.cloud 08
+uruma.okinawa.jp 01
+uryu.hokkaido.jp 01
+us 01
+us-1.evennode.com 08
+us-2.evennode.com 08
+us-3.evennode.com 08
+us-4.evennode.com 08
+us-central-1.r.cloud.int.apple 10
+us-central-2.r.cloud.int.apple 10
+us-east-1.airflow.amazonaws.com 10
+us-east-1.amazonaws.com 08
+us-east-1.elasticbeanstalk.com 08
+us-east-1.r.cloud.int.apple 10
+us-east-1.rds.amazonaws.com 10
+us-east-2.airflow.amazonaws.com 10
+us-east-2.elasticbeanstalk.com 08
+us-east-2.r.cloud.int.apple 10
+us-east-2.rds.amazonaws.com 10
+us-gov-east-1.elasticbeanstalk.com 08
+us-gov-east-1.rds.amazonaws.com 10
+us-gov-west-1.elasticbeanstalk.com 08
+us-gov-west-1.rds.amazonaws.com 10
+us-northeast-1.rds.amazonaws.com 10
+us-west-1.airflow.amazonaws.com 10
+us-west-1.elasticbeanstalk.com 08
+us-west-1.r.cloud.int.apple 10
+us-west-1.rds.amazonaws.com 10
+us-west-2.airflow.amazonaws.com 10
+us-west-2.elasticbeanstalk.com 08
+us-west-2.r.cloud.int.apple 10
+us-west-2.rds.amazonaws.com 10
+us-west-3.r.cloud.int.apple 10
+us.cc 08
+us.ci 08
+us.com 08
+us.eu.org 08
+us.gov.pl 01
+us.in 01
+us.kg 08
+us.ngrok.io 08
+us.org 08
+us.platform.sh 08
+us.reclaim.cloud 08
+us.ug 01
+us1-plenit.com 08
+usa.oita.jp 01
+user.aseinet.ne.jp 08
+user.fm 10
+user.localcert.dev 10
+user.party.eus 08
+user.srcf.net 08
+user.webaccel.jp 08
+usercontent.goog 10
+usercontent.jp 08
+usgovcloudapp.net 08
+usgovtrafficmanager.net 08
+ushiku.ibaraki.jp 01
+usr.cloud.muni.cz 08
+ustka.pl 01
+usui.fukuoka.jp 01
+usuki.oita.jp 01
+ut.us 01
+utashinai.hokkaido.jp 01
+utazas.hu 01
+utazu.kagawa.jp 01
+uto.kumamoto.jp 01
+utsira.no 01
+utsunomiya.tochigi.jp 01
+utwente.io 08
+uw.gov.pl 01
+uwajima.ehime.jp 01
+uwu.ai 08
+uy 01
+uz 01
+uz.ua 01
+uzhgorod.ua 01
+uzhhorod.ua 01
+uzs.gov.pl 01
+v-info.info 08
+v.bg 01
+v.ua 08
+v0.build 08
+va 01
+va.it 01
+va.no 01
+va.us 01
+vaapste.no 01
+vacations 01
+vadso.no 01
+vaga.no 01
+vagan.no 01
+vagsoy.no 01
+vaksdal.no 01
+val-d-aosta.it 01
+val-daosta.it 01
+val.run 08
+vald-aosta.it 01
+valdaosta.it 01
+valer.hedmark.no 01
+valer.ostfold.no 01
+valle-aosta.it 01
+valle-d-aosta.it 01
+valle-daosta.it 01
+valle.no 01
+valleaosta.it 01
+valled-aosta.it 01
+valledaosta.it 01
+vallee-aoste.it 01
+vallee-d-aoste.it 01
+valleeaoste.it 01
+valleedaoste.it 01
+vana 01
+vang.no 01
+vanguard 01
+vanylven.no 01
+vao.it 01
+vapor.cloud 08
+vaporcloud.io 08
+vardo.no 01
+varese.it 01
+varggat.no 01
+varoy.no 01
+vb.it 01
+vc 01
+vc.it 01
+vda.it 01
+ve 01
+ve.it 01
+vefsn.no 01
+vega.no 01
+vegarshei.no 01
+vegas 01
+velvet.jp 08
+ven.it 01
+veneto.it 01
+venezia.it 01
+venice.it 01
+vennesla.no 01
+ventures 01
+verbania.it 01
+vercel.app 08
+vercel.dev 08
+vercel.run 08
+vercelli.it 01
+verdal.no 01
+verisign 01
+verona.it 01
+verran.no 01
+verse.jp 08
+versicherung 01
+versus.jp 08
+vestby.no 01
+vestnes.no 01
+vestre-slidre.no 01
+vestre-toten.no 01
+vestvagoy.no 01
+vet 01
+vet.br 01
+vet.ec 01
+veterinaire.fr 08
+veterinaire.km 01
+vevelstad.no 01
+vf.no 01
+vfs.cloud9.af-south-1.amazonaws.com 08
+vfs.cloud9.ap-east-1.amazonaws.com 08
+vfs.cloud9.ap-northeast-1.amazonaws.com 08
+vfs.cloud9.ap-northeast-2.amazonaws.com 08
+vfs.cloud9.ap-northeast-3.amazonaws.com 08
+vfs.cloud9.ap-south-1.amazonaws.com 08
+vfs.cloud9.ap-southeast-1.amazonaws.com 08
+vfs.cloud9.ap-southeast-2.amazonaws.com 08
+vfs.cloud9.ca-central-1.amazonaws.com 08
+vfs.cloud9.eu-central-1.amazonaws.com 08
+vfs.cloud9.eu-north-1.amazonaws.com 08
+vfs.cloud9.eu-south-1.amazonaws.com 08
+vfs.cloud9.eu-west-1.amazonaws.com 08
+vfs.cloud9.eu-west-2.amazonaws.com 08
+vfs.cloud9.eu-west-3.amazonaws.com 08
+vfs.cloud9.il-central-1.amazonaws.com 08
+vfs.cloud9.me-south-1.amazonaws.com 08
+vfs.cloud9.sa-east-1.amazonaws.com 08
+vfs.cloud9.us-east-1.amazonaws.com 08
+vfs.cloud9.us-east-2.amazonaws.com 08
+vfs.cloud9.us-west-1.amazonaws.com 08
+vfs.cloud9.us-west-2.amazonaws.com 08
+vg 01
+vgs.no 01
+vi 01
+vi.it 01
+vi.us 01
+viajes 01
+vibo-valentia.it 01
+vibovalentia.it 01
+vic.au 01
+vic.edu.au 01
+vic.gov.au 01
+vicenza.it 01
+video 01
+video.hu 01
+vig 01
+vik.no 01
+viking 01
+vikna.no 01
+villas 01
+vin 01
+vindafjord.no 01
+vinhlong.vn 01
+vinhphuc.vn 01
+vinnica.ua 01
+vinnytsia.ua 01
+vip 01
+vip.jelastic.cloud 08
+vipsinaapp.com 08
+virgin 01
+virtual-user.de 08
+virtualserver.io 08
+virtualuser.de 08
+visa 01
+vision 01
+vistablog.ir 08
+viterbo.it 01
+viva 01
+vivian.jp 08
+vivo 01
+vix.br 01
+vki.kr 08
+vlaanderen 01
+vladikavkaz.ru 08
+vladikavkaz.su 08
+vladimir.ru 08
+vladimir.su 08
+vlog.br 01
+vm.bytemark.co.uk 08
+vn 01
+vn.ua 01
+voagat.no 01
+vodka 01
+volda.no 01
+vologda.su 08
+volvo 01
+volyn.ua 01
+voorloper.cloud 08
+voss.no 01
+vossevangen.no 01
+vote 01
+voting 01
+voto 01
+voyage 01
+vp4.me 08
+vpndns.net 08
+vpnplus.to 08
+vps-host.net 08
+vps.hrsn.au 08
+vps.mcdir.ru 08
+vps.myjino.ru 10
+vr.it 01
+vs.it 01
+vs.mythic-beasts.com 08
+vt.it 01
+vt.us 01
+vu 01
+vultrobjects.com 10
+vusercontent.net 08
+vv.it 01
+w-corp-staticblitz.com 08
+w-credentialless-staticblitz.com 08
+w-staticblitz.com 08
+w.bg 01
+w.crm.dev 10
+w.se 01
+wa.au 01
+wa.crm.dev 10
+wa.edu.au 01
+wa.gov.au 01
+wa.us 01
+wada.nagano.jp 01
+wadl.top 10
+wafflecell.com 08
+wajiki.tokushima.jp 01
+wajima.ishikawa.jp 01
+wakasa.fukui.jp 01
+wakasa.tottori.jp 01
+wakayama.jp 01
+wakayama.wakayama.jp 01
+wake.okayama.jp 01
+wakkanai.hokkaido.jp 01
+wakuya.miyagi.jp 01
+wal.app 08
+walbrzych.pl 01
+wales 01
+walmart 01
+walter 01
+wang 01
+wanggou 01
+wanouchi.gifu.jp 01
+warabi.saitama.jp 01
+warmia.pl 01
+warszawa.pl 01
+washtenaw.mi.us 01
+wasmer.app 08
+wassamu.hokkaido.jp 01
+watarai.mie.jp 01
+watari.miyagi.jp 01
+watch 01
+watches 01
+watson.jp 08
+waw.pl 01
+wazuka.kyoto.jp 01
+wb.crm.dev 10
+wc.crm.dev 10
+wd.crm.dev 10
+we.bs 08
+we.crm.dev 10
+weather 01
+weatherchannel 01
+web.app 08
+web.bo 01
+web.core.usgovcloudapi.net 08
+web.core.windows.net 08
+web.do 01
+web.erp.dev 08
+web.gu 01
+web.id 01
+web.in 08
+web.lk 01
+web.nf 01
+web.ni 01
+web.pk 01
+web.tj 01
+web.tr 01
+web.val.run 08
+web.ve 01
+web.za 01
+webadorsite.com 08
+webcam 01
+weber 01
+webflow.io 08
+webflowtest.io 08
+webhare.dev 10
+webhop.biz 08
+webhop.info 08
+webhop.me 08
+webhop.net 08
+webhop.org 08
+webhosting.be 08
+weblike.jp 08
+webpaas.ovh.net 10
+webredirect.org 08
+website 01
+website.one 08
+website.yandexcloud.net 08
+websitebuilder.online 08
+websozai.jp 08
+webspace-host.com 08
+webspace.rocks 08
+webspaceconfig.de 08
+webthings.io 08
+webview-assets.aws-cloud9.af-south-1.amazonaws.com 08
+webview-assets.aws-cloud9.ap-east-1.amazonaws.com 08
+webview-assets.aws-cloud9.ap-northeast-1.amazonaws.com 08
+webview-assets.aws-cloud9.ap-northeast-2.amazonaws.com 08
+webview-assets.aws-cloud9.ap-northeast-3.amazonaws.com 08
+webview-assets.aws-cloud9.ap-south-1.amazonaws.com 08
+webview-assets.aws-cloud9.ap-southeast-1.amazonaws.com 08
+webview-assets.aws-cloud9.ap-southeast-2.amazonaws.com 08
+webview-assets.aws-cloud9.ca-central-1.amazonaws.com 08
+webview-assets.aws-cloud9.eu-central-1.amazonaws.com 08
+webview-assets.aws-cloud9.eu-north-1.amazonaws.com 08
+webview-assets.aws-cloud9.eu-south-1.amazonaws.com 08
+webview-assets.aws-cloud9.eu-west-1.amazonaws.com 08
+webview-assets.aws-cloud9.eu-west-2.amazonaws.com 08
+webview-assets.aws-cloud9.eu-west-3.amazonaws.com 08
+webview-assets.aws-cloud9.il-central-1.amazonaws.com 08
+webview-assets.aws-cloud9.me-south-1.amazonaws.com 08
+webview-assets.aws-cloud9.sa-east-1.amazonaws.com 08
+webview-assets.aws-cloud9.us-east-1.amazonaws.com 08
+webview-assets.aws-cloud9.us-east-2.amazonaws.com 08
+webview-assets.aws-cloud9.us-west-1.amazonaws.com 08
+webview-assets.aws-cloud9.us-west-2.amazonaws.com 08
+webview-assets.cloud9.af-south-1.amazonaws.com 08
+webview-assets.cloud9.ap-east-1.amazonaws.com 08
+webview-assets.cloud9.ap-northeast-1.amazonaws.com 08
+webview-assets.cloud9.ap-northeast-2.amazonaws.com 08
+webview-assets.cloud9.ap-northeast-3.amazonaws.com 08
+webview-assets.cloud9.ap-south-1.amazonaws.com 08
+webview-assets.cloud9.ap-southeast-1.amazonaws.com 08
+webview-assets.cloud9.ap-southeast-2.amazonaws.com 08
+webview-assets.cloud9.ca-central-1.amazonaws.com 08
+webview-assets.cloud9.eu-central-1.amazonaws.com 08
+webview-assets.cloud9.eu-north-1.amazonaws.com 08
+webview-assets.cloud9.eu-south-1.amazonaws.com 08
+webview-assets.cloud9.eu-west-1.amazonaws.com 08
+webview-assets.cloud9.eu-west-2.amazonaws.com 08
+webview-assets.cloud9.eu-west-3.amazonaws.com 08
+webview-assets.cloud9.me-south-1.amazonaws.com 08
+webview-assets.cloud9.sa-east-1.amazonaws.com 08
+webview-assets.cloud9.us-east-1.amazonaws.com 08
+webview-assets.cloud9.us-east-2.amazonaws.com 08
+webview-assets.cloud9.us-west-1.amazonaws.com 08
+webview-assets.cloud9.us-west-2.amazonaws.com 08
+wed 01
+wedding 01
+weeklylottery.org.uk 08
+wegrow.pl 01
+weibo 01
+weir 01
+wesley.replit.dev 08
+west1-us.cloudjiffy.net 08
+westeurope.azurestaticapps.net 08
+westus2.azurestaticapps.net 08
+wf 01
+wf.crm.dev 10
+whitesnow.jp 08
+whm.fr-par.scw.cloud 08
+whm.nl-ams.scw.cloud 08
+whoswho 01
+wi.us 01
+wielun.pl 01
+wien 01
+wien.funkfeuer.at 08
+wif.gov.pl 01
+wiih.gov.pl 01
+wiki 01
+wiki.bo 01
+wiki.br 01
+williamhill 01
+win 01
+winb.gov.pl 01
+windows 01
+windsurf.app 08
+windsurf.build 08
+wine 01
+winners 01
+wios.gov.pl 01
+wiredbladehosting.com 08
+witd.gov.pl 01
+with.playit.plus 08
+withgoogle.com 08
+withyoutube.com 08
+wiw.gov.pl 01
+wix.run 08
+wixsite.com 08
+wixstudio.com 08
+wixstudio.io 08
+wjg.jp 08
+wkz.gov.pl 01
+wlocl.pl 01
+wloclawek.pl 01
+wmcloud.org 08
+wme 01
+wmflabs.org 08
+wnext.app 08
+wodzislaw.pl 01
+wolomin.pl 01
+wolterskluwer 01
+woltlab-demo.com 08
+woodside 01
+worf.replit.dev 08
+work 01
+workers.dev 08
+workinggroup.aero 01
+workisboring.com 08
+works 01
+works.aero 01
+world 01
+worse-than.tv 08
+wow 01
+wp2.host 08
+wpdevcloud.com 08
+wpenginepowered.com 08
+wphostedmail.com 08
+wpmucdn.com 08
+wpmudev.host 08
+wpsquared.site 08
+writesthisblog.com 08
+wroc.pl 08
+wroclaw.pl 01
+ws 01
+wsa.gov.pl 01
+wskr.gov.pl 01
+wsse.gov.pl 01
+wtc 01
+wtf 01
+wuoz.gov.pl 01
+wv.us 01
+www.ck 04
+www.ro 01
+wy.us 01
+wzmiuw.gov.pl 01
+x.bg 01
+x.mythic-beasts.com 08
+x.se 01
+x0.com 08
+x0.to 08
+x443.pw 08
+xbox 01
+xen.prgmr.com 08
+xenonconnect.de 10
+xerox 01
+xihuan 01
+xii.jp 08
+xin 01
+xj.cn 01
+xmit.co 10
+xmit.dev 08
+xn--0trq7p7nn.jp 01
+xn--11b4c3d 01
+xn--12c1fe0br.xn--o3cw4h 01
+xn--12cfi8ixb8l.xn--o3cw4h 01
+xn--12co0c3b4eva.xn--o3cw4h 01
+xn--1ck2e1b 01
+xn--1ctwo.jp 01
+xn--1lqs03n.jp 01
+xn--1lqs71d.jp 01
+xn--1qqw23a 01
+xn--2m4a15e.jp 01
+xn--2scrj9c 01
+xn--30rr7y 01
+xn--32vp30h.jp 01
+xn--3bst00m 01
+xn--3ds443g 01
+xn--3e0b707e 01
+xn--3hcrj9c 01
+xn--3pxu8k 01
+xn--41a.xn--p1acf 08
+xn--42c2d9a 01
+xn--45br5cyl 01
+xn--45brj9c 01
+xn--45q11c 01
+xn--4dbgdty6c.xn--4dbrk0ce 01
+xn--4dbrk0ce 01
+xn--4gbrim 01
+xn--4it168d.jp 01
+xn--4it797k.jp 01
+xn--4pvxs.jp 01
+xn--54b7fta0cc 01
+xn--55qw42g 01
+xn--55qx5d 01
+xn--55qx5d.cn 01
+xn--55qx5d.hk 01
+xn--55qx5d.xn--j6w193g 01
+xn--5dbhl8d.xn--4dbrk0ce 01
+xn--5js045d.jp 01
+xn--5rtp49c.jp 01
+xn--5rtq34k.jp 01
+xn--5su34j936bgsg 01
+xn--5tzm5g 01
+xn--6btw5a.jp 01
+xn--6frz82g 01
+xn--6orx2r.jp 01
+xn--6qq986b3xl 01
+xn--7t0a264c.jp 01
+xn--80aaa0cvac.xn--p1acf 08
+xn--80adxhks 01
+xn--80ao21a 01
+xn--80aqecdr1a 01
+xn--80asehdb 01
+xn--80aswg 01
+xn--80au.xn--90a3ac 01
+xn--8dbq2a.xn--4dbrk0ce 01
+xn--8ltr62k.jp 01
+xn--8pvr4u.jp 01
+xn--8y0a063a 01
+xn--90a1af.xn--p1acf 08
+xn--90a3ac 01
+xn--90ae 01
+xn--90ais 01
+xn--90amc.xn--p1acf 08
+xn--90azh.xn--90a3ac 01
+xn--9dbq2a 01
+xn--9et52u 01
+xn--9krt00a 01
+xn--9tfky.id 01
+xn--andy-ira.no 01
+xn--aroport-bya.ci 01
+xn--asky-ira.no 01
+xn--aurskog-hland-jnb.no 01
+xn--avery-yua.no 01
+xn--b-5ga.nordland.no 01
+xn--b-5ga.telemark.no 01
+xn--b4w605ferd 01
+xn--balsan-sdtirol-nsb.it 01
+xn--bck1b9a5dre4c 01
+xn--bdddj-mrabd.no 01
+xn--bearalvhki-y4a.no 01
+xn--berlevg-jxa.no 01
+xn--bhcavuotna-s4a.no 01
+xn--bhccavuotna-k7a.no 01
+xn--bidr-5nac.no 01
+xn--bievt-0qa.no 01
+xn--bjddar-pta.no 01
+xn--blt-elab.no 01
+xn--bmlo-gra.no 01
+xn--bod-2na.no 01
+xn--bozen-sdtirol-2ob.it 01
+xn--brnny-wuac.no 01
+xn--brnnysund-m8ac.no 01
+xn--brum-voa.no 01
+xn--btsfjord-9za.no 01
+xn--bulsan-sdtirol-nsb.it 01
+xn--c1avg 01
+xn--c1avg.xn--90a3ac 01
+xn--c1avg.xn--p1acf 08
+xn--c2br7g 01
+xn--c3s14m.jp 01
+xn--cck2b3b 01
+xn--cckwcxetd 01
+xn--cesena-forl-mcb.it 01
+xn--cesenaforl-i8a.it 01
+xn--cg4bki 01
+xn--ciqpn.hk 01
+xn--clchc0ea0b2g2a9gcd 01
+xn--czr694b 01
+xn--czrs0t 01
+xn--czru2d 01
+xn--d1acj3b 01
+xn--d1alf 01
+xn--d1at.xn--90a3ac 01
+xn--d5qv7z876c.jp 01
+xn--davvenjrga-y4a.no 01
+xn--djrs72d6uy.jp 01
+xn--djty4k.jp 01
+xn--dnna-gra.no 01
+xn--drbak-wua.no 01
+xn--dyry-ira.no 01
+xn--e1a4c 01
+xn--eckvdtc9d 01
+xn--efvn9s.jp 01
+xn--efvy88h 01
+xn--ehqz56n.jp 01
+xn--elqq16h.jp 01
+xn--eveni-0qa01ga.no 01
+xn--f6qx53a.jp 01
+xn--fct429k 01
+xn--fhbei 01
+xn--finny-yua.no 01
+xn--fiq228c5hs 01
+xn--fiq64b 01
+xn--fiqs8s 01
+xn--fiqz9s 01
+xn--fjord-lra.no 01
+xn--fjq720a 01
+xn--fl-zia.no 01
+xn--flor-jra.no 01
+xn--flw351e 01
+xn--forl-cesena-fcb.it 01
+xn--forlcesena-c8a.it 01
+xn--fpcrj9c3d 01
+xn--frde-gra.no 01
+xn--frna-woa.no 01
+xn--frya-hra.no 01
+xn--fzc2c9e2c 01
+xn--fzys8d69uvgm 01
+xn--g2xx48c 01
+xn--gckr3f0f 01
+xn--gecrj9c 01
+xn--ggaviika-8ya47h.no 01
+xn--gildeskl-g0a.no 01
+xn--givuotna-8ya.no 01
+xn--gjvik-wua.no 01
+xn--gk3at1e 01
+xn--gls-elac.no 01
+xn--gmq050i.hk 01
+xn--gmqw5a.hk 01
+xn--gmqw5a.xn--j6w193g 01
+xn--gnstigbestellen-zvb.de 08
+xn--gnstigliefern-wob.de 08
+xn--h-2fa.no 01
+xn--h1ahn.xn--p1acf 08
+xn--h1aliz.xn--p1acf 08
+xn--h2breg3eve 01
+xn--h2brj9c 01
+xn--h2brj9c8c 01
+xn--h3cuzk1di.xn--o3cw4h 01
+xn--hbmer-xqa.no 01
+xn--hcesuolo-7ya35b.no 01
+xn--hebda8b.xn--4dbrk0ce 01
+xn--hery-ira.nordland.no 01
+xn--hery-ira.xn--mre-og-romsdal-qqb.no 01
+xn--hgebostad-g3a.no 01
+xn--hkkinen-5wa.fi 08
+xn--hmmrfeasta-s4ac.no 01
+xn--hnefoss-q1a.no 01
+xn--hobl-ira.no 01
+xn--holtlen-hxa.no 01
+xn--hpmir-xqa.no 01
+xn--hxt814e 01
+xn--hyanger-q1a.no 01
+xn--hylandet-54a.no 01
+xn--i1b6b1a6a2e 01
+xn--imr513n 01
+xn--indery-fya.no 01
+xn--io0a7i 01
+xn--io0a7i.cn 01
+xn--io0a7i.hk 01
+xn--j1adp.xn--p1acf 08
+xn--j1aef 01
+xn--j1aef.xn--p1acf 08
+xn--j1ael8b.xn--p1acf 08
+xn--j1amh 01
+xn--j6w193g 01
+xn--jlq480n2rg 01
+xn--jlster-bya.no 01
+xn--jrpeland-54a.no 01
+xn--jvr189m 01
+xn--k7yn95e.jp 01
+xn--karmy-yua.no 01
+xn--kbrq7o.jp 01
+xn--kcrx77d1x4a 01
+xn--kfjord-iua.no 01
+xn--klbu-woa.no 01
+xn--klt787d.jp 01
+xn--kltp7d.jp 01
+xn--kltx9a.jp 01
+xn--klty5x.jp 01
+xn--koluokta-7ya57h.no 01
+xn--kprw13d 01
+xn--kpry57d 01
+xn--kput3i 01
+xn--krager-gya.no 01
+xn--kranghke-b0a.no 01
+xn--krdsherad-m8a.no 01
+xn--krehamn-dxa.no 01
+xn--krjohka-hwab49j.no 01
+xn--ksnes-uua.no 01
+xn--kvfjord-nxa.no 01
+xn--kvitsy-fya.no 01
+xn--kvnangen-k0a.no 01
+xn--l-1fa.no 01
+xn--l1acc 01
+xn--laheadju-7ya.no 01
+xn--langevg-jxa.no 01
+xn--lcvr32d.hk 01
+xn--ldingen-q1a.no 01
+xn--leagaviika-52b.no 01
+xn--lesund-hua.no 01
+xn--lgbbat1ad8j 01
+xn--lgrd-poac.no 01
+xn--lhppi-xqa.no 01
+xn--linds-pra.no 01
+xn--loabt-0qa.no 01
+xn--lrdal-sra.no 01
+xn--lrenskog-54a.no 01
+xn--lt-liac.no 01
+xn--lten-gra.no 01
+xn--lury-ira.no 01
+xn--m3ch0j3a.xn--o3cw4h 01
+xn--mely-ira.no 01
+xn--merker-kua.no 01
+xn--mgb2ddes 01
+xn--mgb9awbf 01
+xn--mgba3a3ejt 01
+xn--mgba3a4f16a 01
+xn--mgba3a4f16a.ir 01
+xn--mgba3a4fra 01
+xn--mgba3a4fra.ir 01
+xn--mgba7c0bbn0a 01
+xn--mgbaam7a8h 01
+xn--mgbab2bd 01
+xn--mgbah1a3hjkrd 01
+xn--mgbai9a5eva00b 01
+xn--mgbai9azgqp6j 01
+xn--mgbayh7gpa 01
+xn--mgbbh1a 01
+xn--mgbbh1a71e 01
+xn--mgbc0a9azcg 01
+xn--mgbca7dzdo 01
+xn--mgbcpq6gpa1a 01
+xn--mgberp4a5d4a87g 01
+xn--mgberp4a5d4ar 01
+xn--mgbgu82a 01
+xn--mgbi4ecexp 01
+xn--mgbpl2fh 01
+xn--mgbqly7c0a67fbc 01
+xn--mgbqly7cvafr 01
+xn--mgbt3dhd 01
+xn--mgbtf8fl 01
+xn--mgbtx2b 01
+xn--mgbx4cd0ab 01
+xn--mix082f 01
+xn--mix891f 01
+xn--mjndalen-64a.no 01
+xn--mk0axi.hk 01
+xn--mk1bu44c 01
+xn--mkru45i.jp 01
+xn--mlatvuopmi-s4a.no 01
+xn--mli-tla.no 01
+xn--mlselv-iua.no 01
+xn--moreke-jua.no 01
+xn--mori-qsa.nz 01
+xn--mosjen-eya.no 01
+xn--mot-tla.no 01
+xn--msy-ula0h.no 01
+xn--mtta-vrjjat-k7af.no 01
+xn--muost-0qa.no 01
+xn--mxtq1m 01
+xn--mxtq1m.hk 01
+xn--mxtq1m.xn--j6w193g 01
+xn--ngbc5azd 01
+xn--ngbe9e0a 01
+xn--ngbrx 01
+xn--nit225k.jp 01
+xn--nmesjevuemie-tcba.no 01
+xn--nnx388a 01
+xn--node 01
+xn--nqv7f 01
+xn--nqv7fs00ema 01
+xn--nry-yla5g.no 01
+xn--ntso0iqx3a.jp 01
+xn--ntsq17g.jp 01
+xn--nttery-byae.no 01
+xn--nvuotna-hwa.no 01
+xn--nyqy26a 01
+xn--o1ac.xn--90a3ac 01
+xn--o1ach.xn--90a3ac 01
+xn--o3cw4h 01
+xn--o3cyx2a.xn--o3cw4h 01
+xn--od0alg.cn 01
+xn--od0alg.hk 01
+xn--od0alg.xn--j6w193g 01
+xn--od0aq3b.hk 01
+xn--ogbpf8fl 01
+xn--oppegrd-ixa.no 01
+xn--ostery-fya.no 01
+xn--osyro-wua.no 01
+xn--otu796d 01
+xn--p1acf 01
+xn--p1ai 01
+xn--pgbs0dh 01
+xn--porsgu-sta26f.no 01
+xn--pssu33l.jp 01
+xn--pssy2u 01
+xn--q7ce6a 01
+xn--q9jyb4c 01
+xn--qcka1pmc 01
+xn--qqqt11m.jp 01
+xn--qxa6a 01
+xn--qxam 01
+xn--rady-ira.no 01
+xn--rdal-poa.no 01
+xn--rde-ula.no 01
+xn--rdy-0nab.no 01
+xn--rennesy-v1a.no 01
+xn--rhkkervju-01af.no 01
+xn--rholt-mra.no 01
+xn--rhqv96g 01
+xn--rht27z.jp 01
+xn--rht3d.jp 01
+xn--rht61e.jp 01
+xn--risa-5na.no 01
+xn--risr-ira.no 01
+xn--rland-uua.no 01
+xn--rlingen-mxa.no 01
+xn--rmskog-bya.no 01
+xn--rny31h.jp 01
+xn--rovu88b 01
+xn--rros-gra.no 01
+xn--rskog-uua.no 01
+xn--rst-0na.no 01
+xn--rsta-fra.no 01
+xn--rvc1e0am3e 01
+xn--ryken-vua.no 01
+xn--ryrvik-bya.no 01
+xn--s-1fa.no 01
+xn--s9brj9c 01
+xn--sandnessjen-ogb.no 01
+xn--sandy-yua.no 01
+xn--sdtirol-n2a.it 01
+xn--seral-lra.no 01
+xn--ses554g 01
+xn--sgne-gra.no 01
+xn--skierv-uta.no 01
+xn--skjervy-v1a.no 01
+xn--skjk-soa.no 01
+xn--sknit-yqa.no 01
+xn--sknland-fxa.no 01
+xn--slat-5na.no 01
+xn--slt-elab.no 01
+xn--smla-hra.no 01
+xn--smna-gra.no 01
+xn--snase-nra.no 01
+xn--sndre-land-0cb.no 01
+xn--snes-poa.no 01
+xn--snsa-roa.no 01
+xn--sr-aurdal-l8a.no 01
+xn--sr-fron-q1a.no 01
+xn--sr-odal-q1a.no 01
+xn--sr-varanger-ggb.no 01
+xn--srfold-bya.no 01
+xn--srreisa-q1a.no 01
+xn--srum-gra.no 01
+xn--stjrdal-s1a.no 01
+xn--stjrdalshalsen-sqb.no 01
+xn--stre-toten-zcb.no 01
+xn--t60b56a 01
+xn--tckwe 01
+xn--tiq49xqyj 01
+xn--tjme-hra.no 01
+xn--tn0ag.hk 01
+xn--tnsberg-q1a.no 01
+xn--tor131o.jp 01
+xn--trany-yua.no 01
+xn--trentin-sd-tirol-rzb.it 01
+xn--trentin-sdtirol-7vb.it 01
+xn--trentino-sd-tirol-c3b.it 01
+xn--trentino-sdtirol-szb.it 01
+xn--trentinosd-tirol-rzb.it 01
+xn--trentinosdtirol-7vb.it 01
+xn--trentinsd-tirol-6vb.it 01
+xn--trentinsdtirol-nsb.it 01
+xn--trgstad-r1a.no 01
+xn--trna-woa.no 01
+xn--troms-zua.no 01
+xn--tysvr-vra.no 01
+xn--uc0atv.hk 01
+xn--uc0atv.xn--j6w193g 01
+xn--uc0ay4a.hk 01
+xn--uist22h.jp 01
+xn--uisz3g.jp 01
+xn--unjrga-rta.no 01
+xn--unup4y 01
+xn--uuwu58a.jp 01
+xn--vads-jra.no 01
+xn--valle-aoste-ebb.it 01
+xn--valle-d-aoste-ehb.it 01
+xn--valleaoste-e7a.it 01
+xn--valledaoste-ebb.it 01
+xn--vard-jra.no 01
+xn--vegrshei-c0a.no 01
+xn--vermgensberater-ctb 01
+xn--vermgensberatung-pwb 01
+xn--vestvgy-ixa6o.no 01
+xn--vg-yiab.no 01
+xn--vgan-qoa.no 01
+xn--vgsy-qoa0j.no 01
+xn--vgu402c.jp 01
+xn--vhquv 01
+xn--vler-qoa.hedmark.no 01
+xn--vler-qoa.xn--stfold-9xa.no 01
+xn--vre-eiker-k8a.no 01
+xn--vrggt-xqad.no 01
+xn--vry-yla5g.no 01
+xn--vuq861b 01
+xn--w4r85el8fhu5dnra 01
+xn--w4rs40l 01
+xn--wcvs22d.hk 01
+xn--wcvs22d.xn--j6w193g 01
+xn--wgbh1c 01
+xn--wgbl6a 01
+xn--xhq521b 01
+xn--xkc2al3hye2a 01
+xn--xkc2dl3a5ee0h 01
+xn--y9a3aq 01
+xn--yer-zna.no 01
+xn--yfro4i67o 01
+xn--ygarden-p1a.no 01
+xn--ygbi2ammx 01
+xn--ystre-slidre-ujb.no 01
+xn--zbx025d.jp 01
+xn--zf0avx.hk 01
+xn--zfr164b 01
+xnbay.com 08
+xs4all.space 08
+xtooldevice.com 08
+xx.kg 08
+xxx 01
+xxx.ec 01
+xyz 01
+xyz.br 01
+xz.cn 01
+y.bg 01
+y.se 01
+yabu.hyogo.jp 01
+yabuki.fukushima.jp 01
+yachimata.chiba.jp 01
+yachiyo.chiba.jp 01
+yachiyo.ibaraki.jp 01
+yachts 01
+yaese.okinawa.jp 01
+yahaba.iwate.jp 01
+yahiko.niigata.jp 01
+yahoo 01
+yaita.tochigi.jp 01
+yaizu.shizuoka.jp 01
+yakage.okayama.jp 01
+yakumo.hokkaido.jp 01
+yakumo.shimane.jp 01
+yali.mythic-beasts.com 08
+yalta.ua 01
+yamada.fukuoka.jp 01
+yamada.iwate.jp 01
+yamada.toyama.jp 01
+yamaga.kumamoto.jp 01
+yamagata.gifu.jp 01
+yamagata.ibaraki.jp 01
+yamagata.jp 01
+yamagata.nagano.jp 01
+yamagata.yamagata.jp 01
+yamaguchi.jp 01
+yamakita.kanagawa.jp 01
+yamamoto.miyagi.jp 01
+yamanakako.yamanashi.jp 01
+yamanashi.jp 01
+yamanashi.yamanashi.jp 01
+yamanobe.yamagata.jp 01
+yamanouchi.nagano.jp 01
+yamashina.kyoto.jp 01
+yamato.fukushima.jp 01
+yamato.kanagawa.jp 01
+yamato.kumamoto.jp 01
+yamatokoriyama.nara.jp 01
+yamatotakada.nara.jp 01
+yamatsuri.fukushima.jp 01
+yamaxun 01
+yamazoe.nara.jp 01
+yame.fukuoka.jp 01
+yanagawa.fukuoka.jp 01
+yanaizu.fukushima.jp 01
+yandex 01
+yandexcloud.net 08
+yao.osaka.jp 01
+yaotsu.gifu.jp 01
+yasaka.nagano.jp 01
+yashio.saitama.jp 01
+yashiro.hyogo.jp 01
+yasu.shiga.jp 01
+yasuda.kochi.jp 01
+yasugi.shimane.jp 01
+yasuoka.nagano.jp 01
+yatomi.aichi.jp 01
+yatsuka.shimane.jp 01
+yatsushiro.kumamoto.jp 01
+yawara.ibaraki.jp 01
+yawata.kyoto.jp 01
+yawatahama.ehime.jp 01
+yazu.tottori.jp 01
+ye 01
+yenbai.vn 01
+yk.ca 01
+yn.cn 01
+ynh.fr 08
+yodobashi 01
+yoga 01
+yoichi.hokkaido.jp 01
+yoita.niigata.jp 01
+yoka.hyogo.jp 01
+yokaichiba.chiba.jp 01
+yokawa.hyogo.jp 01
+yokkaichi.mie.jp 01
+yokohama 01
+yokohama.jp 02
+yokoshibahikari.chiba.jp 01
+yokosuka.kanagawa.jp 01
+yokote.akita.jp 01
+yokoze.saitama.jp 01
+yolasite.com 08
+yomitan.okinawa.jp 01
+yonabaru.okinawa.jp 01
+yonago.tottori.jp 01
+yonaguni.okinawa.jp 01
+yonezawa.yamagata.jp 01
+yono.saitama.jp 01
+yorii.saitama.jp 01
+yoro.gifu.jp 01
+yoshida.saitama.jp 01
+yoshida.shizuoka.jp 01
+yoshikawa.saitama.jp 01
+yoshimi.saitama.jp 01
+yoshino.nara.jp 01
+yoshinogari.saga.jp 01
+yoshioka.gunma.jp 01
+yotsukaido.chiba.jp 01
+you 01
+you2.pl 08
+youtube 01
+yt 01
+yuasa.wakayama.jp 01
+yufu.oita.jp 01
+yugawa.fukushima.jp 01
+yugawara.kanagawa.jp 01
+yuki.ibaraki.jp 01
+yukuhashi.fukuoka.jp 01
+yun 01
+yura.wakayama.jp 01
+yurihonjo.akita.jp 01
+yusuhara.kochi.jp 01
+yusui.kagoshima.jp 01
+yuu.yamaguchi.jp 01
+yuza.yamagata.jp 01
+yuzawa.niigata.jp 01
+z.bg 01
+z.se 01
+za.bz 08
+za.com 08
+za.net 08
+za.org 08
+zabc.net 08
+zachpomor.pl 01
+zagan.pl 01
+zakarpattia.ua 01
+zakopane.pl 08
+zama.kanagawa.jp 01
+zamami.okinawa.jp 01
+zao.miyagi.jp 01
+zap.cloud 08
+zaporizhzhe.ua 01
+zaporizhzhia.ua 01
+zappos 01
+zapto.org 08
+zara 01
+zarow.pl 01
+zeabur.app 08
+zentsuji.kagawa.jp 01
+zero 01
+zerops.app 10
+zgierz.pl 08
+zgora.pl 01
+zgorzelec.pl 01
+zhitomir.ua 01
+zhytomyr.ua 01
+zip 01
+zj.cn 01
+zlg.br 01
+zm 01
+zombie.jp 08
+zone 01
+zone.id 08
+zp.gov.pl 01
+zp.ua 01
+zpisdn.gov.pl 01
+zt.ua 01
+zuerich 01
+zushi.kanagawa.jp 01
+zw 01
+`
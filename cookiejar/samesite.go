@@ -0,0 +1,152 @@
+package cookiejar
+
+// This file is an ooni/oohttp extension. It implements the SameSite rules
+// of RFC 6265bis and partitioned cookies (CHIPS) as modern browsers do.
+
+import (
+	"net/url"
+	"time"
+
+	http "github.com/ooni/oohttp"
+)
+
+// RequestContext describes the browsing context of a request, which
+// determines which cookies a Jar sends and accepts. A site is the scheme
+// along with the eTLD+1 of the host, e.g., "https://example.com".
+type RequestContext struct {
+	// TopLevelSite is the URL of the document in the address bar, whose
+	// site is the partition of the partitioned cookies. Nil means the URL
+	// of the request. It is ignored for top-level navigations.
+	TopLevelSite *url.URL
+
+	// Initiator is the URL of the document that initiated the request,
+	// e.g., using a link, a form or a script. Nil means the user did,
+	// e.g., by typing the URL or using a bookmark.
+	Initiator *url.URL
+
+	// Method is the method of the request. The empty string means GET.
+	Method string
+
+	// TopLevelNavigation is true when the request loads the document in
+	// the address bar, rather than a subresource or a frame.
+	TopLevelNavigation bool
+}
+
+// CookiesForRequest is like Cookies but only returns the cookies that a
+// modern browser sends to u in the given context. That is:
+//   - SameSite=Strict cookies only for same-site requests;
+//   - SameSite=Lax cookies, and those without SameSite, also for cross-site
+//     top-level navigations using safe methods, e.g., GET;
+//   - SameSite=None cookies for any request, provided they are secure;
+//   - partitioned cookies only when the top-level site is the one that
+//     was in effect when the jar received them.
+//
+// A request is same-site when the initiator, if any, and the top-level
+// site have the same site as u.
+func (j *Jar) CookiesForRequest(u *url.URL, rc RequestContext) []*http.Cookie {
+	return j.cookiesForRequest(u, &rc, time.Now())
+}
+
+// SetCookiesForRequest is like SetCookies but follows the rules a modern
+// browser uses when handling a response to a request for u in the given
+// context. That is, a cross-site response that is not a top-level
+// navigation can only set secure SameSite=None cookies, and partitioned
+// cookies belong to the partition of the top-level site.
+func (j *Jar) SetCookiesForRequest(u *url.URL, cookies []*http.Cookie, rc RequestContext) {
+	if j.setCookiesForRequest(u, cookies, &rc, time.Now()) {
+		j.save()
+	}
+}
+
+// requestSite is the outcome of evaluating the context of a request.
+type requestSite struct {
+	// partitionKey is the site of the top-level document.
+	partitionKey string
+
+	// enforce is true when we enforce the SameSite rules.
+	enforce bool
+
+	// sameSite is true for same-site requests.
+	sameSite bool
+
+	// lax is true when we can send SameSite=Lax cookies.
+	lax bool
+
+	// topLevelNavigation is the corresponding field of RequestContext.
+	topLevelNavigation bool
+}
+
+// newRequestSite evaluates the context rc, which may be nil, of a request
+// for u, whose canonical host is host.
+func (j *Jar) newRequestSite(u *url.URL, host string, rc *RequestContext) requestSite {
+	reqSite := u.Scheme + "://" + jarKey(host, j.psList)
+	if rc == nil {
+		return requestSite{partitionKey: reqSite}
+	}
+	s := requestSite{
+		partitionKey:       reqSite,
+		enforce:            true,
+		topLevelNavigation: rc.TopLevelNavigation,
+	}
+	if !rc.TopLevelNavigation && rc.TopLevelSite != nil {
+		s.partitionKey = j.siteOf(rc.TopLevelSite)
+	}
+	s.sameSite = s.partitionKey == reqSite && (rc.Initiator == nil || j.siteOf(rc.Initiator) == reqSite)
+	s.lax = s.sameSite || (rc.TopLevelNavigation && isSafeMethod(rc.Method))
+	return s
+}
+
+// siteOf returns the site of u, or the empty string if u has no valid host.
+func (j *Jar) siteOf(u *url.URL) string {
+	host, err := canonicalHost(u.Host)
+	if err != nil || host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + jarKey(host, j.psList)
+}
+
+// isSafeMethod reports whether method is safe according to RFC 9110.
+func isSafeMethod(method string) bool {
+	switch method {
+	case "", "GET", "HEAD", "OPTIONS", "TRACE":
+		return true
+	}
+	return false
+}
+
+// allowsSend reports whether we can send e in this context.
+func (s *requestSite) allowsSend(e *entry) bool {
+	if e.PartitionKey != "" && e.PartitionKey != s.partitionKey {
+		return false
+	}
+	if !s.enforce || s.sameSite {
+		return true
+	}
+	switch e.SameSite {
+	case "SameSite=None":
+		return e.Secure
+	case "SameSite=Strict":
+		return false
+	default:
+		// Browsers treat cookies without SameSite, or with an
+		// invalid value, as SameSite=Lax.
+		return s.lax
+	}
+}
+
+// admit reports whether we can store the entry e for the cookie c, which
+// we received in this context, and assigns partitioned cookies to the
+// partition of the context. We check the attributes of c because e is
+// incomplete when c deletes a cookie.
+func (s *requestSite) admit(e *entry, c *http.Cookie) bool {
+	if s.enforce && !s.sameSite && !s.topLevelNavigation && (c.SameSite != http.SameSiteNoneMode || !c.Secure) {
+		return false
+	}
+	if e.Partitioned {
+		if s.partitionKey == "" {
+			return false
+		}
+		e.PartitionKey = s.partitionKey
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package cookiejar
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	http "github.com/ooni/oohttp"
+)
+
+// sortedCookieNames returns the sorted names of the cookies.
+func sortedCookieNames(cookies []*http.Cookie) string {
+	var names []string
+	for _, c := range cookies {
+		names = append(names, c.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, " ")
+}
+
+func TestCookiesForRequest(t *testing.T) {
+	jar := newTestJar()
+	target := mustParseURL("https://www.example.com/")
+	jar.SetCookies(target, []*http.Cookie{
+		{Name: "strict", SameSite: http.SameSiteStrictMode},
+		{Name: "lax", SameSite: http.SameSiteLaxMode},
+		{Name: "unspecified"},
+		{Name: "none", SameSite: http.SameSiteNoneMode, Secure: true},
+		{Name: "insecure-none", SameSite: http.SameSiteNoneMode},
+	})
+
+	for _, tc := range []struct {
+		name string
+		rc   RequestContext
+		want string
+	}{{
+		name: "user-initiated navigation",
+		rc:   RequestContext{TopLevelNavigation: true},
+		want: "insecure-none lax none strict unspecified",
+	}, {
+		name: "same-site subresource",
+		rc: RequestContext{
+			TopLevelSite: mustParseURL("https://example.com/"),
+			Initiator:    mustParseURL("https://static.example.com/app.js"),
+		},
+		want: "insecure-none lax none strict unspecified",
+	}, {
+		name: "cross-site navigation",
+		rc: RequestContext{
+			Initiator:          mustParseURL("https://other.com/"),
+			TopLevelNavigation: true,
+		},
+		want: "lax none unspecified",
+	}, {
+		name: "cross-site navigation using POST",
+		rc: RequestContext{
+			Initiator:          mustParseURL("https://other.com/"),
+			Method:             "POST",
+			TopLevelNavigation: true,
+		},
+		want: "none",
+	}, {
+		name: "cross-site subresource",
+		rc: RequestContext{
+			TopLevelSite: mustParseURL("https://other.com/"),
+			Initiator:    mustParseURL("https://other.com/"),
+		},
+		want: "none",
+	}, {
+		name: "same-site subresource in cross-site frame",
+		rc: RequestContext{
+			TopLevelSite: mustParseURL("https://other.com/"),
+			Initiator:    mustParseURL("https://example.com/"),
+		},
+		want: "none",
+	}, {
+		name: "schemeful cross-site subresource",
+		rc: RequestContext{
+			TopLevelSite: mustParseURL("http://example.com/"),
+		},
+		want: "none",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sortedCookieNames(jar.CookiesForRequest(target, tc.rc)); got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	// Cookies ignores SameSite as before.
+	if got := sortedCookieNames(jar.Cookies(target)); got != "insecure-none lax none strict unspecified" {
+		t.Fatalf("unexpected cookies: %q", got)
+	}
+}
+
+func TestSetCookiesForRequest(t *testing.T) {
+	jar := newTestJar()
+	target := mustParseURL("https://www.example.com/")
+	cookies := func() []*http.Cookie {
+		return []*http.Cookie{
+			{Name: "strict", SameSite: http.SameSiteStrictMode},
+			{Name: "unspecified"},
+			{Name: "none", SameSite: http.SameSiteNoneMode, Secure: true},
+			{Name: "insecure-none", SameSite: http.SameSiteNoneMode},
+		}
+	}
+	crossSite := RequestContext{
+		TopLevelSite: mustParseURL("https://other.com/"),
+		Initiator:    mustParseURL("https://other.com/"),
+	}
+	jar.SetCookiesForRequest(target, cookies(), crossSite)
+	if got := sortedCookieNames(jar.Cookies(target)); got != "none" {
+		t.Fatalf("unexpected cookies after a cross-site request: %q", got)
+	}
+
+	crossSite.TopLevelNavigation = true
+	jar.SetCookiesForRequest(target, cookies(), crossSite)
+	if got := sortedCookieNames(jar.Cookies(target)); got != "insecure-none none strict unspecified" {
+		t.Fatalf("unexpected cookies after a cross-site navigation: %q", got)
+	}
+}
+
+func TestPartitionedCookies(t *testing.T) {
+	jar := newTestJar()
+	embedded := mustParseURL("https://widget.example.com/")
+	inA := RequestContext{TopLevelSite: mustParseURL("https://a.com/")}
+	inB := RequestContext{TopLevelSite: mustParseURL("https://b.co.uk/")}
+
+	// The embedded site sets a partitioned cookie with the same name in
+	// both partitions, along with an unpartitioned one, while the one
+	// lacking the Secure attribute is rejected.
+	for _, rc := range []RequestContext{inA, inB} {
+		jar.SetCookiesForRequest(embedded, []*http.Cookie{
+			{Name: "chips", Value: rc.TopLevelSite.Host, SameSite: http.SameSiteNoneMode, Secure: true, Partitioned: true},
+			{Name: "insecure", Partitioned: true},
+		}, rc)
+	}
+	jar.SetCookies(embedded, []*http.Cookie{{Name: "plain", Value: "1", SameSite: http.SameSiteNoneMode, Secure: true}})
+
+	for _, tc := range []struct {
+		name string
+		rc   RequestContext
+		want string
+	}{
+		{"first partition", inA, "chips=a.com plain=1"},
+		{"second partition", inB, "chips=b.co.uk plain=1"},
+		{"other partition", RequestContext{TopLevelSite: mustParseURL("https://c.com/")}, "plain=1"},
+		{"own partition", RequestContext{}, "plain=1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, c := range jar.CookiesForRequest(embedded, tc.rc) {
+				got = append(got, c.Name+"="+c.Value)
+			}
+			sort.Strings(got)
+			if strings.Join(got, " ") != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	// Deleting a partitioned cookie only affects its partition.
+	jar.SetCookiesForRequest(embedded, []*http.Cookie{
+		{Name: "chips", SameSite: http.SameSiteNoneMode, Secure: true, Partitioned: true, MaxAge: -1},
+	}, inA)
+	if got := sortedCookieNames(jar.CookiesForRequest(embedded, inB)); got != "chips plain" {
+		t.Fatalf("unexpected cookies: %q", got)
+	}
+	entries := jar.AllCookies()
+	if len(entries) != 2 || entries[0].PartitionKey != "https://b.co.uk" || entries[1].Partitioned {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
@@ -157,7 +157,7 @@ func (t *Transport) IdleConnStrsForTesting_h2() []string {
 func (t *Transport) IdleConnCountForTesting(scheme, addr string) int {
 	t.idleMu.Lock()
 	defer t.idleMu.Unlock()
-	key := connectMethodKey{"", scheme, addr, false}
+	key := connectMethodKey{"", scheme, addr, false, "", ""}
 	cacheKey := key.String()
 	for k, conns := range t.idleConn {
 		if k.String() == cacheKey {
@@ -167,6 +167,12 @@ func (t *Transport) IdleConnCountForTesting(scheme, addr string) int {
 	return 0
 }
 
+func (c *AltSvcCache) LenForTesting() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
 func (t *Transport) IdleConnWaitMapSizeForTesting() int {
 	t.idleMu.Lock()
 	defer t.idleMu.Unlock()
@@ -187,7 +193,7 @@ func (t *Transport) QueueForIdleConnForTesting() {
 // persistConn for scheme, addr into the idle connection pool.
 func (t *Transport) PutIdleTestConn(scheme, addr string) bool {
 	c, _ := net.Pipe()
-	key := connectMethodKey{"", scheme, addr, false}
+	key := connectMethodKey{"", scheme, addr, false, "", ""}
 
 	if t.MaxConnsPerHost > 0 {
 		// Transport is tracking conns-per-host.
@@ -212,7 +218,7 @@ func (t *Transport) PutIdleTestConn(scheme, addr string) bool {
 // PutIdleTestConnH2 reports whether it was able to insert a fresh
 // HTTP/2 persistConn for scheme, addr into the idle connection pool.
 func (t *Transport) PutIdleTestConnH2(scheme, addr string, alt RoundTripper) bool {
-	key := connectMethodKey{"", scheme, addr, false}
+	key := connectMethodKey{"", scheme, addr, false, "", ""}
 
 	if t.MaxConnsPerHost > 0 {
 		// Transport is tracking conns-per-host.
@@ -0,0 +1,730 @@
+package fcgi
+
+// This file is an ooni/oohttp extension. It implements FastCGI from the
+// perspective of the web server, which sends requests to the application.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	http "github.com/ooni/oohttp"
+)
+
+// Client sends requests to a FastCGI application, such as php-fpm, using the
+// responder role. It is both an http.RoundTripper and an http.Handler, such
+// that a Server can front the application. The zero value is not usable,
+// since Network and Address are required. Its methods may be called
+// concurrently.
+//
+// The Client keeps the connections open between requests, unless
+// DisableKeepAlives is set. The first time it connects, the Client sends
+// an FCGI_GET_VALUES request to discover whether the application accepts
+// several concurrent requests over a single connection, in which case it
+// multiplexes them, and the maximum number of connections and requests.
+type Client struct {
+	// Network and Address identify the application, e.g., "tcp" and
+	// "127.0.0.1:9000", or "unix" and "/run/php/php-fpm.sock".
+	Network string
+	Address string
+
+	// Dial, if not nil, is used to connect to the application.
+	Dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// Root is the root URI prefix of the application or empty for "/".
+	// We use it to compute SCRIPT_NAME and PATH_INFO, as cgi.Handler does.
+	Root string
+
+	// Env contains extra parameters to send, as "key=value", which take
+	// precedence over the ones we compute from the request. For example,
+	// php-fpm needs SCRIPT_FILENAME and, possibly, DOCUMENT_ROOT.
+	Env []string
+
+	// DisableKeepAlives, if true, causes the Client to use a connection
+	// for a single request.
+	DisableKeepAlives bool
+
+	// MaxConns, if positive, limits the number of connections, such that
+	// requests wait for a connection to be available. If zero, we use the
+	// FCGI_MAX_CONNS value of the application, if any.
+	MaxConns int
+
+	// GetValuesTimeout is how long we wait for the application to reply to
+	// the FCGI_GET_VALUES request. If zero, we wait one second. If negative,
+	// we do not send the request, and we do not multiplex requests.
+	GetValuesTimeout time.Duration
+
+	// Stderr, if not nil, receives the FCGI_STDERR streams. We serialize
+	// the writes to it.
+	Stderr io.Writer
+
+	// Logger logs the errors of ServeHTTP. If nil, we use log.Print.
+	Logger *log.Logger
+
+	mu       sync.Mutex
+	conns    []*clientConn
+	dialing  int
+	wait     chan struct{} // closed when a connection may be available
+	caps     *capabilities // nil until discovered
+	stderrMu sync.Mutex
+}
+
+var _ http.RoundTripper = &Client{}
+var _ http.Handler = &Client{}
+
+// capabilities are the capabilities of the application.
+type capabilities struct {
+	multiplex bool
+	maxConns  int // zero means unlimited
+	maxReqs   int // zero means unlimited
+}
+
+// Names of the FCGI_GET_VALUES variables.
+const (
+	valueMaxConns  = "FCGI_MAX_CONNS"
+	valueMaxReqs   = "FCGI_MAX_REQS"
+	valueMpxsConns = "FCGI_MPXS_CONNS"
+)
+
+// ErrAppOverloaded is returned when the application is overloaded.
+var ErrAppOverloaded = errors.New("fcgi: application overloaded")
+
+// ErrCantMultiplex is returned when the application rejects a request
+// because it does not support multiplexing.
+var ErrCantMultiplex = errors.New("fcgi: application cannot multiplex connections")
+
+// errClientConnClosed indicates that the connection was closed before
+// the application ended the request.
+var errClientConnClosed = errors.New("fcgi: connection to application closed")
+
+// RoundTrip implements http.RoundTripper. It sends req to the application
+// and returns its response. The response body is a stream of FCGI_STDOUT
+// records, which blocks the other requests on the same connection until
+// the caller reads it or closes it.
+func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, contentLength, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	ctx := req.Context()
+	cc, creq, err := c.getConn(ctx)
+	if err != nil {
+		if body != nil {
+			body.Close()
+		}
+		return nil, err
+	}
+	go cc.writeRequest(creq, c.params(req, contentLength), body)
+	stop := context.AfterFunc(ctx, func() { cc.abort(creq, ctx.Err()) })
+	resp, err := readResponse(creq.br, req)
+	if err != nil {
+		stop()
+		cc.abort(creq, err)
+		return nil, err
+	}
+	resp.Body = &clientBody{cc: cc, req: creq, stop: stop}
+	return resp, nil
+}
+
+// requestBody returns the body to send and its length, reading it in
+// memory when its length is unknown, since applications usually rely on
+// the CONTENT_LENGTH parameter.
+func requestBody(req *http.Request) (io.ReadCloser, int64, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, 0, nil
+	}
+	if req.ContentLength > 0 {
+		return req.Body, req.ContentLength, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, 0, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+// params returns the FastCGI parameters of req, which are the same
+// environment variables cgi.Handler uses.
+func (c *Client) params(req *http.Request, contentLength int64) map[string]string {
+	host := req.Host
+	if host == "" && req.URL != nil {
+		host = req.URL.Host
+	}
+	root := strings.TrimRight(c.Root, "/")
+	requestURI := req.RequestURI
+	if requestURI == "" {
+		requestURI = req.URL.RequestURI()
+	}
+	port := "80"
+	if req.TLS != nil || req.URL.Scheme == "https" {
+		port = "443"
+	}
+	if _, p, err := net.SplitHostPort(host); err == nil {
+		port = p
+	}
+	proto := req.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	params := map[string]string{
+		"SERVER_SOFTWARE":   "go",
+		"SERVER_PROTOCOL":   proto,
+		"HTTP_HOST":         host,
+		"GATEWAY_INTERFACE": "CGI/1.1",
+		"REQUEST_METHOD":    req.Method,
+		"QUERY_STRING":      req.URL.RawQuery,
+		"REQUEST_URI":       requestURI,
+		"PATH_INFO":         strings.TrimPrefix(req.URL.Path, root),
+		"SCRIPT_NAME":       root,
+		"SERVER_PORT":       port,
+		"SERVER_NAME":       host,
+	}
+	if params["REQUEST_METHOD"] == "" {
+		params["REQUEST_METHOD"] = "GET"
+	}
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		params["SERVER_NAME"] = hostname
+	}
+	if remoteIP, remotePort, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		params["REMOTE_ADDR"], params["REMOTE_HOST"], params["REMOTE_PORT"] = remoteIP, remoteIP, remotePort
+	} else if req.RemoteAddr != "" {
+		params["REMOTE_ADDR"], params["REMOTE_HOST"] = req.RemoteAddr, req.RemoteAddr
+	}
+	if req.TLS != nil || req.URL.Scheme == "https" {
+		params["HTTPS"] = "on"
+	}
+	for k, v := range req.Header {
+		k = strings.Map(upperCaseAndUnderscore, k)
+		if k == "PROXY" {
+			// See Issue 16405
+			continue
+		}
+		joinStr := ", "
+		if k == "COOKIE" {
+			joinStr = "; "
+		}
+		params["HTTP_"+k] = strings.Join(v, joinStr)
+	}
+	if contentLength > 0 {
+		params["CONTENT_LENGTH"] = strconv.FormatInt(contentLength, 10)
+	}
+	if ctype := req.Header.Get("Content-Type"); ctype != "" {
+		params["CONTENT_TYPE"] = ctype
+	}
+	for _, e := range c.Env {
+		if k, v, ok := strings.Cut(e, "="); ok {
+			params[k] = v
+		}
+	}
+	return params
+}
+
+// upperCaseAndUnderscore is the same function used by cgi.Handler.
+func upperCaseAndUnderscore(r rune) rune {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return r - ('a' - 'A')
+	case r == '-':
+		return '_'
+	case r == '=':
+		// Maybe not part of the CGI 'spec' but would mess up
+		// the environment in any case, as Go represents the
+		// environment as a slice of "key=value" strings.
+		return '_'
+	}
+	// TODO: other transformations in spec or practice?
+	return r
+}
+
+// readResponse reads the CGI response headers from br.
+func readResponse(br *bufio.Reader, req *http.Request) (*http.Response, error) {
+	mh, err := textproto.NewReader(br).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			err = errors.New("fcgi: no headers")
+		}
+		return nil, err
+	}
+	header := http.Header(mh)
+	resp := &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		ContentLength: -1,
+		Request:       req,
+	}
+	if status := header.Get("Status"); status != "" {
+		code, err := strconv.Atoi(strings.SplitN(status, " ", 2)[0])
+		if err != nil || code < 100 || code > 999 {
+			return nil, fmt.Errorf("fcgi: bogus status: %q", status)
+		}
+		resp.StatusCode, resp.Status = code, status
+		if !strings.Contains(status, " ") {
+			resp.Status = status + " " + http.StatusText(code)
+		}
+		header.Del("Status")
+	} else if header.Get("Location") != "" {
+		resp.StatusCode, resp.Status = http.StatusFound, "302 Found"
+	}
+	if cl, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64); err == nil && cl >= 0 {
+		resp.ContentLength = cl
+	}
+	return resp, nil
+}
+
+// ServeHTTP implements http.Handler. It sends req to the application and
+// copies its response to rw, replying with 502 Bad Gateway on failure.
+func (c *Client) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	resp, err := c.RoundTrip(req)
+	if err != nil {
+		c.printf("fcgi: %v", err)
+		rw.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for k, vv := range resp.Header {
+		for _, v := range vv {
+			rw.Header().Add(k, v)
+		}
+	}
+	rw.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(rw, resp.Body); err != nil {
+		c.printf("fcgi: copy error: %v", err)
+	}
+}
+
+func (c *Client) printf(format string, v ...any) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	} else {
+		log.Printf(format, v...)
+	}
+}
+
+// GetValues sends an FCGI_GET_VALUES request for the given variables, such
+// as "FCGI_MPXS_CONNS", over a new connection and returns the reply.
+func (c *Client) GetValues(ctx context.Context, names ...string) (map[string]string, error) {
+	cc, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.close(errClientConnClosed)
+	return cc.getValues(ctx, names)
+}
+
+// CloseIdleConnections closes the connections not used by any request.
+func (c *Client) CloseIdleConnections() {
+	c.mu.Lock()
+	conns := append([]*clientConn(nil), c.conns...)
+	c.mu.Unlock()
+	for _, cc := range conns {
+		cc.closeIfIdle()
+	}
+}
+
+// getConn returns a connection along with a new request on it, dialing
+// a new connection or waiting for one to be available as needed.
+func (c *Client) getConn(ctx context.Context) (*clientConn, *clientRequest, error) {
+	for {
+		c.mu.Lock()
+		for _, cc := range c.conns {
+			if creq := cc.reserve(); creq != nil {
+				c.mu.Unlock()
+				return cc, creq, nil
+			}
+		}
+		maxConns := c.MaxConns
+		if maxConns <= 0 && c.caps != nil {
+			maxConns = c.caps.maxConns
+		}
+		if maxConns <= 0 || len(c.conns)+c.dialing < maxConns {
+			c.dialing++
+			c.mu.Unlock()
+			cc, err := c.dial(ctx)
+			c.mu.Lock()
+			c.dialing--
+			if err != nil {
+				c.notifyLocked()
+				c.mu.Unlock()
+				return nil, nil, err
+			}
+			creq := cc.reserve()
+			if creq == nil {
+				// The application closed the connection.
+				c.mu.Unlock()
+				return nil, nil, cc.err
+			}
+			c.conns = append(c.conns, cc)
+			c.mu.Unlock()
+			return cc, creq, nil
+		}
+		if c.wait == nil {
+			c.wait = make(chan struct{})
+		}
+		wait := c.wait
+		c.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// notifyLocked wakes up the requests waiting for a connection. The
+// caller must hold c.mu.
+func (c *Client) notifyLocked() {
+	if c.wait != nil {
+		close(c.wait)
+		c.wait = nil
+	}
+}
+
+// notify is like notifyLocked but acquires c.mu.
+func (c *Client) notify() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notifyLocked()
+}
+
+// removeConn removes cc from the pool.
+func (c *Client) removeConn(cc *clientConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, other := range c.conns {
+		if other == cc {
+			c.conns = append(c.conns[:i], c.conns[i+1:]...)
+			break
+		}
+	}
+	c.notifyLocked()
+}
+
+// connect establishes a new connection with the application.
+func (c *Client) connect(ctx context.Context) (*clientConn, error) {
+	dial := c.Dial
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	nc, err := dial(ctx, c.Network, c.Address)
+	if err != nil {
+		return nil, err
+	}
+	cc := &clientConn{
+		client: c,
+		nc:     nc,
+		c:      newConn(nc),
+		reqs:   make(map[uint16]*clientRequest),
+		values: make(chan map[string]string, 1),
+		done:   make(chan struct{}),
+	}
+	go cc.readLoop()
+	return cc, nil
+}
+
+// dial establishes a new connection for requests, discovering the
+// capabilities of the application the first time.
+func (c *Client) dial(ctx context.Context) (*clientConn, error) {
+	cc, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	caps := c.caps
+	c.mu.Unlock()
+	if caps == nil {
+		caps = c.discover(ctx, cc)
+		c.mu.Lock()
+		c.caps = caps
+		c.mu.Unlock()
+	}
+	cc.caps = *caps
+	cc.keepAlive = !c.DisableKeepAlives
+	return cc, nil
+}
+
+// discover discovers the capabilities of the application using cc.
+func (c *Client) discover(ctx context.Context, cc *clientConn) *capabilities {
+	caps := &capabilities{}
+	timeout := c.GetValuesTimeout
+	if timeout < 0 {
+		return caps
+	}
+	if timeout == 0 {
+		timeout = time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	values, err := cc.getValues(ctx, []string{valueMaxConns, valueMaxReqs, valueMpxsConns})
+	if err != nil {
+		return caps
+	}
+	caps.multiplex = values[valueMpxsConns] == "1"
+	caps.maxConns, _ = strconv.Atoi(values[valueMaxConns])
+	caps.maxReqs, _ = strconv.Atoi(values[valueMaxReqs])
+	return caps
+}
+
+// writeStderr writes the content of an FCGI_STDERR record.
+func (c *Client) writeStderr(p []byte) {
+	if c.Stderr == nil {
+		return
+	}
+	c.stderrMu.Lock()
+	defer c.stderrMu.Unlock()
+	c.Stderr.Write(p)
+}
+
+// clientConn is a connection to the application.
+type clientConn struct {
+	client    *Client
+	nc        net.Conn
+	c         *conn
+	caps      capabilities
+	keepAlive bool
+	values    chan map[string]string // FCGI_GET_VALUES_RESULT
+	done      chan struct{}          // closed by close
+
+	mu     sync.Mutex
+	reqs   map[uint16]*clientRequest // keyed by request ID
+	nextID uint16
+	used   bool // whether we sent any request
+	err    error
+}
+
+// clientRequest is an in-progress request.
+type clientRequest struct {
+	id uint16
+	pr *io.PipeReader
+	pw *io.PipeWriter
+	br *bufio.Reader
+
+	mu    sync.Mutex
+	ended bool // whether we closed the body
+}
+
+// reserve returns a new request on cc, or nil if cc cannot send it.
+func (cc *clientConn) reserve() *clientRequest {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.err != nil || (cc.used && !cc.keepAlive) {
+		return nil
+	}
+	if n := len(cc.reqs); n > 0 && (!cc.caps.multiplex || (cc.caps.maxReqs > 0 && n >= cc.caps.maxReqs)) {
+		return nil
+	}
+	if len(cc.reqs) >= 0xffff {
+		return nil
+	}
+	for {
+		cc.nextID++
+		if _, found := cc.reqs[cc.nextID]; !found && cc.nextID != 0 {
+			break
+		}
+	}
+	creq := &clientRequest{id: cc.nextID}
+	creq.pr, creq.pw = io.Pipe()
+	creq.br = bufio.NewReader(creq.pr)
+	cc.reqs[creq.id] = creq
+	cc.used = true
+	return creq
+}
+
+// writeRequest writes the records of creq. On failure, it closes cc.
+func (cc *clientConn) writeRequest(creq *clientRequest, params map[string]string, body io.ReadCloser) {
+	if body != nil {
+		defer body.Close()
+	}
+	b := [8]byte{0, roleResponder}
+	if cc.keepAlive {
+		b[2] = flagKeepConn
+	}
+	err := cc.c.writeRecord(typeBeginRequest, creq.id, b[:])
+	if err == nil {
+		err = cc.c.writePairs(typeParams, creq.id, params)
+	}
+	if err == nil {
+		w := newWriter(cc.c, typeStdin, creq.id)
+		if body != nil {
+			_, err = io.Copy(w, body)
+		}
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		cc.close(err)
+	}
+}
+
+// abort aborts creq, unless the application already ended it. We keep
+// creq on cc until the application ends it, such that we do not reuse
+// its ID or send other requests on cc if it does not multiplex them.
+func (cc *clientConn) abort(creq *clientRequest, err error) {
+	if creq.finish(err) {
+		cc.c.writeRecord(typeAbortRequest, creq.id, nil)
+	}
+}
+
+// finish closes the body of creq with err, returning false if we
+// already closed it.
+func (creq *clientRequest) finish(err error) bool {
+	creq.mu.Lock()
+	ended := creq.ended
+	creq.ended = true
+	creq.mu.Unlock()
+	if ended {
+		return false
+	}
+	creq.pw.CloseWithError(err)
+	return true
+}
+
+// endRequest handles the FCGI_END_REQUEST record of creq.
+func (cc *clientConn) endRequest(creq *clientRequest, err error) {
+	creq.finish(err)
+	cc.mu.Lock()
+	delete(cc.reqs, creq.id)
+	reusable := cc.err == nil && cc.keepAlive
+	cc.mu.Unlock()
+	if !reusable {
+		cc.close(errClientConnClosed)
+	}
+	cc.client.notify()
+}
+
+// readLoop reads the records the application sends until cc is closed.
+func (cc *clientConn) readLoop() {
+	var rec record
+	for {
+		if err := rec.read(cc.nc); err != nil {
+			if err == io.EOF {
+				err = errClientConnClosed
+			}
+			cc.close(err)
+			return
+		}
+		if rec.h.Id == 0 {
+			if rec.h.Type == typeGetValuesResult {
+				values := &request{params: map[string]string{}, rawParams: rec.content()}
+				values.parseParams()
+				select {
+				case cc.values <- values.params:
+				default:
+				}
+			}
+			continue
+		}
+		cc.mu.Lock()
+		creq := cc.reqs[rec.h.Id]
+		cc.mu.Unlock()
+		if creq == nil {
+			continue // the spec says to ignore unknown request IDs
+		}
+		switch rec.h.Type {
+		case typeStdout:
+			// An error means the caller closed the body.
+			creq.pw.Write(rec.content())
+		case typeStderr:
+			cc.client.writeStderr(rec.content())
+		case typeEndRequest:
+			var err error
+			if content := rec.content(); len(content) >= 5 {
+				switch content[4] {
+				case statusRequestComplete:
+				case statusCantMultiplex:
+					err = ErrCantMultiplex
+				case statusOverloaded:
+					err = ErrAppOverloaded
+				default:
+					err = fmt.Errorf("fcgi: application rejected request (app status %d, protocol status %d)",
+						binary.BigEndian.Uint32(content), content[4])
+				}
+			}
+			cc.endRequest(creq, err)
+		}
+	}
+}
+
+// getValues sends an FCGI_GET_VALUES request and waits for the reply.
+func (cc *clientConn) getValues(ctx context.Context, names []string) (map[string]string, error) {
+	query := make(map[string]string)
+	for _, name := range names {
+		query[name] = ""
+	}
+	if err := cc.c.writePairs(typeGetValues, 0, query); err != nil {
+		return nil, err
+	}
+	select {
+	case values := <-cc.values:
+		return values, nil
+	case <-cc.done:
+		return nil, cc.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// closeIfIdle closes cc if it has no requests.
+func (cc *clientConn) closeIfIdle() {
+	cc.mu.Lock()
+	idle := len(cc.reqs) == 0
+	cc.mu.Unlock()
+	if idle {
+		cc.close(errClientConnClosed)
+	}
+}
+
+// close closes cc, failing the pending requests with err.
+func (cc *clientConn) close(err error) {
+	cc.mu.Lock()
+	if cc.err != nil {
+		cc.mu.Unlock()
+		return
+	}
+	cc.err = err
+	reqs := cc.reqs
+	cc.reqs = make(map[uint16]*clientRequest)
+	close(cc.done)
+	cc.mu.Unlock()
+	cc.c.Close()
+	for _, creq := range reqs {
+		creq.finish(err)
+	}
+	cc.client.removeConn(cc)
+}
+
+// clientBody is the body of a response.
+type clientBody struct {
+	cc   *clientConn
+	req  *clientRequest
+	stop func() bool
+}
+
+func (b *clientBody) Read(p []byte) (int, error) {
+	return b.req.br.Read(p)
+}
+
+func (b *clientBody) Close() error {
+	b.stop()
+	b.cc.abort(b.req, errBodyClosed)
+	return nil
+}
+
+// errBodyClosed is returned when reading a closed response body.
+var errBodyClosed = errors.New("fcgi: read on closed response body")
@@ -0,0 +1,250 @@
+package fcgi
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	http "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// countingListener counts the accepted connections.
+type countingListener struct {
+	net.Listener
+	count atomic.Int32
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.count.Add(1)
+	}
+	return conn, err
+}
+
+// newTestChild serves handler using Serve and returns a Client for it
+// along with the listener.
+func newTestChild(t *testing.T, network, address string, handler http.Handler) (*Client, *countingListener) {
+	t.Helper()
+	l, err := net.Listen(network, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := &countingListener{Listener: l}
+	go Serve(cl, handler)
+	t.Cleanup(func() { l.Close() })
+	c := &Client{Network: network, Address: l.Addr().String()}
+	t.Cleanup(c.CloseIdleConnections)
+	return c, cl
+}
+
+// echoHandler echoes the request and the FastCGI environment.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("X-Host", r.Host)
+	w.Header().Set("X-Script-Filename", ProcessEnv(r)["SCRIPT_FILENAME"])
+	w.Header().Set("X-Remote-Addr", r.RemoteAddr)
+	if r.URL.Path == "/missing" {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	io.WriteString(w, r.Method+" "+r.URL.RequestURI()+" "+r.Header.Get("X-Foo")+" "+string(body))
+})
+
+// roundTrip sends a request using c and returns the response and its body.
+func roundTrip(t *testing.T, c *Client, method, url, body string) (*http.Response, string) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, _ := http.NewRequest(method, url, reader)
+	req.Header.Set("X-Foo", "bar")
+	resp, err := c.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(data)
+}
+
+func TestClient(t *testing.T) {
+	t.Run("we send requests over TCP reusing the connection", func(t *testing.T) {
+		c, l := newTestChild(t, "tcp", "127.0.0.1:0", echoHandler)
+		c.Env = []string{"SCRIPT_FILENAME=/srv/index.php"}
+		for _, body := range []string{"", "hello"} {
+			resp, data := roundTrip(t, c, "POST", "http://example.com/a?b=c", body)
+			if resp.StatusCode != 200 || data != "POST /a?b=c bar "+body {
+				t.Fatalf("unexpected response: %d %q", resp.StatusCode, data)
+			}
+			if resp.Header.Get("X-Host") != "example.com" || resp.Header.Get("X-Script-Filename") != "/srv/index.php" {
+				t.Fatalf("unexpected headers: %v", resp.Header)
+			}
+		}
+		if resp, _ := roundTrip(t, c, "GET", "http://example.com/missing", ""); resp.StatusCode != 404 {
+			t.Fatalf("unexpected status: %s", resp.Status)
+		}
+		if n := l.count.Load(); n != 1 {
+			t.Fatalf("expected one connection, got %d", n)
+		}
+	})
+
+	t.Run("we send requests over Unix sockets", func(t *testing.T) {
+		c, _ := newTestChild(t, "unix", filepath.Join(t.TempDir(), "fcgi.sock"), echoHandler)
+		if _, data := roundTrip(t, c, "GET", "http://example.com/", ""); data != "GET / bar " {
+			t.Fatalf("unexpected body: %q", data)
+		}
+	})
+
+	t.Run("we use a connection per request when disabling keep-alives", func(t *testing.T) {
+		c, l := newTestChild(t, "tcp", "127.0.0.1:0", echoHandler)
+		c.DisableKeepAlives = true
+		for i := 0; i < 3; i++ {
+			roundTrip(t, c, "GET", "http://example.com/", "")
+		}
+		if n := l.count.Load(); n != 3 {
+			t.Fatalf("expected three connections, got %d", n)
+		}
+	})
+
+	t.Run("we multiplex requests", func(t *testing.T) {
+		const count = 5
+		var arrived sync.WaitGroup
+		arrived.Add(count)
+		c, l := newTestChild(t, "tcp", "127.0.0.1:0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/warmup" {
+				return
+			}
+			arrived.Done()
+			arrived.Wait() // all the requests are in flight
+			io.WriteString(w, r.URL.Path)
+		}))
+		// Make sure we know the capabilities before sending the requests.
+		roundTrip(t, c, "GET", "http://example.com/warmup", "")
+		var wg sync.WaitGroup
+		for i := 0; i < count; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, _ := http.NewRequest("GET", "http://example.com/", nil)
+				resp, err := c.RoundTrip(req)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}()
+		}
+		wg.Wait()
+		if n := l.count.Load(); n != 1 {
+			t.Fatalf("expected one connection, got %d", n)
+		}
+	})
+
+	t.Run("we discover the capabilities", func(t *testing.T) {
+		c, _ := newTestChild(t, "tcp", "127.0.0.1:0", echoHandler)
+		values, err := c.GetValues(context.Background(), "FCGI_MPXS_CONNS")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values["FCGI_MPXS_CONNS"] != "1" {
+			t.Fatalf("unexpected values: %v", values)
+		}
+	})
+
+	t.Run("we accept an empty reply to FCGI_GET_VALUES", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		go func() {
+			rwc, err := l.Accept()
+			if err != nil {
+				return
+			}
+			c := newConn(rwc)
+			defer c.Close()
+			var rec record
+			if err := rec.read(rwc); err != nil || rec.h.Type != typeGetValues {
+				return
+			}
+			c.writeRecord(typeGetValuesResult, 0, nil)
+			io.Copy(io.Discard, rwc)
+		}()
+		c := &Client{Network: "tcp", Address: l.Addr().String()}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		values, err := c.GetValues(ctx, "FCGI_MPXS_CONNS")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 0 {
+			t.Fatalf("unexpected values: %v", values)
+		}
+	})
+
+	t.Run("we abort requests when the context is done", func(t *testing.T) {
+		started, unblock := make(chan struct{}), make(chan struct{})
+		defer close(unblock)
+		c, _ := newTestChild(t, "tcp", "127.0.0.1:0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-unblock
+		}))
+		ctx, cancel := context.WithCancel(context.Background())
+		req, _ := http.NewRequestWithContext(ctx, "GET", "http://example.com/", nil)
+		errc := make(chan error, 1)
+		go func() {
+			_, err := c.RoundTrip(req)
+			errc <- err
+		}()
+		<-started
+		cancel()
+		if err := <-errc; err != context.Canceled {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("we front the application with a Server", func(t *testing.T) {
+		c, _ := newTestChild(t, "tcp", "127.0.0.1:0", echoHandler)
+		srv := httptest.NewServer(c)
+		defer srv.Close()
+		req, _ := http.NewRequest("PUT", srv.URL+"/x?y=z", strings.NewReader("body"))
+		req.Header.Set("X-Foo", "bar")
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		if string(data) != "PUT /x?y=z bar body" || !strings.HasPrefix(resp.Header.Get("X-Remote-Addr"), "127.0.0.1:") {
+			t.Fatalf("unexpected response: %v %q", resp.Header, data)
+		}
+	})
+
+	t.Run("we reply with 502 when the application is down", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.Close()
+		rec := httptest.NewRecorder()
+		c := &Client{Network: "tcp", Address: l.Addr().String(), Logger: log.New(io.Discard, "", 0)}
+		c.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		if rec.Code != http.StatusBadGateway {
+			t.Fatalf("unexpected status: %d", rec.Code)
+		}
+	})
+}
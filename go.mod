@@ -1,7 +1,11 @@
 module github.com/ooni/oohttp
 
-go 1.21
+go 1.22
 
 require golang.org/x/net v0.31.0
 
-require golang.org/x/text v0.20.0 // indirect
+require (
+	golang.org/x/crypto v0.29.0 // indirect
+	golang.org/x/sys v0.27.0 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
@@ -2894,7 +2894,7 @@ func (mh *http2MetaHeadersFrame) checkPseudos() error {
 	pf := mh.PseudoFields()
 	for i, hf := range pf {
 		switch hf.Name {
-		case ":method", ":path", ":scheme", ":authority":
+		case ":method", ":path", ":scheme", ":authority", ":protocol": // ooni/oohttp extension: :protocol (RFC 8441)
 			isRequest = true
 		case ":status":
 			isResponse = true
@@ -3485,6 +3485,10 @@ func (s http2Setting) Valid() error {
 		if s.Val < 16384 || s.Val > 1<<24-1 {
 			return http2ConnectionError(http2ErrCodeProtocol)
 		}
+	case http2SettingEnableConnectProtocol: // ooni/oohttp extension
+		if s.Val != 1 && s.Val != 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
 	}
 	return nil
 }
@@ -3500,6 +3504,9 @@ const (
 	http2SettingInitialWindowSize    http2SettingID = 0x4
 	http2SettingMaxFrameSize         http2SettingID = 0x5
 	http2SettingMaxHeaderListSize    http2SettingID = 0x6
+
+	// ooni/oohttp extension: RFC 8441 Section 3
+	http2SettingEnableConnectProtocol http2SettingID = 0x8
 )
 
 var http2settingName = map[http2SettingID]string{
@@ -3509,6 +3516,8 @@ var http2settingName = map[http2SettingID]string{
 	http2SettingInitialWindowSize:    "INITIAL_WINDOW_SIZE",
 	http2SettingMaxFrameSize:         "MAX_FRAME_SIZE",
 	http2SettingMaxHeaderListSize:    "MAX_HEADER_LIST_SIZE",
+
+	http2SettingEnableConnectProtocol: "ENABLE_CONNECT_PROTOCOL", // ooni/oohttp extension
 }
 
 func (s http2SettingID) String() string {
@@ -4748,14 +4757,18 @@ func (sc *http2serverConn) serve() {
 		sc.vlogf("http2: server connection from %v on %p", sc.conn.RemoteAddr(), sc.hs)
 	}
 
+	settings := http2writeSettings{
+		{http2SettingMaxFrameSize, sc.srv.maxReadFrameSize()},
+		{http2SettingMaxConcurrentStreams, sc.advMaxStreams},
+		{http2SettingMaxHeaderListSize, sc.maxHeaderListSize()},
+		{http2SettingHeaderTableSize, sc.srv.maxDecoderHeaderTableSize()},
+		{http2SettingInitialWindowSize, uint32(sc.srv.initialStreamRecvWindowSize())},
+	}
+	if sc.hs.ExtendedConnect { // ooni/oohttp extension
+		settings = append(settings, http2Setting{http2SettingEnableConnectProtocol, 1})
+	}
 	sc.writeFrame(http2FrameWriteRequest{
-		write: http2writeSettings{
-			{http2SettingMaxFrameSize, sc.srv.maxReadFrameSize()},
-			{http2SettingMaxConcurrentStreams, sc.advMaxStreams},
-			{http2SettingMaxHeaderListSize, sc.maxHeaderListSize()},
-			{http2SettingHeaderTableSize, sc.srv.maxDecoderHeaderTableSize()},
-			{http2SettingInitialWindowSize, uint32(sc.srv.initialStreamRecvWindowSize())},
-		},
+		write: settings,
 	})
 	sc.unackedSettings++
 
@@ -6001,10 +6014,16 @@ func (sc *http2serverConn) newWriterAndRequest(st *http2stream, f *http2MetaHead
 		scheme:    f.PseudoValue("scheme"),
 		authority: f.PseudoValue("authority"),
 		path:      f.PseudoValue("path"),
+		protocol:  f.PseudoValue("protocol"),
 	}
 
 	isConnect := rp.method == "CONNECT"
-	if isConnect {
+	if rp.protocol != "" {
+		// ooni/oohttp extension: extended CONNECT (RFC 8441)
+		if !sc.hs.ExtendedConnect || !isConnect || rp.path == "" || rp.scheme == "" || rp.authority == "" {
+			return nil, nil, sc.countError("bad_extended_connect", http2streamError(f.StreamID, http2ErrCodeProtocol))
+		}
+	} else if isConnect {
 		if rp.path != "" || rp.scheme != "" || rp.authority == "" {
 			return nil, nil, sc.countError("bad_connect", http2streamError(f.StreamID, http2ErrCodeProtocol))
 		}
@@ -6026,6 +6045,9 @@ func (sc *http2serverConn) newWriterAndRequest(st *http2stream, f *http2MetaHead
 	for _, hf := range f.RegularFields() {
 		rp.header.Add(sc.canonicalHeader(hf.Name), hf.Value)
 	}
+	if rp.protocol != "" {
+		rp.header[":protocol"] = []string{rp.protocol} // ooni/oohttp extension
+	}
 	if rp.authority == "" {
 		rp.authority = rp.header.Get("Host")
 	}
@@ -6055,6 +6077,7 @@ func (sc *http2serverConn) newWriterAndRequest(st *http2stream, f *http2MetaHead
 type http2requestParam struct {
 	method                  string
 	scheme, authority, path string
+	protocol                string // ooni/oohttp extension
 	header                  Header
 }
 
@@ -6096,7 +6119,7 @@ func (sc *http2serverConn) newWriterAndRequestNoBody(st *http2stream, rp http2re
 
 	var url_ *url.URL
 	var requestURI string
-	if rp.method == "CONNECT" {
+	if rp.method == "CONNECT" && rp.protocol == "" {
 		url_ = &url.URL{Host: rp.authority}
 		requestURI = rp.authority // mimic HTTP/1 server behavior
 	} else {
@@ -7307,6 +7330,23 @@ func (t *http2Transport) disableCompression() bool {
 	return t.DisableCompression || (t.t1 != nil && t.t1.DisableCompression)
 }
 
+// ooni/oohttp extension: the Accept-Encoding header we send when we
+// request compression, which depends on the HTTP/1 Transport.
+func (t *http2Transport) acceptEncoding() string {
+	if t.t1 != nil {
+		return t.t1.acceptEncoding()
+	}
+	return "gzip"
+}
+
+// ooni/oohttp extension: the ContentDecoders of the HTTP/1 Transport.
+func (t *http2Transport) contentDecoders() ContentDecoders {
+	if t.t1 != nil {
+		return t.t1.ContentDecoders
+	}
+	return nil
+}
+
 func (t *http2Transport) pingTimeout() time.Duration {
 	if t.PingTimeout == 0 {
 		return 15 * time.Second
@@ -7444,6 +7484,20 @@ type http2ClientConn struct {
 	werr error        // first write error that has occurred
 	hbuf bytes.Buffer // HPACK encoder writes into this
 	henc *hpack.Encoder
+
+	// Fields controlled by the Transport's HTTP2Fingerprint:
+	streamInflow      int32              // initial stream-level inflow window
+	pseudoHeaderOrder []string           // or nil for the default order
+	headersPriority   http2PriorityParam // priority of HEADERS frames
+
+	h2cUpgrade *h2cUpgrade // ooni/oohttp extension: non-nil after an h2c upgrade
+
+	// ooni/oohttp extension: extended CONNECT (RFC 8441) is allowed
+	// if the first SETTINGS frame of the peer enables it. We close
+	// seenSettingsChan after processing such frame, or when the read
+	// loop exits, and we set extendedConnectAllowed before that.
+	seenSettingsChan       chan struct{}
+	extendedConnectAllowed bool
 }
 
 // clientStream is the state for a single HTTP/2 stream. One of these
@@ -7608,6 +7662,10 @@ type http2RoundTripOpt struct {
 	// no cached connection is available, RoundTripOpt
 	// will return ErrNoCachedConn.
 	OnlyCachedConn bool
+
+	// poolKey, if not empty, is the key of the connections to use in
+	// place of the authority of the request.
+	poolKey string // ooni/oohttp extension
 }
 
 func (t *http2Transport) RoundTrip(req *Request) (*Response, error) {
@@ -7654,6 +7712,10 @@ func (t *http2Transport) RoundTripOpt(req *Request, opt http2RoundTripOpt) (*Res
 	}
 
 	addr := http2authorityAddr(req.URL.Scheme, req.URL.Host)
+	addr += connPoolPartition(req.Context()) // ooni/oohttp extension
+	if opt.poolKey != "" {                   // ooni/oohttp extension
+		addr = opt.poolKey
+	}
 	for retry := 0; ; retry++ {
 		cc, err := t.connPool().GetClientConn(req, addr)
 		if err != nil {
@@ -7841,6 +7903,7 @@ func (t *http2Transport) newClientConn(c net.Conn, singleUse bool) (*http2Client
 		t:                     t,
 		tconn:                 c,
 		readerDone:            make(chan struct{}),
+		seenSettingsChan:      make(chan struct{}), // ooni/oohttp extension
 		nextStreamID:          1,
 		maxFrameSize:          16 << 10,                         // spec default
 		initialWindowSize:     65535,                            // spec default
@@ -7851,6 +7914,7 @@ func (t *http2Transport) newClientConn(c net.Conn, singleUse bool) (*http2Client
 		wantSettingsAck:       true,
 		pings:                 make(map[[8]byte]chan struct{}),
 		reqHeaderMu:           make(chan struct{}, 1),
+		streamInflow:          http2transportDefaultStreamFlow,
 	}
 	if d := t.idleConnTimeout(); d != 0 {
 		cc.idleTimeout = d
@@ -7895,24 +7959,27 @@ func (t *http2Transport) newClientConn(c net.Conn, singleUse bool) (*http2Client
 		cc.tlsState = &state
 	}
 
-	initialSettings := []http2Setting{
-		{ID: http2SettingEnablePush, Val: 0},
-		{ID: http2SettingInitialWindowSize, Val: http2transportDefaultStreamFlow},
-	}
-	if max := t.maxFrameReadSize(); max != 0 {
-		initialSettings = append(initialSettings, http2Setting{ID: http2SettingMaxFrameSize, Val: max})
-	}
-	if max := t.maxHeaderListSize(); max != 0 {
-		initialSettings = append(initialSettings, http2Setting{ID: http2SettingMaxHeaderListSize, Val: max})
-	}
-	if maxHeaderTableSize != http2initialHeaderTableSize {
-		initialSettings = append(initialSettings, http2Setting{ID: http2SettingHeaderTableSize, Val: maxHeaderTableSize})
+	initialSettings := t.initialSettings() // ooni/oohttp extension
+
+	connFlow := uint32(http2transportDefaultConnFlow)
+	fingerprint := t.fingerprint()
+	if fingerprint != nil {
+		var err error
+		if initialSettings, connFlow, err = cc.applyFingerprint(fingerprint); err != nil {
+			cc.Close()
+			return nil, err
+		}
 	}
 
 	cc.bw.Write(http2clientPreface)
 	cc.fr.WriteSettings(initialSettings...)
-	cc.fr.WriteWindowUpdate(0, http2transportDefaultConnFlow)
-	cc.inflow.init(http2transportDefaultConnFlow + http2initialWindowSize)
+	if connFlow > 0 {
+		cc.fr.WriteWindowUpdate(0, connFlow)
+	}
+	cc.inflow.init(int32(connFlow) + http2initialWindowSize)
+	if fingerprint != nil {
+		cc.writeFingerprintPriorities(fingerprint)
+	}
 	cc.bw.Flush()
 	if cc.werr != nil {
 		cc.Close()
@@ -7923,6 +7990,27 @@ func (t *http2Transport) newClientConn(c net.Conn, singleUse bool) (*http2Client
 	return cc, nil
 }
 
+// initialSettings returns the settings we send when the
+// HTTP2Fingerprint is nil.
+//
+// ooni/oohttp extension: h2c upgrades also use it.
+func (t *http2Transport) initialSettings() []http2Setting {
+	initialSettings := []http2Setting{
+		{ID: http2SettingEnablePush, Val: 0},
+		{ID: http2SettingInitialWindowSize, Val: http2transportDefaultStreamFlow},
+	}
+	if max := t.maxFrameReadSize(); max != 0 {
+		initialSettings = append(initialSettings, http2Setting{ID: http2SettingMaxFrameSize, Val: max})
+	}
+	if max := t.maxHeaderListSize(); max != 0 {
+		initialSettings = append(initialSettings, http2Setting{ID: http2SettingMaxHeaderListSize, Val: max})
+	}
+	if maxHeaderTableSize := t.maxDecoderHeaderTableSize(); maxHeaderTableSize != http2initialHeaderTableSize {
+		initialSettings = append(initialSettings, http2Setting{ID: http2SettingHeaderTableSize, Val: maxHeaderTableSize})
+	}
+	return initialSettings
+}
+
 func (cc *http2ClientConn) healthCheck() {
 	pingTimeout := cc.t.pingTimeout()
 	// We don't need to periodically ping in the health check, because the readLoop of ClientConn will
@@ -8246,6 +8334,9 @@ func (cc *http2ClientConn) closeForLostPing() {
 // exported. At least they'll be DeepEqual for h1-vs-h2 comparisons tests.
 var http2errRequestCanceled = errors.New("net/http: request canceled")
 
+// ooni/oohttp extension: see RFC 8441 Section 3.
+var http2errExtendedConnectNotSupported = errors.New("http2: extended CONNECT not supported by peer")
+
 func http2commaSeparatedTrailers(req *Request) (string, error) {
 	keys := make([]string, 0, len(req.Trailer))
 	for k := range req.Trailer {
@@ -8443,6 +8534,27 @@ func (cs *http2clientStream) writeRequest(req *Request) (err error) {
 		return err
 	}
 
+	// ooni/oohttp extension: we can only send an extended CONNECT
+	// request if the peer allows it in its first SETTINGS frame.
+	if extendedConnectProtocol(req) != "" {
+		select {
+		case <-cc.seenSettingsChan:
+			cc.mu.Lock()
+			closed := cc.closed
+			cc.mu.Unlock()
+			if closed {
+				return http2errClientConnClosed
+			}
+			if !cc.extendedConnectAllowed {
+				return http2errExtendedConnectNotSupported
+			}
+		case <-cs.reqCancel:
+			return http2errRequestCanceled
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
 	// Acquire the new-request lock by writing to reqHeaderMu.
 	// This lock guards the critical section covering allocating a new stream ID
 	// (requires mu) and creating the stream (requires wmu).
@@ -8506,7 +8618,13 @@ func (cs *http2clientStream) writeRequest(req *Request) (err error) {
 	// RoundTrip to return successfully. Since the RoundTrip contract permits
 	// the caller to "mutate or reuse" the Request after closing the Response's Body,
 	// we must take care when referencing the Request from here on.
-	err = cs.encodeAndWriteHeaders(req)
+	if u := cc.h2cUpgrade; u != nil && u.req == req {
+		// ooni/oohttp extension: we sent req using HTTP/1.1 and the
+		// server is sending the response using stream 1.
+		err = u.sent(cs)
+	} else {
+		err = cs.encodeAndWriteHeaders(req)
+	}
 	<-cc.reqHeaderMu
 	if err != nil {
 		return err
@@ -8736,6 +8854,7 @@ func (cc *http2ClientConn) writeHeaders(streamID uint32, endStream bool, maxFram
 				BlockFragment: chunk,
 				EndStream:     endStream,
 				EndHeaders:    endHeaders,
+				Priority:      cc.headersPriority,
 			})
 			first = false
 		} else {
@@ -8998,8 +9117,11 @@ func (cc *http2ClientConn) encodeHeaders(req *Request, addGzipHeader bool, trail
 		return nil, errors.New("http2: invalid Host header")
 	}
 
+	// ooni/oohttp extension: extended CONNECT (RFC 8441)
+	protocol := extendedConnectProtocol(req)
+
 	var path string
-	if req.Method != "CONNECT" {
+	if req.Method != "CONNECT" || protocol != "" {
 		path = req.URL.RequestURI()
 		if !http2validPseudoPath(path) {
 			orig := path
@@ -9018,6 +9140,9 @@ func (cc *http2ClientConn) encodeHeaders(req *Request, addGzipHeader bool, trail
 	// potentially pollute our hpack state. (We want to be able to
 	// continue to reuse the hpack encoder for future requests)
 	for k, vv := range req.Header {
+		if k == HeaderOrderKey || (k == ":protocol" && protocol != "") {
+			continue
+		}
 		if !httpguts.ValidHeaderFieldName(k) {
 			return nil, fmt.Errorf("invalid HTTP header name %q", k)
 		}
@@ -9029,21 +9154,58 @@ func (cc *http2ClientConn) encodeHeaders(req *Request, addGzipHeader bool, trail
 		}
 	}
 
+	headerOrder := req.Header.headerOrder()
+
 	enumerateHeaders := func(f func(name, value string)) {
+		// When the user asked for a specific header order, collect the
+		// regular header fields and emit them in order at the end.
+		emit := f
+		var kvs []keyValues
+		if len(headerOrder) > 0 {
+			index := make(map[string]int)
+			f = func(name, value string) {
+				if strings.HasPrefix(name, ":") {
+					emit(name, value)
+					return
+				}
+				key := strings.ToLower(name)
+				if idx, found := index[key]; found {
+					kvs[idx].values = append(kvs[idx].values, value)
+					return
+				}
+				index[key] = len(kvs)
+				kvs = append(kvs, keyValues{key, []string{value}})
+			}
+			defer func() {
+				for _, kv := range orderKeyValues(kvs, headerOrder) {
+					for _, v := range kv.values {
+						emit(kv.key, v)
+					}
+				}
+			}()
+		}
+
 		// 8.1.2.3 Request Pseudo-Header Fields
 		// The :path pseudo-header field includes the path and query parts of the
 		// target URI (the path-absolute production and optionally a '?' character
 		// followed by the query production, see Sections 3.3 and 3.4 of
 		// [RFC3986]).
-		f(":authority", host)
 		m := req.Method
 		if m == "" {
 			m = MethodGet
 		}
-		f(":method", m)
-		if req.Method != "CONNECT" {
-			f(":path", path)
-			f(":scheme", req.URL.Scheme)
+		pseudo := []keyValues{{":authority", []string{host}}, {":method", []string{m}}}
+		if req.Method != "CONNECT" || protocol != "" {
+			pseudo = append(pseudo, keyValues{":path", []string{path}}, keyValues{":scheme", []string{req.URL.Scheme}})
+		}
+		if protocol != "" {
+			pseudo = append(pseudo, keyValues{":protocol", []string{protocol}})
+		}
+		if len(cc.pseudoHeaderOrder) > 0 {
+			pseudo = orderKeyValues(pseudo, cc.pseudoHeaderOrder)
+		}
+		for _, kv := range pseudo {
+			f(kv.key, kv.values[0])
 		}
 		if trailers != "" {
 			f("trailer", trailers)
@@ -9051,7 +9213,9 @@ func (cc *http2ClientConn) encodeHeaders(req *Request, addGzipHeader bool, trail
 
 		var didUA bool
 		for k, vv := range req.Header {
-			if http2asciiEqualFold(k, "host") || http2asciiEqualFold(k, "content-length") {
+			if k == HeaderOrderKey || k == ":protocol" {
+				continue
+			} else if http2asciiEqualFold(k, "host") || http2asciiEqualFold(k, "content-length") {
 				// Host is :authority, already sent.
 				// Content-Length is automatic, set below.
 				continue
@@ -9111,7 +9275,7 @@ func (cc *http2ClientConn) encodeHeaders(req *Request, addGzipHeader bool, trail
 			f("content-length", strconv.FormatInt(contentLength, 10))
 		}
 		if addGzipHeader {
-			f("accept-encoding", "gzip")
+			f("accept-encoding", cc.t.acceptEncoding()) // ooni/oohttp extension
 		}
 		if !didUA {
 			f("user-agent", http2defaultUserAgent)
@@ -9222,7 +9386,7 @@ type http2resAndError struct {
 func (cc *http2ClientConn) addStreamLocked(cs *http2clientStream) {
 	cs.flow.add(int32(cc.initialWindowSize))
 	cs.flow.setConnFlow(&cc.flow)
-	cs.inflow.init(http2transportDefaultStreamFlow)
+	cs.inflow.init(cc.streamInflow)
 	cs.ID = cc.nextStreamID
 	cc.nextStreamID += 2
 	cc.streams[cs.ID] = cs
@@ -9323,6 +9487,12 @@ func (rl *http2clientConnReadLoop) cleanup() {
 		err = io.ErrUnexpectedEOF
 	}
 	cc.closed = true
+	if !cc.seenSettings {
+		// ooni/oohttp extension: unblock extended CONNECT requests
+		// waiting for the first SETTINGS frame, which they fail.
+		cc.seenSettings = true
+		close(cc.seenSettingsChan)
+	}
 
 	for _, cs := range cc.streams {
 		select {
@@ -9606,7 +9776,9 @@ func (rl *http2clientConnReadLoop) handleResponse(cs *http2clientStream, f *http
 	cs.bytesRemain = res.ContentLength
 	res.Body = http2transportResponseBody{cs}
 
-	if cs.requestedGzip && http2asciiEqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+	if decoders := cs.cc.t.contentDecoders(); cs.requestedGzip && len(decoders) > 0 { // ooni/oohttp extension
+		decoders.Decode(res)
+	} else if cs.requestedGzip && http2asciiEqualFold(res.Header.Get("Content-Encoding"), "gzip") {
 		res.Header.Del("Content-Encoding")
 		res.Header.Del("Content-Length")
 		res.ContentLength = -1
@@ -9975,6 +10147,16 @@ func (rl *http2clientConnReadLoop) processSettingsNoWrite(f *http2SettingsFrame)
 		case http2SettingHeaderTableSize:
 			cc.henc.SetMaxDynamicTableSize(s.Val)
 			cc.peerMaxHeaderTableSize = s.Val
+		case http2SettingEnableConnectProtocol:
+			// ooni/oohttp extension: we only honour this setting
+			// in the first SETTINGS frame, which we wait for before
+			// sending extended CONNECT requests (RFC 8441).
+			if err := s.Valid(); err != nil {
+				return err
+			}
+			if !cc.seenSettings {
+				cc.extendedConnectAllowed = s.Val == 1
+			}
 		default:
 			cc.vlogf("Unhandled Setting: %v", s)
 		}
@@ -9993,6 +10175,7 @@ func (rl *http2clientConnReadLoop) processSettingsNoWrite(f *http2SettingsFrame)
 			cc.maxConcurrentStreams = http2defaultMaxConcurrentStreams
 		}
 		cc.seenSettings = true
+		close(cc.seenSettingsChan) // ooni/oohttp extension
 	}
 
 	return nil
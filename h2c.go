@@ -0,0 +1,42 @@
+package http
+
+// This file is an ooni/oohttp extension. It implements HTTP/2 over
+// cleartext TCP (h2c) using either prior knowledge or the HTTP/1.1
+// Upgrade mechanism (RFC 7540 Section 3.2), on both the client and
+// the server side.
+
+// H2CMode controls whether the Transport uses HTTP/2 over cleartext TCP
+// (h2c) for http URLs. See the H2C field of Transport.
+type H2CMode int
+
+const (
+	// H2COff means that we use HTTP/1.1 for http URLs.
+	H2COff H2CMode = iota
+
+	// H2CPriorKnowledge means that we assume that servers speak HTTP/2
+	// and we send the HTTP/2 connection preface right after connecting.
+	H2CPriorKnowledge
+
+	// H2CUpgrade means that we send the first request of each connection
+	// using HTTP/1.1 along with "Upgrade: h2c" and we switch to HTTP/2
+	// if the server agrees. We only do that for requests without body,
+	// so a request with body on a new connection uses HTTP/1.1.
+	H2CUpgrade
+)
+
+// useH2C returns whether we should use h2c to reach the target of cm
+// using the given mode. We do not use h2c with HTTP proxies, which
+// we send absolute-URI HTTP/1.1 requests to, nor without the bundled
+// HTTP/2 implementation (i.e., using the nethttpomithttp2 build tag).
+func (t *Transport) useH2C(cm *connectMethod, mode H2CMode) bool {
+	return !omitBundledHTTP2 && t.H2C == mode && cm.targetScheme == "http" &&
+		(cm.proxyURL == nil || isSOCKSProxy(cm.proxyURL))
+}
+
+// shouldUpgradeH2C returns whether we should send req using pconn and
+// ask the server to upgrade the connection to h2c.
+func (t *Transport) shouldUpgradeH2C(cm *connectMethod, pconn *persistConn, req *Request) bool {
+	return t.useH2C(cm, H2CUpgrade) && !pconn.isProxy && !pconn.isReused() &&
+		req.outgoingLength() == 0 && req.Method != "CONNECT" &&
+		!isProtocolSwitchHeader(req.Header)
+}
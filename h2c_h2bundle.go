@@ -0,0 +1,312 @@
+//go:build !nethttpomithttp2
+
+package http
+
+// This file is an ooni/oohttp extension. It implements h2c, as described
+// in h2c.go, using the bundled HTTP/2 transport and server.
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+// errH2CUpgrade indicates that the server switched to a protocol
+// other than h2c.
+var errH2CUpgrade = errors.New("http: server did not switch to h2c")
+
+// h2cTransport returns the HTTP/2 transport we use for h2c.
+func (t *Transport) h2cTransport() *http2Transport {
+	t.h2cMu.Lock()
+	defer t.h2cMu.Unlock()
+	if t.h2cT2 == nil {
+		connPool := new(http2clientConnPool)
+		t2 := &http2Transport{
+			ConnPool:  http2noDialClientConnPool{connPool},
+			t1:        t,
+			AllowHTTP: true,
+		}
+		connPool.t = t2
+		t.h2cT2 = t2
+	}
+	return t.h2cT2
+}
+
+// closeIdleH2CConns closes the idle h2c connections.
+func (t *Transport) closeIdleH2CConns() {
+	t.h2cMu.Lock()
+	t2 := t.h2cT2
+	t.h2cMu.Unlock()
+	if t2 != nil {
+		t2.CloseIdleConnections()
+	}
+}
+
+// h2cPoolKey returns the key of the h2c connections to the target of
+// cm, which includes the proxies we use, if any, so that we never mix
+// direct and proxied connections.
+func h2cPoolKey(cm *connectMethod) string {
+	return cm.key().String()
+}
+
+// h2cRoundTripper sends requests using the h2c connections with key.
+type h2cRoundTripper struct {
+	t2  *http2Transport
+	key string
+}
+
+func (rt *h2cRoundTripper) RoundTrip(req *Request) (*Response, error) {
+	return rt.t2.RoundTripOpt(req, http2RoundTripOpt{poolKey: rt.key})
+}
+
+// addH2CConn adds cc to the pool of the h2c transport t2.
+func addH2CConn(t2 *http2Transport, key string, cc *http2ClientConn) {
+	p := t2.ConnPool.(http2noDialClientConnPool).http2clientConnPool
+	p.mu.Lock()
+	cc.getConnCalled = true // as http2addConnCall.run does
+	p.addConnLocked(key, cc)
+	p.mu.Unlock()
+}
+
+// dialH2CPriorKnowledge starts speaking HTTP/2 over pconn, which is
+// connected to the target of cm, when using H2CPriorKnowledge. It
+// returns nil if we should speak HTTP/1.1 instead.
+func (t *Transport) dialH2CPriorKnowledge(cm *connectMethod, pconn *persistConn) (RoundTripper, error) {
+	if !t.useH2C(cm, H2CPriorKnowledge) || pconn.isProxy {
+		return nil, nil
+	}
+	t2 := t.h2cTransport()
+	cc, err := t2.NewClientConn(pconn.conn)
+	if err != nil {
+		pconn.conn.Close()
+		return nil, err
+	}
+	key := h2cPoolKey(cm)
+	addH2CConn(t2, key, cc)
+	return &h2cRoundTripper{t2: t2, key: key}, nil
+}
+
+// roundTripCachedH2C sends req using a cached h2c connection to the
+// target of cm, when using H2CUpgrade. It returns an error for which
+// http2isNoCachedConnError is true when there is no such connection.
+func (t *Transport) roundTripCachedH2C(req *Request, cm *connectMethod) (*Response, error) {
+	if !t.useH2C(cm, H2CUpgrade) {
+		return nil, http2ErrNoCachedConn
+	}
+	return t.h2cTransport().RoundTripOpt(req, http2RoundTripOpt{
+		OnlyCachedConn: true,
+		poolKey:        h2cPoolKey(cm),
+	})
+}
+
+// upgradeH2C sends treq using pconn along with "Upgrade: h2c". If the
+// server switches protocols, we read the response using HTTP/2 and we
+// add the connection to the h2c pool. Otherwise, we return the HTTP/1.1
+// response, and pconn remains an HTTP/1.1 connection.
+func (t *Transport) upgradeH2C(treq *transportRequest, cm *connectMethod, pconn *persistConn) (*Response, error) {
+	req := treq.Request
+	t2 := t.h2cTransport()
+	ureq := req.Clone(req.Context())
+	ureq.Header.Set("Connection", "Upgrade, HTTP2-Settings")
+	ureq.Header.Set("Upgrade", "h2c")
+	ureq.Header.Set("HTTP2-Settings", encodeH2CSettings(t2))
+	resp, err := pconn.roundTrip(&transportRequest{Request: ureq, trace: treq.trace, cancelKey: treq.cancelKey})
+	if err != nil || resp.StatusCode != StatusSwitchingProtocols {
+		return resp, err
+	}
+	rwc := resp.Body.(io.ReadWriteCloser)
+	if !httpguts.HeaderValuesContainsToken(resp.Header["Upgrade"], "h2c") {
+		rwc.Close()
+		return nil, errH2CUpgrade
+	}
+	conn := &h2cUpgradedConn{
+		Conn:   pconn.conn,
+		rwc:    rwc,
+		ready:  make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+	cc, err := t2.NewClientConn(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	cc.mu.Lock()
+	cc.h2cUpgrade = &h2cUpgrade{req: req, conn: conn, nextStreamID: cc.nextStreamID}
+	cc.nextStreamID = 1
+	cc.mu.Unlock()
+	resp, err = cc.RoundTrip(req)
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	addH2CConn(t2, h2cPoolKey(cm), cc)
+	return resp, nil
+}
+
+// encodeH2CSettings returns the value of the HTTP2-Settings header,
+// which contains the SETTINGS that t2 sends on new connections.
+func encodeH2CSettings(t2 *http2Transport) string {
+	settings := t2.initialSettings()
+	if fp := t2.fingerprint(); fp != nil {
+		settings = settings[:0]
+		for _, s := range fp.Settings {
+			settings = append(settings, http2Setting{ID: http2SettingID(s.ID), Val: s.Val})
+		}
+	}
+	payload := make([]byte, 0, 6*len(settings))
+	for _, s := range settings {
+		payload = binary.BigEndian.AppendUint16(payload, uint16(s.ID))
+		payload = binary.BigEndian.AppendUint32(payload, s.Val)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// h2cUpgrade is the state of an HTTP/2 client connection created by
+// upgrading an HTTP/1.1 connection.
+type h2cUpgrade struct {
+	req          *Request // sent using HTTP/1.1
+	conn         *h2cUpgradedConn
+	nextStreamID uint32 // to restore after using stream 1 for req
+}
+
+// sent is called by writeRequest once cs, the stream of the request
+// we sent using HTTP/1.1, is ready to receive the response.
+func (u *h2cUpgrade) sent(cs *http2clientStream) error {
+	cc := cs.cc
+	cc.mu.Lock()
+	if cc.nextStreamID < u.nextStreamID {
+		cc.nextStreamID = u.nextStreamID
+	}
+	cc.h2cUpgrade = nil // so we don't match req again if reused
+	cc.mu.Unlock()
+	if cs.ID != 1 {
+		return errH2CUpgrade
+	}
+	cs.sentEndStream = true
+	close(u.conn.ready)
+	return nil
+}
+
+// h2cUpgradedConn is the net.Conn of an upgraded connection. The read
+// loop of the HTTP/2 client connection must not read before stream 1
+// exists, otherwise it would discard the response.
+type h2cUpgradedConn struct {
+	net.Conn
+	rwc       io.ReadWriteCloser // the body of the 101 response
+	ready     chan struct{}      // closed once stream 1 exists
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *h2cUpgradedConn) Read(p []byte) (int, error) {
+	select {
+	case <-c.ready:
+		return c.rwc.Read(p)
+	case <-c.closed:
+		return 0, net.ErrClosed
+	}
+}
+
+func (c *h2cUpgradedConn) Write(p []byte) (int, error) {
+	return c.rwc.Write(p)
+}
+
+func (c *h2cUpgradedConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return c.rwc.Close()
+}
+
+// h2cServer returns the HTTP/2 server serving h2c connections.
+func (srv *Server) h2cServer() *http2Server {
+	srv.h2cOnce.Do(func() {
+		conf := &http2Server{}
+		conf.state = &http2serverInternalState{activeConns: make(map[*http2serverConn]struct{})}
+		if srv.IdleTimeout != 0 {
+			conf.IdleTimeout = srv.IdleTimeout
+		} else {
+			conf.IdleTimeout = srv.ReadTimeout
+		}
+		srv.RegisterOnShutdown(conf.state.startGracefulShutdown)
+		srv.h2c = conf
+	})
+	return srv.h2c
+}
+
+// h2cClientPrefaceRest is what follows "PRI * HTTP/2.0\r\n\r\n" in
+// the HTTP/2 client connection preface.
+const h2cClientPrefaceRest = "SM\r\n\r\n"
+
+// serveH2C serves the connection using HTTP/2 when the first request,
+// w.req, is either the start of the HTTP/2 connection preface or an
+// "Upgrade: h2c" request without body. It returns false if the
+// connection should continue using HTTP/1.1.
+func (c *conn) serveH2C(ctx context.Context, w *response) bool {
+	req := w.req
+	opts := &http2ServeConnOpts{
+		Context:    ctx,
+		BaseConfig: c.server,
+		Handler:    serverHandler{c.server},
+	}
+	switch {
+	case req.isH2Upgrade():
+		var rest [len(h2cClientPrefaceRest)]byte
+		if _, err := io.ReadFull(c.bufr, rest[:]); err != nil || string(rest[:]) != h2cClientPrefaceRest {
+			return true // not HTTP/2: just close the connection
+		}
+		opts.SawClientPreface = true
+	case isH2CUpgradeRequest(req):
+		value := strings.TrimRight(req.Header.get("Http2-Settings"), "=")
+		settings, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			return false // ignore the upgrade and use HTTP/1.1
+		}
+		for _, k := range []string{"Connection", "Upgrade", "Http2-Settings"} {
+			req.Header.Del(k)
+		}
+		const switching = "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: h2c\r\n\r\n"
+		if _, err := io.WriteString(c.rwc, switching); err != nil {
+			return true
+		}
+		req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+		opts.UpgradeRequest = req
+		opts.Settings = settings
+	default:
+		return false
+	}
+	// Read the data buffered by c.bufr first, then from c.rwc.
+	buffered, _ := c.bufr.Peek(c.bufr.Buffered())
+	rwc := &h2cServerConn{
+		Conn: c.rwc,
+		r:    io.MultiReader(bytes.NewReader(bytes.Clone(buffered)), c.rwc),
+	}
+	c.rwc.SetDeadline(time.Time{})
+	c.server.h2cServer().ServeConn(rwc, opts)
+	return true
+}
+
+// isH2CUpgradeRequest returns whether req asks for an upgrade to h2c.
+func isH2CUpgradeRequest(req *Request) bool {
+	return req.ProtoAtLeast(1, 1) && req.Body == NoBody &&
+		httpguts.HeaderValuesContainsToken(req.Header["Upgrade"], "h2c") &&
+		httpguts.HeaderValuesContainsToken(req.Header["Connection"], "HTTP2-Settings") &&
+		len(req.Header["Http2-Settings"]) == 1
+}
+
+// h2cServerConn is a net.Conn reading from r.
+type h2cServerConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *h2cServerConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
@@ -0,0 +1,152 @@
+package http_test
+
+import (
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+func TestH2C(t *testing.T) {
+	CondSkipHTTP2(t)
+	// newServer returns a server echoing the protocol and the body of
+	// requests, which records the client addresses.
+	newServer := func(h2c bool) (*httptest.Server, func() map[string]bool) {
+		var mu sync.Mutex
+		clients := make(map[string]bool)
+		srv := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			mu.Lock()
+			clients[r.RemoteAddr] = true
+			mu.Unlock()
+			body, _ := io.ReadAll(r.Body)
+			io.WriteString(w, r.Proto+" "+string(body))
+		}))
+		srv.Config.H2C = h2c
+		srv.Start()
+		return srv, func() map[string]bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return clients
+		}
+	}
+
+	// roundTrip sends a request and returns the response body.
+	roundTrip := func(t *testing.T, txp *Transport, method, URL, body string) (*Response, string) {
+		t.Helper()
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		req, _ := NewRequest(method, URL, reader)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp, string(data)
+	}
+
+	for _, mode := range []H2CMode{H2CPriorKnowledge, H2CUpgrade} {
+		t.Run("we use HTTP/2 with H2CMode "+[]string{"", "prior knowledge", "upgrade"}[mode], func(t *testing.T) {
+			srv, clients := newServer(true)
+			defer srv.Close()
+			txp := &Transport{H2C: mode}
+			defer txp.CloseIdleConnections()
+			for _, body := range []string{"", "hello"} {
+				resp, data := roundTrip(t, txp, "POST", srv.URL, body)
+				if resp.ProtoMajor != 2 || data != "HTTP/2.0 "+body {
+					t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+				}
+			}
+			if n := len(clients()); n != 1 {
+				t.Fatalf("expected one connection, got %d", n)
+			}
+		})
+	}
+
+	for _, mode := range []H2CMode{H2CPriorKnowledge, H2CUpgrade} {
+		t.Run("we do not mix direct and proxied connections with H2CMode "+[]string{"", "prior knowledge", "upgrade"}[mode], func(t *testing.T) {
+			srv, clients := newServer(true)
+			defer srv.Close()
+			// the SOCKS5 proxy keeps the connections to srv open
+			defer srv.CloseClientConnections()
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+			targets := make(chan string, 10)
+			go serveSOCKS5(l, targets)
+			socksURL := &url.URL{Scheme: "socks5", Host: l.Addr().String()}
+			txp := &Transport{
+				H2C: mode,
+				Proxy: func(req *Request) (*url.URL, error) {
+					if req.URL.RawQuery == "proxy" {
+						return socksURL, nil
+					}
+					return nil, nil
+				},
+			}
+			defer txp.CloseIdleConnections()
+			for i := 0; i < 2; i++ {
+				for _, URL := range []string{srv.URL, srv.URL + "/?proxy"} {
+					resp, data := roundTrip(t, txp, "GET", URL, "")
+					if resp.ProtoMajor != 2 || data != "HTTP/2.0 " {
+						t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+					}
+				}
+			}
+			if n := len(clients()); n != 2 {
+				t.Fatalf("expected two connections, got %d", n)
+			}
+			if n := len(targets); n != 1 {
+				t.Fatalf("expected one proxied connection, got %d", n)
+			}
+		})
+	}
+
+	t.Run("we use HTTP/1.1 for requests with body on new connections", func(t *testing.T) {
+		srv, _ := newServer(true)
+		defer srv.Close()
+		txp := &Transport{H2C: H2CUpgrade}
+		defer txp.CloseIdleConnections()
+		resp, data := roundTrip(t, txp, "POST", srv.URL, "hello")
+		if resp.ProtoMajor != 1 || data != "HTTP/1.1 hello" {
+			t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+		}
+	})
+
+	t.Run("we fall back to HTTP/1.1 when the server does not upgrade", func(t *testing.T) {
+		srv, clients := newServer(false)
+		defer srv.Close()
+		txp := &Transport{H2C: H2CUpgrade}
+		defer txp.CloseIdleConnections()
+		for i := 0; i < 2; i++ {
+			resp, data := roundTrip(t, txp, "GET", srv.URL, "")
+			if resp.ProtoMajor != 1 || data != "HTTP/1.1 " {
+				t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+			}
+		}
+		if n := len(clients()); n != 1 {
+			t.Fatalf("expected one connection, got %d", n)
+		}
+	})
+
+	t.Run("the server keeps serving HTTP/1.1 clients", func(t *testing.T) {
+		srv, _ := newServer(true)
+		defer srv.Close()
+		resp, data := roundTrip(t, &Transport{}, "GET", srv.URL, "")
+		if resp.ProtoMajor != 1 || data != "HTTP/1.1 " {
+			t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+		}
+	})
+}
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HTTP2SettingID is the ID of an HTTP/2 SETTINGS parameter.
+type HTTP2SettingID uint16
+
+// These are the HTTP/2 SETTINGS parameters defined by RFC 9113 Section 6.5.2.
+const (
+	HTTP2SettingHeaderTableSize      HTTP2SettingID = 0x1
+	HTTP2SettingEnablePush           HTTP2SettingID = 0x2
+	HTTP2SettingMaxConcurrentStreams HTTP2SettingID = 0x3
+	HTTP2SettingInitialWindowSize    HTTP2SettingID = 0x4
+	HTTP2SettingMaxFrameSize         HTTP2SettingID = 0x5
+	HTTP2SettingMaxHeaderListSize    HTTP2SettingID = 0x6
+)
+
+// HTTP2Setting is an HTTP/2 SETTINGS parameter and its value.
+type HTTP2Setting struct {
+	ID  HTTP2SettingID
+	Val uint32
+}
+
+// HTTP2PriorityParam contains the stream priority parameters
+// of PRIORITY frames and of HEADERS frames.
+type HTTP2PriorityParam struct {
+	// StreamDep is a 31-bit stream identifier for the
+	// stream that this stream depends on. Zero means no
+	// dependency.
+	StreamDep uint32
+
+	// Exclusive is whether the dependency is exclusive.
+	Exclusive bool
+
+	// Weight is the stream's zero-indexed weight. Per the spec,
+	// "Add one to the value to obtain a weight between 1 and 256."
+	Weight uint8
+}
+
+// IsZero reports whether p is the zero value.
+func (p HTTP2PriorityParam) IsZero() bool {
+	return p == HTTP2PriorityParam{}
+}
+
+// HTTP2PriorityFrame is a PRIORITY frame to send when
+// a new HTTP/2 client connection is created.
+type HTTP2PriorityFrame struct {
+	// StreamID is the stream the PRIORITY frame applies to.
+	StreamID uint32
+
+	// HTTP2PriorityParam contains the priority parameters.
+	HTTP2PriorityParam
+}
+
+// HTTP2Fingerprint is an ooni/oohttp extension that controls the
+// frames an HTTP/2 client connection sends when it is created, as
+// well as the order of the request pseudo-header fields. These are
+// the features that allow one to fingerprint an HTTP/2 client, as
+// described by Akamai's "Passive Fingerprinting of HTTP/2 Clients".
+//
+// When a Transport's HTTP2Fingerprint is nil, the Transport uses its
+// default behavior. Otherwise, each field is used literally, e.g.,
+// an empty Settings means sending an empty SETTINGS frame.
+//
+// Use ParseHTTP2Fingerprint to obtain a fingerprint from its
+// Akamai string representation.
+type HTTP2Fingerprint struct {
+	// Settings contains the parameters of the initial SETTINGS frame
+	// in the order in which they should be sent. The Transport honours
+	// the HEADER_TABLE_SIZE, INITIAL_WINDOW_SIZE, MAX_FRAME_SIZE and
+	// MAX_HEADER_LIST_SIZE values it advertises. When there is
+	// no INITIAL_WINDOW_SIZE, the default 65535 window is used. As
+	// RFC 9113 Section 6.5.2 requires, ENABLE_PUSH must be 0 or 1,
+	// INITIAL_WINDOW_SIZE must not exceed 2^31-1 and MAX_FRAME_SIZE
+	// must be between 2^14 and 2^24-1.
+	Settings []HTTP2Setting
+
+	// ConnectionFlow is the increment of the connection-level
+	// WINDOW_UPDATE frame sent after the SETTINGS frame. Zero
+	// means not sending any WINDOW_UPDATE frame. The increment
+	// plus the initial 65535 window must not exceed 2^31-1.
+	ConnectionFlow uint32
+
+	// Priorities contains PRIORITY frames to send after the
+	// WINDOW_UPDATE frame. When a frame refers to a client stream
+	// ID, requests will use subsequent stream IDs.
+	Priorities []HTTP2PriorityFrame
+
+	// HeadersPriority contains the priority parameters of the HEADERS
+	// frames sent for each request. If zero, HEADERS frames do not
+	// include priority parameters.
+	HeadersPriority HTTP2PriorityParam
+
+	// PseudoHeaderOrder contains the order of the request pseudo-header
+	// fields (e.g., ":method", ":authority", ":scheme", ":path"). Fields
+	// that are not listed come after the listed ones using the default
+	// order. If empty, we use the default order.
+	PseudoHeaderOrder []string
+}
+
+// http2AkamaiPseudoHeaders maps the pseudo-header fields to the
+// abbreviations used by the Akamai fingerprint representation.
+var http2AkamaiPseudoHeaders = map[string]string{
+	":method":    "m",
+	":authority": "a",
+	":scheme":    "s",
+	":path":      "p",
+}
+
+// ParseHTTP2Fingerprint parses the Akamai representation of an HTTP/2
+// fingerprint, i.e., "S|WU|P|PS", where S is a semicolon-separated list
+// of "id:value" SETTINGS, WU is the WINDOW_UPDATE increment, P is "0" or
+// a comma-separated list of "stream:exclusive:dependency:weight" PRIORITY
+// frames (where weight is in the 1-256 range), and PS is a comma-separated
+// list of pseudo-header abbreviations (m, a, s, p). For example:
+//
+//	1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p
+//
+// The Akamai representation does not include HeadersPriority.
+func ParseHTTP2Fingerprint(s string) (*HTTP2Fingerprint, error) {
+	parts := strings.Split(s, "|")
+	if len(parts) != 4 {
+		return nil, errors.New("http: HTTP/2 fingerprint must contain four |-separated parts")
+	}
+	fp := &HTTP2Fingerprint{}
+	if parts[0] != "" {
+		for _, entry := range strings.Split(parts[0], ";") {
+			id, val, found := strings.Cut(entry, ":")
+			if !found {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint setting %q", entry)
+			}
+			nid, err := strconv.ParseUint(id, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint setting %q", entry)
+			}
+			nval, err := strconv.ParseUint(val, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint setting %q", entry)
+			}
+			fp.Settings = append(fp.Settings, HTTP2Setting{ID: HTTP2SettingID(nid), Val: uint32(nval)})
+		}
+	}
+	flow, err := strconv.ParseUint(parts[1], 10, 31)
+	if err != nil {
+		return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint window update %q", parts[1])
+	}
+	fp.ConnectionFlow = uint32(flow)
+	if parts[2] != "0" && parts[2] != "" {
+		for _, entry := range strings.Split(parts[2], ",") {
+			fields := strings.Split(entry, ":")
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint priority %q", entry)
+			}
+			var values [4]uint64
+			for idx, field := range fields {
+				if values[idx], err = strconv.ParseUint(field, 10, 31); err != nil {
+					return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint priority %q", entry)
+				}
+			}
+			if values[1] > 1 || values[3] < 1 || values[3] > 256 {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint priority %q", entry)
+			}
+			fp.Priorities = append(fp.Priorities, HTTP2PriorityFrame{
+				StreamID: uint32(values[0]),
+				HTTP2PriorityParam: HTTP2PriorityParam{
+					Exclusive: values[1] == 1,
+					StreamDep: uint32(values[2]),
+					Weight:    uint8(values[3] - 1),
+				},
+			})
+		}
+	}
+	if parts[3] != "" {
+		for _, abbrev := range strings.Split(parts[3], ",") {
+			var name string
+			for key, value := range http2AkamaiPseudoHeaders {
+				if value == abbrev {
+					name = key
+				}
+			}
+			if name == "" {
+				return nil, fmt.Errorf("http: invalid HTTP/2 fingerprint pseudo-header %q", abbrev)
+			}
+			fp.PseudoHeaderOrder = append(fp.PseudoHeaderOrder, name)
+		}
+	}
+	if err := fp.validate(); err != nil {
+		return nil, err
+	}
+	return fp, nil
+}
+
+// http2MaxWindowSize is the maximum size of a flow-control window
+// according to RFC 9113 Section 6.9.1.
+const http2MaxWindowSize = 1<<31 - 1
+
+// The bounds of MAX_FRAME_SIZE according to RFC 9113 Section 6.5.2.
+const (
+	http2MinMaxFrameSizeSetting = 1 << 14
+	http2MaxMaxFrameSizeSetting = 1<<24 - 1
+)
+
+// validate returns an error if using fp would cause a flow-control
+// window to exceed http2MaxWindowSize, which is a FLOW_CONTROL_ERROR,
+// or if fp contains a setting with an invalid value, which is a
+// PROTOCOL_ERROR.
+func (fp *HTTP2Fingerprint) validate() error {
+	if fp.ConnectionFlow > http2MaxWindowSize-65535 {
+		return fmt.Errorf("http: HTTP/2 fingerprint window update %d is too large", fp.ConnectionFlow)
+	}
+	for _, s := range fp.Settings {
+		switch s.ID {
+		case HTTP2SettingEnablePush:
+			if s.Val > 1 {
+				return fmt.Errorf("http: HTTP/2 fingerprint enable push %d is not 0 or 1", s.Val)
+			}
+		case HTTP2SettingInitialWindowSize:
+			if s.Val > http2MaxWindowSize {
+				return fmt.Errorf("http: HTTP/2 fingerprint initial window size %d is too large", s.Val)
+			}
+		case HTTP2SettingMaxFrameSize:
+			if s.Val < http2MinMaxFrameSizeSetting || s.Val > http2MaxMaxFrameSizeSetting {
+				return fmt.Errorf("http: HTTP/2 fingerprint max frame size %d is out of range", s.Val)
+			}
+		}
+	}
+	return nil
+}
+
+// String returns the Akamai representation of the fingerprint. See
+// ParseHTTP2Fingerprint for a description of the format.
+func (fp *HTTP2Fingerprint) String() string {
+	var settings []string
+	for _, setting := range fp.Settings {
+		settings = append(settings, fmt.Sprintf("%d:%d", setting.ID, setting.Val))
+	}
+	priorities := []string{"0"}
+	if len(fp.Priorities) > 0 {
+		priorities = nil
+	}
+	for _, p := range fp.Priorities {
+		var exclusive int
+		if p.Exclusive {
+			exclusive = 1
+		}
+		priorities = append(priorities, fmt.Sprintf("%d:%d:%d:%d", p.StreamID, exclusive, p.StreamDep, int(p.Weight)+1))
+	}
+	var pseudo []string
+	for _, name := range fp.PseudoHeaderOrder {
+		if abbrev, found := http2AkamaiPseudoHeaders[name]; found {
+			pseudo = append(pseudo, abbrev)
+		}
+	}
+	return strings.Join([]string{
+		strings.Join(settings, ";"),
+		strconv.FormatUint(uint64(fp.ConnectionFlow), 10),
+		strings.Join(priorities, ","),
+		strings.Join(pseudo, ","),
+	}, "|")
+}
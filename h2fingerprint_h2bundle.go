@@ -0,0 +1,53 @@
+//go:build !nethttpomithttp2
+
+package http
+
+// This file is an ooni/oohttp extension. It applies the HTTP2Fingerprint
+// of h2fingerprint.go to the connections of the bundled HTTP/2 transport.
+
+// fingerprint returns the HTTP/2 fingerprint configured
+// on the parent Transport or nil.
+func (t *http2Transport) fingerprint() *HTTP2Fingerprint {
+	if t.t1 != nil {
+		return t.t1.HTTP2Fingerprint
+	}
+	return nil
+}
+
+// applyFingerprint configures cc to use fp and returns the
+// initial settings and the connection flow to send. It fails
+// if fp is not valid, e.g., because we did not parse it.
+func (cc *http2ClientConn) applyFingerprint(fp *HTTP2Fingerprint) ([]http2Setting, uint32, error) {
+	if err := fp.validate(); err != nil {
+		return nil, 0, err
+	}
+	settings := make([]http2Setting, 0, len(fp.Settings))
+	cc.streamInflow = http2initialWindowSize
+	for _, s := range fp.Settings {
+		settings = append(settings, http2Setting{ID: http2SettingID(s.ID), Val: s.Val})
+		switch s.ID {
+		case HTTP2SettingHeaderTableSize:
+			cc.fr.ReadMetaHeaders.SetAllowedMaxDynamicTableSize(s.Val)
+		case HTTP2SettingInitialWindowSize:
+			cc.streamInflow = int32(s.Val)
+		case HTTP2SettingMaxFrameSize:
+			cc.fr.SetMaxReadFrameSize(s.Val)
+		case HTTP2SettingMaxHeaderListSize:
+			cc.fr.MaxHeaderListSize = s.Val
+		}
+	}
+	cc.pseudoHeaderOrder = fp.PseudoHeaderOrder
+	cc.headersPriority = http2PriorityParam(fp.HeadersPriority)
+	return settings, fp.ConnectionFlow, nil
+}
+
+// writeFingerprintPriorities writes the PRIORITY frames of fp. It also
+// ensures we do not use the client stream IDs they refer to.
+func (cc *http2ClientConn) writeFingerprintPriorities(fp *HTTP2Fingerprint) {
+	for _, p := range fp.Priorities {
+		cc.fr.WritePriority(p.StreamID, http2PriorityParam(p.HTTP2PriorityParam))
+		if p.StreamID >= cc.nextStreamID {
+			cc.nextStreamID = p.StreamID + 1 + p.StreamID%2
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package http_test
+
+import (
+	"crypto/tls"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	"github.com/ooni/oohttp/internal/testcert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestParseHTTP2Fingerprint(t *testing.T) {
+	t.Run("we can parse and format a valid fingerprint", func(t *testing.T) {
+		const input = "1:65536;4:131072;5:16384|12517377|3:0:0:201,5:0:0:101,7:1:3:1|m,p,a,s"
+		fp, err := ParseHTTP2Fingerprint(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &HTTP2Fingerprint{
+			Settings: []HTTP2Setting{
+				{ID: HTTP2SettingHeaderTableSize, Val: 65536},
+				{ID: HTTP2SettingInitialWindowSize, Val: 131072},
+				{ID: HTTP2SettingMaxFrameSize, Val: 16384},
+			},
+			ConnectionFlow: 12517377,
+			Priorities: []HTTP2PriorityFrame{
+				{StreamID: 3, HTTP2PriorityParam: HTTP2PriorityParam{Weight: 200}},
+				{StreamID: 5, HTTP2PriorityParam: HTTP2PriorityParam{Weight: 100}},
+				{StreamID: 7, HTTP2PriorityParam: HTTP2PriorityParam{Exclusive: true, StreamDep: 3}},
+			},
+			PseudoHeaderOrder: []string{":method", ":path", ":authority", ":scheme"},
+		}
+		if !reflect.DeepEqual(fp, want) {
+			t.Fatalf("got %+v, want %+v", fp, want)
+		}
+		if got := fp.String(); got != input {
+			t.Fatalf("got %q, want %q", got, input)
+		}
+	})
+
+	t.Run("we reject invalid fingerprints", func(t *testing.T) {
+		for _, input := range []string{
+			"",
+			"1:65536|0|0",
+			"1-65536|0|0|m,a,s,p",
+			"1:65536|-1|0|m,a,s,p",
+			"1:65536|0|3:0:0|m,a,s,p",
+			"1:65536|0|3:0:0:0|m,a,s,p",
+			"1:65536|0|0|m,a,x,p",
+			"1:65536|2147418113|0|m,a,s,p",
+			"4:2147483648|0|0|m,a,s,p",
+			"2:2|0|0|m,a,s,p",
+			"5:16383|0|0|m,a,s,p",
+			"5:16777216|0|0|m,a,s,p",
+		} {
+			if _, err := ParseHTTP2Fingerprint(input); err == nil {
+				t.Errorf("%q: expected an error", input)
+			}
+		}
+	})
+}
+
+func TestTransportHTTP2Fingerprint(t *testing.T) {
+	CondSkipHTTP2(t)
+	cert, err := tls.X509KeyPair(testcert.LocalhostCert, testcert.LocalhostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	fp, err := ParseHTTP2Fingerprint("1:65536;2:0;4:6291456;6:262144|15663105|3:0:0:201|m,a,s,p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.HeadersPriority = HTTP2PriorityParam{StreamDep: 0, Exclusive: true, Weight: 255}
+	txp := &Transport{
+		ForceAttemptHTTP2: true,
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+		HTTP2Fingerprint:  fp,
+	}
+	defer txp.CloseIdleConnections()
+	go func() {
+		resp, err := (&Client{Transport: txp}).Get("https://" + ln.Addr().String() + "/x")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	preface := make([]byte, len(http2.ClientPreface))
+	if _, err := io.ReadFull(conn, preface); err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(conn, conn)
+
+	// SETTINGS
+	frame, err := framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sf, ok := frame.(*http2.SettingsFrame)
+	if !ok {
+		t.Fatalf("expected SETTINGS, got %v", frame)
+	}
+	var settings []string
+	sf.ForeachSetting(func(s http2.Setting) error {
+		settings = append(settings, s.String())
+		return nil
+	})
+	wantSettings := []string{
+		"[HEADER_TABLE_SIZE = 65536]",
+		"[ENABLE_PUSH = 0]",
+		"[INITIAL_WINDOW_SIZE = 6291456]",
+		"[MAX_HEADER_LIST_SIZE = 262144]",
+	}
+	if !reflect.DeepEqual(settings, wantSettings) {
+		t.Fatalf("got %v, want %v", settings, wantSettings)
+	}
+
+	// WINDOW_UPDATE
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wu, ok := frame.(*http2.WindowUpdateFrame); !ok || wu.StreamID != 0 || wu.Increment != 15663105 {
+		t.Fatalf("unexpected frame: %v", frame)
+	}
+
+	// PRIORITY
+	frame, err = framer.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pf, ok := frame.(*http2.PriorityFrame); !ok || pf.StreamID != 3 || pf.Weight != 200 {
+		t.Fatalf("unexpected frame: %v", frame)
+	}
+
+	// HEADERS
+	framer.WriteSettings()
+	var hf *http2.HeadersFrame
+	for hf == nil {
+		frame, err = framer.ReadFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		hf, _ = frame.(*http2.HeadersFrame)
+	}
+	if hf.StreamID != 5 {
+		t.Fatalf("expected stream 5, got %d", hf.StreamID)
+	}
+	if !hf.HasPriority() || !hf.Priority.Exclusive || hf.Priority.Weight != 255 {
+		t.Fatalf("unexpected priority: %+v", hf.Priority)
+	}
+	fields, err := hpack.NewDecoder(4096, nil).DecodeFull(hf.HeaderBlockFragment())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pseudo []string
+	for _, field := range fields {
+		if field.IsPseudo() {
+			pseudo = append(pseudo, field.Name)
+		}
+	}
+	wantPseudo := []string{":method", ":authority", ":scheme", ":path"}
+	if !reflect.DeepEqual(pseudo, wantPseudo) {
+		t.Fatalf("got %v, want %v", pseudo, wantPseudo)
+	}
+}
+
+func TestTransportHTTP2FingerprintValidation(t *testing.T) {
+	CondSkipHTTP2(t)
+	srv := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	for _, fp := range []*HTTP2Fingerprint{
+		{ConnectionFlow: 1<<31 - 1},
+		{Settings: []HTTP2Setting{{ID: HTTP2SettingInitialWindowSize, Val: 1 << 31}}},
+		{Settings: []HTTP2Setting{{ID: HTTP2SettingEnablePush, Val: 2}}},
+		{Settings: []HTTP2Setting{{ID: HTTP2SettingMaxFrameSize, Val: 1 << 24}}},
+	} {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		txp.HTTP2Fingerprint = fp
+		req, _ := NewRequest("GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+			t.Fatalf("%+v: expected an error", fp)
+		}
+		if !strings.Contains(err.Error(), "HTTP/2 fingerprint") {
+			t.Fatalf("%+v: unexpected error: %v", fp, err)
+		}
+		txp.CloseIdleConnections()
+	}
+}
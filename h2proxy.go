@@ -0,0 +1,94 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows tunnelling connections
+// through HTTPS proxies using HTTP/2 CONNECT (RFC 9113 Section 8.5) or
+// extended CONNECT (RFC 8441), multiplexing several tunnels over a single
+// HTTP/2 connection to the proxy.
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+)
+
+// HTTP2Proxy configures tunnelling through HTTPS proxies using HTTP/2.
+// See the HTTP2Proxy field of Transport.
+type HTTP2Proxy struct {
+	// Protocol is the value of the :protocol pseudo-header field sent
+	// using extended CONNECT (e.g., "connect-tcp"). When Protocol is
+	// empty, the Transport uses a regular CONNECT request, whose
+	// :authority pseudo-header field is the target "host:port".
+	Protocol string
+
+	// Path is the value of the :path pseudo-header field sent using
+	// extended CONNECT, where the Transport replaces "{target_host}" and
+	// "{target_port}" with the escaped target host and port. When Path
+	// is empty, we use "/". The :authority is the proxy host.
+	Path string
+}
+
+// errHTTP2ProxyConnect indicates that the proxy refused to open a tunnel.
+var errHTTP2ProxyConnect = errors.New("http: proxy refused the HTTP/2 CONNECT request")
+
+// extendedConnectProtocol returns the :protocol of an extended CONNECT
+// request, which is the ":protocol" header, or the empty string.
+func extendedConnectProtocol(req *Request) string {
+	if req.Method != "CONNECT" {
+		return ""
+	}
+	if vv := req.Header[":protocol"]; len(vv) > 0 {
+		return vv[0]
+	}
+	return ""
+}
+
+// useHTTP2Proxy returns whether we should attempt to reach the target of
+// cm using an HTTP/2 tunnel through its proxy. We never do that without
+// the bundled HTTP/2 implementation (i.e., using nethttpomithttp2).
+func (t *Transport) useHTTP2Proxy(key connectMethodKey) bool {
+	return !omitBundledHTTP2 && t.HTTP2Proxy != nil && key.chain == "" && strings.HasPrefix(key.proxy, "https:") && key.scheme == "https"
+}
+
+// proxyNextProtos returns the ALPN protocols to offer to the proxy of
+// the given key, where nextProtos is the default.
+func (t *Transport) proxyNextProtos(key connectMethodKey, nextProtos []string) []string {
+	if t.useHTTP2Proxy(key) {
+		return []string{"h2", "http/1.1"}
+	}
+	return nextProtos
+}
+
+// http2ProxyTunnelConn is a tunnel over an HTTP/2 stream. We copy between
+// the stream and one end of a net.Pipe, which provides the deadlines.
+type http2ProxyTunnelConn struct {
+	net.Conn
+	local, remote net.Addr
+}
+
+// newHTTP2ProxyTunnel returns a conn for the stream whose request body
+// is w and whose response body is r, where proxyConn is the connection
+// to the proxy and cancel cancels the stream context.
+func newHTTP2ProxyTunnel(proxyConn net.Conn, w io.WriteCloser, r io.ReadCloser, cancel context.CancelFunc) net.Conn {
+	near, far := net.Pipe()
+	go func() {
+		io.Copy(far, r)
+		far.Close()
+	}()
+	go func() {
+		io.Copy(w, far)
+		w.Close()
+		r.Close()
+		cancel()
+	}()
+	return &http2ProxyTunnelConn{Conn: near, local: proxyConn.LocalAddr(), remote: proxyConn.RemoteAddr()}
+}
+
+func (c *http2ProxyTunnelConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *http2ProxyTunnelConn) RemoteAddr() net.Addr {
+	return c.remote
+}
@@ -0,0 +1,184 @@
+//go:build !nethttpomithttp2
+
+package http
+
+// This file is an ooni/oohttp extension. It opens the tunnels described
+// in h2proxy.go using the bundled HTTP/2 transport.
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// http2ProxyTunnel returns a tunnel to the target of cm using an existing
+// HTTP/2 connection to its proxy or nil when there is no such connection.
+func (t *Transport) http2ProxyTunnel(ctx context.Context, cm *connectMethod) (net.Conn, error) {
+	key := cm.key()
+	if !t.useHTTP2Proxy(key) {
+		return nil, nil
+	}
+	t.h2proxyMu.Lock()
+	var found *http2ClientConn
+	conns := t.h2proxyConns[key.proxy][:0]
+	for _, cc := range t.h2proxyConns[key.proxy] {
+		if state := cc.State(); state.Closed || state.Closing {
+			continue
+		}
+		conns = append(conns, cc)
+		if found == nil && cc.ReserveNewRequest() {
+			found = cc
+		}
+	}
+	if t.h2proxyConns != nil {
+		t.h2proxyConns[key.proxy] = conns
+	}
+	t.h2proxyMu.Unlock()
+	if found == nil {
+		return nil, nil
+	}
+	return t.openHTTP2ProxyTunnel(ctx, cm, found)
+}
+
+// upgradeHTTP2Proxy checks whether we negotiated HTTP/2 with the proxy
+// of cm using pconn and, if so, returns a tunnel to the target of cm over
+// the HTTP/2 connection. Otherwise, it returns nil and we should use an
+// HTTP/1.1 CONNECT request.
+func (t *Transport) upgradeHTTP2Proxy(ctx context.Context, cm *connectMethod, pconn *persistConn) (net.Conn, error) {
+	key := cm.key()
+	if !t.useHTTP2Proxy(key) || pconn.tlsState == nil || pconn.tlsState.NegotiatedProtocol != "h2" {
+		return nil, nil
+	}
+	t.h2proxyMu.Lock()
+	if t.h2proxyTransport == nil {
+		t.h2proxyTransport = &http2Transport{t1: t}
+	}
+	t2 := t.h2proxyTransport
+	t.h2proxyMu.Unlock()
+	cc, err := t2.NewClientConn(pconn.conn)
+	if err != nil {
+		pconn.conn.Close()
+		return nil, err
+	}
+	if !cc.ReserveNewRequest() {
+		cc.Close()
+		return nil, http2errClientConnUnusable
+	}
+	t.h2proxyMu.Lock()
+	if t.h2proxyConns == nil {
+		t.h2proxyConns = make(map[string][]*http2ClientConn)
+	}
+	t.h2proxyConns[key.proxy] = append(t.h2proxyConns[key.proxy], cc)
+	t.h2proxyMu.Unlock()
+	return t.openHTTP2ProxyTunnel(ctx, cm, cc)
+}
+
+// closeIdleHTTP2ProxyConns closes the HTTP/2 connections to proxies
+// that are not carrying any tunnel.
+func (t *Transport) closeIdleHTTP2ProxyConns() {
+	t.h2proxyMu.Lock()
+	defer t.h2proxyMu.Unlock()
+	for _, conns := range t.h2proxyConns {
+		for _, cc := range conns {
+			cc.closeIfIdle()
+		}
+	}
+}
+
+// openHTTP2ProxyTunnel sends a CONNECT request using cc and returns
+// the resulting tunnel to the target of cm. The caller must have reserved
+// a stream of cc, which the request uses or which we release on failure,
+// so that cc can become idle again.
+func (t *Transport) openHTTP2ProxyTunnel(ctx context.Context, cm *connectMethod, cc *http2ClientConn) (net.Conn, error) {
+	connectReq, pw, err := t.newHTTP2ProxyConnectRequest(ctx, cm)
+	if err != nil {
+		cc.decrStreamReservations()
+		return nil, err
+	}
+
+	// The tunnel outlives ctx, which only bounds sending the CONNECT
+	// request and receiving the response.
+	tunnelCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, cancel)
+	resp, err := cc.RoundTrip(connectReq.WithContext(tunnelCtx))
+	if !stop() {
+		err = ctx.Err()
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+	if err != nil {
+		cancel()
+		pw.Close()
+		return nil, err
+	}
+	if t.OnProxyConnectResponse != nil {
+		if err := t.OnProxyConnectResponse(ctx, cm.proxyURL, connectReq, resp); err != nil {
+			cancel()
+			pw.Close()
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		cancel()
+		pw.Close()
+		resp.Body.Close()
+		return nil, errHTTP2ProxyConnect
+	}
+	return newHTTP2ProxyTunnel(cc.tconn, pw, resp.Body, cancel), nil
+}
+
+// newHTTP2ProxyConnectRequest returns the CONNECT request to open a
+// tunnel to the target of cm, along with the writer of its body.
+func (t *Transport) newHTTP2ProxyConnectRequest(ctx context.Context, cm *connectMethod) (*Request, *io.PipeWriter, error) {
+	target := cm.tunnelAddr()
+	var hdr Header
+	if t.GetProxyConnectHeader != nil {
+		var err error
+		hdr, err = t.GetProxyConnectHeader(ctx, cm.proxyURL, target)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		hdr = t.ProxyConnectHeader
+	}
+	hdr = hdr.Clone()
+	if hdr == nil {
+		hdr = make(Header)
+	}
+	if pa := cm.proxyAuth(); pa != "" {
+		hdr.Set("Proxy-Authorization", pa)
+	}
+	connectReq := &Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Host: target},
+		Host:   target,
+		Header: hdr,
+	}
+	if config := t.HTTP2Proxy; config.Protocol != "" {
+		host, port, err := net.SplitHostPort(target)
+		if err != nil {
+			return nil, nil, err
+		}
+		path := config.Path
+		if path == "" {
+			path = "/"
+		}
+		path = strings.NewReplacer(
+			"{target_host}", url.PathEscape(host),
+			"{target_port}", url.PathEscape(port),
+		).Replace(path)
+		connectReq.URL, err = url.Parse("https://" + cm.proxyURL.Host + path)
+		if err != nil {
+			return nil, nil, err
+		}
+		connectReq.Host = cm.proxyURL.Host
+		hdr[":protocol"] = []string{config.Protocol}
+	}
+	pr, pw := io.Pipe()
+	connectReq.Body = pr
+	return connectReq, pw, nil
+}
@@ -0,0 +1,239 @@
+package http_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// tunnelProxy is an HTTPS proxy handling CONNECT requests.
+type tunnelProxy struct {
+	mu       sync.Mutex
+	conns    int
+	closed   int
+	requests []*Request
+}
+
+func (p *tunnelProxy) ServeHTTP(w ResponseWriter, r *Request) {
+	if r.Method != "CONNECT" {
+		w.WriteHeader(StatusMethodNotAllowed)
+		return
+	}
+	p.mu.Lock()
+	p.requests = append(p.requests, r)
+	p.mu.Unlock()
+	target := r.Host
+	if r.Header.Get(":protocol") != "" {
+		// the path is /tcp/{target_host}/{target_port}
+		parts := strings.Split(r.URL.Path, "/")
+		target = net.JoinHostPort(parts[2], parts[3])
+	}
+	conn, err := net.Dial("tcp", target)
+	if err != nil {
+		w.WriteHeader(StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+	if r.ProtoMajor == 1 {
+		w.WriteHeader(StatusOK)
+		client, brw, err := w.(Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer client.Close()
+		go io.Copy(conn, brw)
+		io.Copy(client, conn)
+		return
+	}
+	w.WriteHeader(StatusOK)
+	w.(Flusher).Flush()
+	go io.Copy(conn, r.Body)
+	buf := make([]byte, 4096)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			w.Write(buf[:n])
+			w.(Flusher).Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func TestTransportHTTP2Proxy(t *testing.T) {
+	CondSkipHTTP2(t)
+	var targets []*httptest.Server
+	for _, body := range []string{"first", "second"} {
+		body := body
+		srv := httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+			w.Write([]byte(body))
+		}))
+		defer srv.Close()
+		targets = append(targets, srv)
+	}
+
+	// newProxy returns a proxy counting the accepted and closed connections.
+	newProxy := func(t *testing.T, enableHTTP2, extendedConnect bool) (*httptest.Server, *tunnelProxy) {
+		t.Helper()
+		handler := &tunnelProxy{}
+		srv := httptest.NewUnstartedServer(handler)
+		srv.EnableHTTP2 = enableHTTP2
+		srv.Config.ExtendedConnect = extendedConnect
+		srv.Config.ConnState = func(conn net.Conn, state ConnState) {
+			handler.mu.Lock()
+			defer handler.mu.Unlock()
+			switch state {
+			case StateNew:
+				handler.conns++
+			case StateClosed:
+				handler.closed++
+			}
+		}
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		return srv, handler
+	}
+
+	// newTransport returns a transport using proxy and config.
+	newTransport := func(proxy *httptest.Server, config *HTTP2Proxy) *Transport {
+		certpool := x509.NewCertPool()
+		certpool.AddCert(proxy.Certificate())
+		proxyURL, _ := url.Parse(proxy.URL)
+		return &Transport{
+			Proxy:           ProxyURL(proxyURL),
+			TLSClientConfig: &tls.Config{RootCAs: certpool},
+			HTTP2Proxy:      config,
+		}
+	}
+
+	// fetchAll fetches all the targets through proxy.
+	fetchAll := func(t *testing.T, proxy *httptest.Server, config *HTTP2Proxy) {
+		t.Helper()
+		txp := newTransport(proxy, config)
+		defer txp.CloseIdleConnections()
+		for idx, srv := range targets {
+			req, _ := NewRequest("GET", srv.URL, nil)
+			resp, err := txp.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"first", "second"}[idx]; string(data) != want {
+				t.Fatalf("got %q, want %q", data, want)
+			}
+		}
+	}
+
+	t.Run("we multiplex the tunnels over a single HTTP/2 connection", func(t *testing.T) {
+		proxy, handler := newProxy(t, true, false)
+		fetchAll(t, proxy, &HTTP2Proxy{})
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		if handler.conns != 1 {
+			t.Fatalf("expected one connection to the proxy, got %d", handler.conns)
+		}
+		for idx, r := range handler.requests {
+			if r.ProtoMajor != 2 || r.Host != strings.TrimPrefix(targets[idx].URL, "https://") {
+				t.Fatalf("unexpected CONNECT request: %s %s", r.Proto, r.Host)
+			}
+		}
+	})
+
+	t.Run("we can use extended CONNECT", func(t *testing.T) {
+		proxy, handler := newProxy(t, true, true)
+		fetchAll(t, proxy, &HTTP2Proxy{Protocol: "connect-tcp", Path: "/tcp/{target_host}/{target_port}"})
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		if handler.conns != 1 || len(handler.requests) != 2 {
+			t.Fatalf("unexpected conns and requests: %d %d", handler.conns, len(handler.requests))
+		}
+		r := handler.requests[0]
+		if r.Header.Get(":protocol") != "connect-tcp" || !strings.HasPrefix(r.URL.Path, "/tcp/127.0.0.1/") {
+			t.Fatalf("unexpected extended CONNECT request: %v %s", r.Header, r.URL.Path)
+		}
+		if r.Host != strings.TrimPrefix(proxy.URL, "https://") {
+			t.Fatalf("unexpected authority: %s", r.Host)
+		}
+	})
+
+	t.Run("we fall back to HTTP/1.1 CONNECT", func(t *testing.T) {
+		proxy, handler := newProxy(t, false, false)
+		fetchAll(t, proxy, &HTTP2Proxy{})
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		if len(handler.requests) != 2 {
+			t.Fatalf("expected two CONNECT requests, got %d", len(handler.requests))
+		}
+		for _, r := range handler.requests {
+			if r.ProtoMajor != 1 {
+				t.Fatalf("unexpected protocol: %s", r.Proto)
+			}
+		}
+	})
+
+	t.Run("we fail cleanly when the proxy does not support extended CONNECT", func(t *testing.T) {
+		proxy, handler := newProxy(t, true, false)
+		txp := newTransport(proxy, &HTTP2Proxy{Protocol: "connect-tcp"})
+		defer txp.CloseIdleConnections()
+		req, _ := NewRequest("GET", targets[0].URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(err.Error(), "extended CONNECT not supported") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		if len(handler.requests) != 0 {
+			t.Fatalf("unexpected requests: %d", len(handler.requests))
+		}
+	})
+
+	t.Run("we can close the connection after failing to create the CONNECT request", func(t *testing.T) {
+		proxy, handler := newProxy(t, true, false)
+		txp := newTransport(proxy, &HTTP2Proxy{})
+		defer txp.CloseIdleConnections()
+		errHeader := errors.New("cannot create the header")
+		txp.GetProxyConnectHeader = func(ctx context.Context, proxyURL *url.URL, target string) (Header, error) {
+			return nil, errHeader
+		}
+		// The first request creates the HTTP/2 connection to the proxy,
+		// which the second request reuses.
+		for idx := 0; idx < 2; idx++ {
+			req, _ := NewRequest("GET", targets[0].URL, nil)
+			if _, err := txp.RoundTrip(req); !errors.Is(err, errHeader) {
+				t.Fatalf("request %d: unexpected error: %v", idx, err)
+			}
+		}
+		txp.CloseIdleConnections()
+		waitCondition(t, 10*time.Millisecond, func(d time.Duration) bool {
+			handler.mu.Lock()
+			defer handler.mu.Unlock()
+			if handler.conns != 1 {
+				t.Fatalf("expected one connection to the proxy, got %d", handler.conns)
+			}
+			if d > 5*time.Second {
+				t.Fatal("the connection to the proxy is still open")
+			}
+			return handler.closed == 1
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// HeaderOrderKey is an ooni/oohttp extension. When a request's Header
+// contains this key, its values are the names of the header fields in
+// the order in which the Transport should write them on the wire. Each
+// value may contain a single name or several comma-separated names.
+//
+// For HTTP/1.1, the casing of each name is also used on the wire, so
+// you can, for example, write "user-agent" rather than "User-Agent". The
+// Host, User-Agent, Content-Length, Transfer-Encoding, Connection and
+// Trailer fields the Transport generates may also be ordered. Fields that
+// are not listed are written after the listed ones using the default
+// order. For HTTP/2, names are always lowercase and the order only
+// applies to regular fields, since pseudo-header fields come first.
+//
+// The key itself is not a valid header field name and is never
+// written on the wire. For example:
+//
+//	req.Header[http.HeaderOrderKey] = []string{
+//		"host", "user-agent", "accept", "accept-encoding",
+//	}
+const HeaderOrderKey = "Header-Order:"
+
+// headerOrder returns the header field names listed using HeaderOrderKey.
+func (h Header) headerOrder() (names []string) {
+	for _, v := range h[HeaderOrderKey] {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return
+}
+
+// orderKeyValues returns a copy of kvs where the entries whose key matches
+// one of the names in order come first, following order, and use the
+// casing of the name in order. Keys are compared case-insensitively. The
+// remaining entries follow in their original order.
+func orderKeyValues(kvs []keyValues, order []string) []keyValues {
+	out := make([]keyValues, 0, len(kvs))
+	used := make([]bool, len(kvs))
+	for _, name := range order {
+		for idx, kv := range kvs {
+			if !used[idx] && strings.EqualFold(kv.key, name) {
+				used[idx] = true
+				out = append(out, keyValues{name, kv.values})
+			}
+		}
+	}
+	for idx, kv := range kvs {
+		if !used[idx] {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+// headerOrderWriter collects the header lines written by Request.write
+// so that we can later write them using the order set by HeaderOrderKey.
+type headerOrderWriter struct {
+	bytes.Buffer
+}
+
+// keyValues parses the collected header lines. Multiple lines with the
+// same key are grouped together at the position of the first line.
+func (hw *headerOrderWriter) keyValues() (kvs []keyValues) {
+	index := make(map[string]int)
+	for _, line := range strings.Split(hw.String(), "\r\n") {
+		if line == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		if idx, found := index[key]; found {
+			kvs[idx].values = append(kvs[idx].values, value)
+			continue
+		}
+		index[key] = len(kvs)
+		kvs = append(kvs, keyValues{key, []string{value}})
+	}
+	return
+}
+
+// writeOrdered writes the collected header lines to w using order.
+func (hw *headerOrderWriter) writeOrdered(w io.Writer, order []string, trace *httptrace.ClientTrace) error {
+	for _, kv := range orderKeyValues(hw.keyValues(), order) {
+		for _, v := range kv.values {
+			if _, err := io.WriteString(w, kv.key+": "+v+"\r\n"); err != nil {
+				return err
+			}
+		}
+		if trace != nil && trace.WroteHeaderField != nil {
+			trace.WroteHeaderField(kv.key, kv.values)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package http_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+func TestHeaderOrderRequestWrite(t *testing.T) {
+	t.Run("without HeaderOrderKey we sort the headers", func(t *testing.T) {
+		req, err := NewRequest("GET", "http://example.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-B", "b")
+		req.Header.Set("X-A", "a")
+		var buf bytes.Buffer
+		if err := req.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		want := "GET / HTTP/1.1\r\n" +
+			"Host: example.com\r\n" +
+			"User-Agent: Go-http-client/1.1\r\n" +
+			"X-A: a\r\n" +
+			"X-B: b\r\n" +
+			"\r\n"
+		if got := buf.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("with HeaderOrderKey we honour order and casing", func(t *testing.T) {
+		req, err := NewRequest("POST", "http://example.com/", strings.NewReader("abc"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		req.Header.Set("X-B", "b")
+		req.Header.Set("X-A", "a")
+		req.Header.Add("Accept", "text/html")
+		req.Header.Add("Accept", "*/*")
+		req.Header[HeaderOrderKey] = []string{
+			"user-agent",
+			"x-b, accept",
+			"HOST",
+			"Content-Length",
+		}
+		var (
+			mu   sync.Mutex
+			keys []string
+		)
+		trace := &httptrace.ClientTrace{
+			WroteHeaderField: func(key string, value []string) {
+				mu.Lock()
+				keys = append(keys, key)
+				mu.Unlock()
+			},
+		}
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+		var buf bytes.Buffer
+		if err := req.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		want := "POST / HTTP/1.1\r\n" +
+			"user-agent: Mozilla/5.0\r\n" +
+			"x-b: b\r\n" +
+			"accept: text/html\r\n" +
+			"accept: */*\r\n" +
+			"HOST: example.com\r\n" +
+			"Content-Length: 3\r\n" +
+			"X-A: a\r\n" +
+			"\r\n" +
+			"abc"
+		if got := buf.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+		wantKeys := "user-agent,x-b,accept,HOST,Content-Length,X-A"
+		if got := strings.Join(keys, ","); got != wantKeys {
+			t.Fatalf("got keys %q, want %q", got, wantKeys)
+		}
+	})
+}
+
+func TestHeaderOrderTransport(t *testing.T) { run(t, testHeaderOrderTransport) }
+func testHeaderOrderTransport(t *testing.T, mode testMode) {
+	cst := newClientServerTest(t, mode, HandlerFunc(func(w ResponseWriter, r *Request) {
+		if r.Header.Get("X-A") != "a" || r.Header.Get("X-B") != "b" {
+			t.Errorf("unexpected request headers: %v", r.Header)
+		}
+		if _, found := r.Header[HeaderOrderKey]; found {
+			t.Error("the HeaderOrderKey was sent on the wire")
+		}
+	}))
+	req, err := NewRequest("GET", cst.ts.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-A", "a")
+	req.Header.Set("X-B", "b")
+	req.Header[HeaderOrderKey] = []string{"x-b", "user-agent", "x-a"}
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	trace := &httptrace.ClientTrace{
+		WroteHeaderField: func(key string, value []string) {
+			mu.Lock()
+			if !strings.HasPrefix(key, ":") {
+				keys = append(keys, strings.ToLower(key))
+			}
+			mu.Unlock()
+		},
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+	resp, err := cst.c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	mu.Lock()
+	defer mu.Unlock()
+	if len(keys) < 3 || strings.Join(keys[:3], ",") != "x-b,user-agent,x-a" {
+		t.Fatalf("unexpected header order: %v", keys)
+	}
+}
@@ -0,0 +1,105 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows the Transport to switch
+// to HTTP/3 when https servers advertise it using the Alt-Svc header (RFC
+// 7838), optionally racing HTTP/3 against HTTP/1.1 and HTTP/2 over TCP.
+// See altsvc.go for how we record and use the alternative services.
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// HTTP3 configures how the Transport uses HTTP/3. See the HTTP3 field
+// of Transport.
+type HTTP3 struct {
+	// RoundTripper sends the HTTP/3 requests. Use the http3 package to
+	// create it, e.g., using http3.ConfigureTransport.
+	RoundTripper RoundTripper
+
+	// Race, if true, causes the Transport to race HTTP/3 against TCP for
+	// requests without a body, using the first successful response. When
+	// Race is false, or the request has a body, we only use HTTP/3, and
+	// we retry using TCP if it fails.
+	Race bool
+
+	// RaceDelay is the head start HTTP/3 has over TCP when racing. When
+	// it is zero, both attempts start at the same time.
+	RaceDelay time.Duration
+}
+
+// raceHTTP3 races HTTP/3 against TCP for req, which has no body.
+func (t *Transport) raceHTTP3(req *Request, route altSvcRoute) (*Response, error) {
+	type result struct {
+		resp *Response
+		err  error
+		h3   bool
+	}
+	results := make(chan result, 2)
+	cancels := make(map[bool]context.CancelFunc) // by h3
+	start := func(h3 bool) {
+		ctx, cancel := context.WithCancel(req.Context())
+		cancels[h3] = cancel
+		go func() {
+			r := result{h3: h3}
+			if h3 {
+				r.resp, r.err = t.sendAltSvc(req, ctx, route)
+			} else {
+				r.resp, r.err = t.sendOrigin(req, ctx)
+			}
+			results <- r
+		}()
+	}
+	start(true)
+	timer := time.NewTimer(t.HTTP3.RaceDelay)
+	defer timer.Stop()
+	for running := 1; ; {
+		select {
+		case <-timer.C:
+			if _, started := cancels[false]; !started {
+				running++
+				start(false)
+			}
+		case r := <-results:
+			running--
+			if r.err == nil {
+				r.resp.Body = &cancelOnCloseBody{ReadCloser: r.resp.Body, cancel: cancels[r.h3]}
+				if running > 0 {
+					// Interrupt the other attempt and discard its response.
+					cancels[!r.h3]()
+					go func() {
+						if r := <-results; r.err == nil {
+							r.resp.Body.Close()
+						}
+					}()
+				}
+				return r.resp, nil
+			}
+			cancels[r.h3]()
+			if r.h3 {
+				t.forgetAltSvc(req, route)
+			}
+			if _, started := cancels[false]; !started && req.Context().Err() == nil {
+				running++
+				start(false)
+			}
+			if running == 0 {
+				return nil, r.err
+			}
+		}
+	}
+}
+
+// cancelOnCloseBody cancels the context of a request when the caller
+// closes the response body.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
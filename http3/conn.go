@@ -0,0 +1,148 @@
+package http3
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+type streamHandler interface {
+	handleControlStream(*stream) error
+	handlePushStream(*stream) error
+	handleEncoderStream(*stream) error
+	handleDecoderStream(*stream) error
+	handleRequestStream(*stream) error
+	abort(error)
+}
+
+type genericConn struct {
+	mu sync.Mutex
+
+	// The peer may create exactly one control, encoder, and decoder stream.
+	// streamsCreated is a bitset of streams created so far.
+	// Bits are 1 << streamType.
+	streamsCreated uint8
+}
+
+// acceptStreams handles the streams opened by the peer until the
+// connection is closed.
+func (c *genericConn) acceptStreams(qconn QUICConn, h streamHandler) {
+	go func() {
+		for {
+			// Use context.Background: This blocks until a stream is accepted
+			// or the connection closes.
+			st, err := qconn.AcceptUniStream(context.Background())
+			if err != nil {
+				return // connection closed
+			}
+			go c.handleUnidirectionalStream(newStream(st), h)
+		}
+	}()
+	for {
+		st, err := qconn.AcceptStream(context.Background())
+		if err != nil {
+			return // connection closed
+		}
+		go c.handleRequestStream(newStream(st), h)
+	}
+}
+
+func (c *genericConn) handleUnidirectionalStream(st *stream, h streamHandler) {
+	// Unidirectional stream header: One varint with the stream type.
+	v, err := st.readVarint()
+	if err != nil {
+		h.abort(&connectionError{
+			code:    errH3StreamCreationError,
+			message: "error reading unidirectional stream header",
+		})
+		return
+	}
+	stype := streamType(v)
+	if err := c.checkStreamCreation(stype); err != nil {
+		h.abort(err)
+		return
+	}
+	switch stype {
+	case streamTypeControl:
+		err = h.handleControlStream(st)
+	case streamTypePush:
+		err = h.handlePushStream(st)
+	case streamTypeEncoder:
+		err = h.handleEncoderStream(st)
+	case streamTypeDecoder:
+		err = h.handleDecoderStream(st)
+	default:
+		// "Recipients of unknown stream types MUST either abort reading
+		// of the stream or discard incoming data without further processing."
+		// https://www.rfc-editor.org/rfc/rfc9114.html#section-6.2-7
+		st.stream.CancelRead(uint64(errH3StreamCreationError))
+		return
+	}
+	if err == io.EOF {
+		err = &connectionError{
+			code:    errH3ClosedCriticalStream,
+			message: streamType(stype).String() + " stream closed",
+		}
+	}
+	c.handleStreamError(st, h, err)
+}
+
+func (c *genericConn) handleRequestStream(st *stream, h streamHandler) {
+	c.handleStreamError(st, h, h.handleRequestStream(st))
+}
+
+func (c *genericConn) handleStreamError(st *stream, h streamHandler, err error) {
+	switch err := err.(type) {
+	case *connectionError:
+		h.abort(err)
+	case nil:
+		st.stream.CancelRead(uint64(errH3NoError))
+		st.stream.CloseWrite()
+	case *streamError:
+		st.stream.CancelRead(uint64(err.code))
+		st.stream.CancelWrite(uint64(err.code))
+	default:
+		st.stream.CancelRead(uint64(errH3InternalError))
+		st.stream.CancelWrite(uint64(errH3InternalError))
+	}
+}
+
+func (c *genericConn) checkStreamCreation(stype streamType) error {
+	switch stype {
+	case streamTypeControl, streamTypeEncoder, streamTypeDecoder:
+		// The peer may create exactly one control, encoder, and decoder stream.
+	default:
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bit := uint8(1) << stype
+	if c.streamsCreated&bit != 0 {
+		return &connectionError{
+			code:    errH3StreamCreationError,
+			message: "multiple " + stype.String() + " streams created",
+		}
+	}
+	c.streamsCreated |= bit
+	return nil
+}
+
+// abortConn closes qconn with an error.
+func abortConn(qconn QUICConn, err error) {
+	if e, ok := err.(*connectionError); ok {
+		qconn.CloseWithError(uint64(e.code), e.message)
+	} else {
+		qconn.CloseWithError(uint64(errH3InternalError), err.Error())
+	}
+}
+
+// discardStream discards the data the peer sends on the encoder or
+// decoder stream, which we do not need since we do not use the dynamic
+// table. These streams must remain open, so it returns io.EOF when
+// the peer closes st.
+func discardStream(st *stream) error {
+	if _, err := io.Copy(io.Discard, st.r); err != nil {
+		return err
+	}
+	return io.EOF
+}
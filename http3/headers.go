@@ -0,0 +1,182 @@
+package http3
+
+import (
+	"strings"
+
+	oohttp "github.com/ooni/oohttp"
+	"golang.org/x/net/http/httpguts"
+)
+
+// headerField is a header field to encode.
+type headerField struct {
+	name, value string
+}
+
+// connectionHeaders contains the connection-specific header fields,
+// which HTTP/3 forbids. See RFC 9114, Section 4.2.
+var connectionHeaders = map[string]bool{
+	"connection":        true,
+	"keep-alive":        true,
+	"proxy-connection":  true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+}
+
+// headerFields returns the fields of h using lowercase names, without
+// the connection-specific fields and the fields for which skip, if not
+// nil, returns true. The TE field is only kept when its value is
+// "trailers". The fields listed using oohttp.HeaderOrderKey come
+// first, in the given order.
+func headerFields(h oohttp.Header, skip func(name string) bool) (fields []headerField) {
+	for key, values := range h {
+		name := strings.ToLower(key)
+		if key == oohttp.HeaderOrderKey || connectionHeaders[name] || (skip != nil && skip(name)) {
+			continue
+		}
+		for _, value := range values {
+			if name == "te" && !strings.EqualFold(value, "trailers") {
+				continue
+			}
+			fields = append(fields, headerField{name, value})
+		}
+	}
+	return orderHeaderFields(fields, h[oohttp.HeaderOrderKey])
+}
+
+// orderHeaderFields sorts fields such that those whose name appears in
+// order, which contains the values of oohttp.HeaderOrderKey, come first
+// following order. The other fields are sorted by name.
+func orderHeaderFields(fields []headerField, order []string) []headerField {
+	rank := make(map[string]int)
+	for _, v := range order {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+				if _, found := rank[name]; !found {
+					rank[name] = len(rank)
+				}
+			}
+		}
+	}
+	less := func(a, b headerField) bool {
+		ra, oka := rank[a.name]
+		rb, okb := rank[b.name]
+		switch {
+		case oka && okb:
+			return ra < rb
+		case oka != okb:
+			return oka
+		default:
+			return a.name < b.name
+		}
+	}
+	// Insertion sort is stable, and we usually have few fields.
+	for i := 1; i < len(fields); i++ {
+		for j := i; j > 0 && less(fields[j], fields[j-1]); j-- {
+			fields[j], fields[j-1] = fields[j-1], fields[j]
+		}
+	}
+	return fields
+}
+
+// validHeaderFields returns an error if any field is invalid.
+func validHeaderFields(fields []headerField) error {
+	for _, f := range fields {
+		if !httpguts.ValidHeaderFieldName(f.name) {
+			return &streamError{errH3MessageError, "invalid header field name " + f.name}
+		}
+		if !httpguts.ValidHeaderFieldValue(f.value) {
+			// Don't include the value in the error, because it may be sensitive.
+			return &streamError{errH3MessageError, "invalid header field value for " + f.name}
+		}
+	}
+	return nil
+}
+
+// encodeFields encodes the pseudo-header fields followed by fields.
+func (qe *qpackEncoder) encodeFields(pseudo, fields []headerField) []byte {
+	return qe.encode(func(yield func(itype indexType, name, value string)) {
+		for _, f := range pseudo {
+			yield(mayIndex, f.name, f.value)
+		}
+		for _, f := range fields {
+			yield(mayIndex, f.name, f.value)
+		}
+	})
+}
+
+// decodeHeaders reads the field section of a HEADERS frame, whose
+// header we have already read, and returns the regular fields. We
+// call pseudo, if not nil, for each pseudo-header field; if nil, any
+// pseudo-header field is an error, as it happens for trailers.
+func (qd *qpackDecoder) decodeHeaders(st *stream, pseudo func(name, value string) error) (oohttp.Header, error) {
+	h := make(oohttp.Header)
+	cookie := ""
+	err := qd.decode(st, func(_ indexType, name, value string) error {
+		switch {
+		case name[0] == ':':
+			if pseudo == nil {
+				return &streamError{errH3MessageError, "unexpected pseudo-header"}
+			}
+			return pseudo(name, value)
+		case name == "cookie":
+			// "If a decompressed field section contains multiple cookie field lines,
+			// these MUST be concatenated into a single byte string [...]"
+			// using the two-byte delimiter of "; "''
+			// https://www.rfc-editor.org/rfc/rfc9114.html#section-4.2.1-2
+			if cookie == "" {
+				cookie = value
+			} else {
+				cookie += "; " + value
+			}
+		case !httpguts.ValidHeaderFieldName(name) || strings.ToLower(name) != name:
+			return &streamError{errH3MessageError, "invalid header field name"}
+		default:
+			key := oohttp.CanonicalHeaderKey(name)
+			h[key] = append(h[key], value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cookie != "" {
+		h["Cookie"] = []string{cookie}
+	}
+	if err := st.endFrame(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// declaredTrailers returns a Header containing the keys of the trailers
+// declared using the Trailer field of h, or nil if there are none.
+func declaredTrailers(h oohttp.Header) (trailer oohttp.Header) {
+	for _, v := range h["Trailer"] {
+		for _, key := range strings.Split(v, ",") {
+			if key = oohttp.CanonicalHeaderKey(strings.TrimSpace(key)); key != "" {
+				if trailer == nil {
+					trailer = make(oohttp.Header)
+				}
+				trailer[key] = nil
+			}
+		}
+	}
+	return
+}
+
+// readTrailers returns a function reading the trailers into trailer,
+// which only keeps the declared trailers, as net/http does.
+func (qd *qpackDecoder) readTrailers(trailer oohttp.Header) func(st *stream) error {
+	return func(st *stream) error {
+		h, err := qd.decodeHeaders(st, nil)
+		if err != nil {
+			return err
+		}
+		for key, values := range h {
+			if _, declared := trailer[key]; declared {
+				trailer[key] = values
+			}
+		}
+		return nil
+	}
+}
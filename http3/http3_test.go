@@ -0,0 +1,172 @@
+package http3_test
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/http3"
+	"github.com/ooni/oohttp/internal/testcert"
+)
+
+// newServer starts a loopback HTTP/3 server using handler and returns
+// its address and a Transport trusting its certificate.
+func newServer(t *testing.T, handler oohttp.Handler) (string, *http3.Transport) {
+	t.Helper()
+	cert, err := tls.X509KeyPair(testcert.LocalhostCert, testcert.LocalhostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := http3.ListenQUIC("127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http3.Server{Handler: handler}
+	go srv.Serve(l)
+	t.Cleanup(func() { srv.Close() })
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(testcert.LocalhostCert)
+	txp := &http3.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
+	t.Cleanup(func() { txp.Close() })
+	return l.Addr().String(), txp
+}
+
+// roundTrip sends a request and returns the response and its body.
+func roundTrip(t *testing.T, txp oohttp.RoundTripper, req *oohttp.Request) (*oohttp.Response, string) {
+	t.Helper()
+	resp, err := txp.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(data)
+}
+
+func TestTransportAndServer(t *testing.T) {
+	var mu sync.Mutex
+	clients := make(map[string]bool)
+	addr, txp := newServer(t, oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+		mu.Lock()
+		clients[r.RemoteAddr] = true
+		mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Trailer", "X-Checksum")
+		w.Header().Set("X-Host", r.Host)
+		if r.TLS != nil {
+			w.Header().Set("X-TLS", fmt.Sprintf("%x %s %s", r.TLS.Version, r.TLS.NegotiatedProtocol, r.TLS.ServerName))
+		}
+		io.WriteString(w, r.Proto+" "+r.Method+" "+r.URL.Path+" "+string(body))
+		w.Header().Set("X-Checksum", "1234")
+	}))
+
+	t.Run("we round trip requests using a single connection", func(t *testing.T) {
+		for _, body := range []string{"", "hello"} {
+			req, _ := oohttp.NewRequest("POST", "https://"+addr+"/echo", strings.NewReader(body))
+			resp, data := roundTrip(t, txp, req)
+			if resp.ProtoMajor != 3 || data != "HTTP/3.0 POST /echo "+body {
+				t.Fatalf("unexpected response: %s %q", resp.Proto, data)
+			}
+			if resp.TLS == nil || resp.TLS.NegotiatedProtocol != "h3" {
+				t.Fatalf("unexpected TLS state: %+v", resp.TLS)
+			}
+			if got := resp.Trailer.Get("X-Checksum"); got != "1234" {
+				t.Fatalf("unexpected trailer: %q", got)
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if len(clients) != 1 {
+			t.Fatalf("expected one connection, got %d", len(clients))
+		}
+	})
+
+	t.Run("we honour the connection overrides", func(t *testing.T) {
+		req, _ := oohttp.NewRequest("GET", "https://example.com/", nil)
+		req = req.WithContext(oohttp.WithConnOverrides(req.Context(), oohttp.ConnOverrides{DialAddr: addr}))
+		resp, data := roundTrip(t, txp, req)
+		if resp.Header.Get("X-Host") != "example.com" || data != "HTTP/3.0 GET / " {
+			t.Fatalf("unexpected response: %v %q", resp.Header, data)
+		}
+	})
+
+	t.Run("the server reports the state of the TLS handshake", func(t *testing.T) {
+		txp := &http3.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true, ServerName: "example.com"}}
+		defer txp.Close()
+		req, _ := oohttp.NewRequest("GET", "https://"+addr+"/", nil)
+		resp, _ := roundTrip(t, txp, req)
+		if got := resp.Header.Get("X-TLS"); got != "304 h3 example.com" {
+			t.Fatalf("unexpected TLS state: %q", got)
+		}
+	})
+
+	t.Run("we use the Dial function", func(t *testing.T) {
+		expected := errors.New("mocked error")
+		txp := &http3.Transport{
+			Dial: func(ctx context.Context, address string, tlsConfig *tls.Config) (http3.QUICConn, error) {
+				if address != "example.com:443" || tlsConfig.ServerName != "example.com" {
+					t.Errorf("unexpected dial: %s %s", address, tlsConfig.ServerName)
+				}
+				return nil, expected
+			},
+		}
+		req, _ := oohttp.NewRequest("GET", "https://example.com/", nil)
+		if _, err := txp.RoundTrip(req); !errors.Is(err, expected) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestTransportGzip(t *testing.T) {
+	addr, txp := newServer(t, oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			io.WriteString(w, "identity")
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		io.WriteString(zw, "compressed")
+		zw.Close()
+	}))
+
+	t.Run("we transparently decompress responses", func(t *testing.T) {
+		req, _ := oohttp.NewRequest("GET", "https://"+addr+"/", nil)
+		resp, data := roundTrip(t, txp, req)
+		if !resp.Uncompressed || data != "compressed" {
+			t.Fatalf("unexpected response: %v %q", resp.Uncompressed, data)
+		}
+	})
+
+	t.Run("we advertise and use the content decoders", func(t *testing.T) {
+		txp.ContentDecoders = oohttp.ContentDecoders{oohttp.DeflateDecoder, oohttp.GzipDecoder}
+		defer func() { txp.ContentDecoders = nil }()
+		req, _ := oohttp.NewRequest("GET", "https://"+addr+"/", nil)
+		if resp, data := roundTrip(t, txp, req); resp.Uncompressed || data != "identity" {
+			t.Fatalf("unexpected response: %v %q", resp.Uncompressed, data)
+		}
+		txp.AcceptEncoding = "gzip"
+		defer func() { txp.AcceptEncoding = "" }()
+		if resp, data := roundTrip(t, txp, req.Clone(req.Context())); !resp.Uncompressed || data != "compressed" {
+			t.Fatalf("unexpected response: %v %q", resp.Uncompressed, data)
+		}
+	})
+
+	t.Run("we do not request compression when disabled", func(t *testing.T) {
+		txp.DisableCompression = true
+		req, _ := oohttp.NewRequest("GET", "https://"+addr+"/", nil)
+		if _, data := roundTrip(t, txp, req); data != "identity" {
+			t.Fatalf("unexpected body: %q", data)
+		}
+	})
+}
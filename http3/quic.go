@@ -0,0 +1,359 @@
+package http3
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"golang.org/x/net/quic"
+)
+
+// QUICConn is a QUIC connection. Implementations must be safe for
+// concurrent use. By implementing this interface, and QUICStream, you
+// can use a QUIC and TLS implementation other than golang.org/x/net/quic.
+type QUICConn interface {
+	// OpenStream opens a bidirectional stream.
+	OpenStream(ctx context.Context) (QUICStream, error)
+
+	// OpenUniStream opens a unidirectional stream.
+	OpenUniStream(ctx context.Context) (QUICStream, error)
+
+	// AcceptStream waits for the peer to open a bidirectional stream.
+	AcceptStream(ctx context.Context) (QUICStream, error)
+
+	// AcceptUniStream waits for the peer to open a unidirectional stream.
+	AcceptUniStream(ctx context.Context) (QUICStream, error)
+
+	// CloseWithError closes the connection using the given application
+	// error code and reason, unblocking all the pending operations.
+	CloseWithError(code uint64, reason string) error
+
+	// ConnectionState returns the state of the TLS handshake.
+	ConnectionState() tls.ConnectionState
+
+	// LocalAddr returns the local address.
+	LocalAddr() net.Addr
+
+	// RemoteAddr returns the remote address.
+	RemoteAddr() net.Addr
+}
+
+// QUICStream is a QUIC stream. Write must send the data to the peer
+// without waiting for further writes, since we buffer writes ourselves.
+// Read returns io.EOF once the peer has finished sending.
+type QUICStream interface {
+	io.Reader
+	io.Writer
+
+	// CloseWrite finishes the sending part of the stream.
+	CloseWrite() error
+
+	// CancelRead aborts the receiving part of the stream, asking the
+	// peer to stop sending using the given code, and unblocks Read.
+	CancelRead(code uint64)
+
+	// CancelWrite aborts the sending part of the stream using the given
+	// code, and unblocks Write.
+	CancelWrite(code uint64)
+}
+
+// QUICListener accepts QUIC connections.
+type QUICListener interface {
+	// Accept waits for and returns the next connection.
+	Accept(ctx context.Context) (QUICConn, error)
+
+	// Close closes the listener and its connections.
+	Close() error
+
+	// Addr returns the address of the listener.
+	Addr() net.Addr
+}
+
+// ListenQUIC listens for QUIC connections on the UDP address using the
+// golang.org/x/net/quic package. The tlsConfig must contain at least a
+// certificate. We require TLS 1.3 and, unless tlsConfig sets NextProtos,
+// the "h3" ALPN.
+func ListenQUIC(address string, tlsConfig *tls.Config) (QUICListener, error) {
+	tc := &tlsCapture{}
+	config := &quic.Config{TLSConfig: tc.wrap(initTLSConfig(tlsConfig))}
+	endpoint, err := quic.Listen("udp", address, config)
+	if err != nil {
+		return nil, err
+	}
+	return &quicListener{endpoint: endpoint, tc: tc}, nil
+}
+
+// initTLSConfig returns a copy of config requiring TLS 1.3 and, unless
+// config sets NextProtos, the "h3" ALPN.
+func initTLSConfig(config *tls.Config) *tls.Config {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	config = config.Clone()
+	if config.MinVersion < tls.VersionTLS13 {
+		config.MinVersion = tls.VersionTLS13
+	}
+	if config.NextProtos == nil {
+		config.NextProtos = []string{"h3"}
+	}
+	return config
+}
+
+// quicListener is the QUICListener returned by ListenQUIC.
+type quicListener struct {
+	endpoint *quic.Endpoint
+	tc       *tlsCapture // shared by the handshakes of all connections
+}
+
+func (l *quicListener) Accept(ctx context.Context) (QUICConn, error) {
+	qconn, err := l.endpoint.Accept(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newQUICConn(qconn, l.tc.pop()), nil
+}
+
+func (l *quicListener) Close() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // do not wait for the peers to acknowledge
+	if err := l.endpoint.Close(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return net.UDPAddrFromAddrPort(l.endpoint.LocalAddr())
+}
+
+// quicDialer dials QUIC connections using a golang.org/x/net/quic
+// endpoint, which it creates on first use.
+type quicDialer struct {
+	mu       sync.Mutex
+	endpoint *quic.Endpoint
+}
+
+// dial establishes a connection to the UDP address.
+func (d *quicDialer) dial(ctx context.Context, address string, tlsConfig *tls.Config) (QUICConn, error) {
+	d.mu.Lock()
+	if d.endpoint == nil {
+		endpoint, err := quic.Listen("udp", ":0", nil)
+		if err != nil {
+			d.mu.Unlock()
+			return nil, err
+		}
+		d.endpoint = endpoint
+	}
+	endpoint := d.endpoint
+	d.mu.Unlock()
+	tc := &tlsCapture{}
+	config := &quic.Config{TLSConfig: tc.wrap(tlsConfig)}
+	qconn, err := endpoint.Dial(ctx, "udp", address, config)
+	if err != nil {
+		return nil, err
+	}
+	return newQUICConn(qconn, tc), nil
+}
+
+// close closes the endpoint, if any, and its connections.
+func (d *quicDialer) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.endpoint != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // do not wait for the peers to acknowledge
+		d.endpoint.Close(ctx)
+		d.endpoint = nil
+	}
+}
+
+// tlsCapture records the TLS connection state of handshakes using the
+// config returned by wrap, since the golang.org/x/net/quic package does
+// not expose it. Each client connection uses its own tlsCapture, while
+// a listener uses one for all the handshakes and pops their states as
+// it accepts connections, which happens when the handshake completes,
+// right after recording the state.
+type tlsCapture struct {
+	mu     sync.Mutex
+	states []tls.ConnectionState
+}
+
+// wrap returns a copy of config recording the connection state.
+func (tc *tlsCapture) wrap(config *tls.Config) *tls.Config {
+	config = config.Clone()
+	verify := config.VerifyConnection
+	config.VerifyConnection = func(cs tls.ConnectionState) error {
+		if verify != nil {
+			if err := verify(cs); err != nil {
+				return err
+			}
+		}
+		cs.HandshakeComplete = true // VerifyConnection runs before completion
+		tc.mu.Lock()
+		tc.states = append(tc.states, cs)
+		tc.mu.Unlock()
+		return nil
+	}
+	return config
+}
+
+// pop returns a tlsCapture containing the oldest recorded state, which
+// belongs to the connection the listener has just accepted.
+func (tc *tlsCapture) pop() *tlsCapture {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	out := &tlsCapture{}
+	if len(tc.states) > 0 {
+		out.states = tc.states[:1:1]
+		tc.states = tc.states[1:]
+	}
+	return out
+}
+
+// connectionState returns the recorded state.
+func (tc *tlsCapture) connectionState() tls.ConnectionState {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if len(tc.states) > 0 {
+		return tc.states[0]
+	}
+	return tls.ConnectionState{}
+}
+
+// quicConn adapts a golang.org/x/net/quic connection to QUICConn.
+type quicConn struct {
+	qconn *quic.Conn
+	tc    *tlsCapture
+
+	// acceptStreams sends the streams opened by the peer to bidi and
+	// uni, and closes done, after setting err, when the connection is
+	// closed. CloseWithError closes closed.
+	bidi      chan *quic.Stream
+	uni       chan *quic.Stream
+	done      chan struct{}
+	err       error
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newQUICConn(qconn *quic.Conn, tc *tlsCapture) *quicConn {
+	c := &quicConn{
+		qconn:  qconn,
+		tc:     tc,
+		bidi:   make(chan *quic.Stream, 16),
+		uni:    make(chan *quic.Stream, 16),
+		done:   make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+	go c.acceptStreams()
+	return c
+}
+
+func (c *quicConn) acceptStreams() {
+	defer close(c.done)
+	for {
+		st, err := c.qconn.AcceptStream(context.Background())
+		if err != nil {
+			c.err = err
+			return
+		}
+		ch := c.bidi
+		if st.IsReadOnly() {
+			ch = c.uni
+		}
+		select {
+		case ch <- st:
+		case <-c.closed:
+			c.err = net.ErrClosed
+			return
+		}
+	}
+}
+
+func (c *quicConn) OpenStream(ctx context.Context) (QUICStream, error) {
+	st, err := c.qconn.NewStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return quicStream{st}, nil
+}
+
+func (c *quicConn) OpenUniStream(ctx context.Context) (QUICStream, error) {
+	st, err := c.qconn.NewSendOnlyStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return quicStream{st}, nil
+}
+
+func (c *quicConn) AcceptStream(ctx context.Context) (QUICStream, error) {
+	return c.accept(ctx, c.bidi)
+}
+
+func (c *quicConn) AcceptUniStream(ctx context.Context) (QUICStream, error) {
+	return c.accept(ctx, c.uni)
+}
+
+func (c *quicConn) accept(ctx context.Context, ch chan *quic.Stream) (QUICStream, error) {
+	select {
+	case st := <-ch:
+		return quicStream{st}, nil
+	case <-c.done:
+		return nil, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *quicConn) CloseWithError(code uint64, reason string) error {
+	c.closeOnce.Do(func() {
+		c.qconn.Abort(&quic.ApplicationError{Code: code, Reason: reason})
+		close(c.closed)
+	})
+	return nil
+}
+
+func (c *quicConn) ConnectionState() tls.ConnectionState {
+	return c.tc.connectionState()
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return net.UDPAddrFromAddrPort(c.qconn.LocalAddr())
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return net.UDPAddrFromAddrPort(c.qconn.RemoteAddr())
+}
+
+// quicStream adapts a golang.org/x/net/quic stream to QUICStream.
+type quicStream struct {
+	st *quic.Stream
+}
+
+func (s quicStream) Read(p []byte) (int, error) {
+	return s.st.Read(p)
+}
+
+func (s quicStream) Write(p []byte) (int, error) {
+	n, err := s.st.Write(p)
+	if err == nil {
+		s.st.Flush()
+	}
+	return n, err
+}
+
+func (s quicStream) CloseWrite() error {
+	s.st.CloseWrite()
+	return nil
+}
+
+func (s quicStream) CancelRead(code uint64) {
+	s.st.CloseRead() // the quic package does not allow setting the code
+}
+
+func (s quicStream) CancelWrite(code uint64) {
+	s.st.Reset(code)
+}
@@ -0,0 +1,403 @@
+package http3
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	oohttp "github.com/ooni/oohttp"
+	"golang.org/x/net/http/httpguts"
+)
+
+// defaultUserAgent is the User-Agent we send when the request has none.
+const defaultUserAgent = "Go-http-client/3"
+
+type roundTripState struct {
+	cc *clientConn
+	st *stream
+
+	// stopCancel stops canceling the stream when the request context
+	// is done.
+	stopCancel func() bool
+
+	// Request body, provided by the caller.
+	onceCloseReqBody sync.Once
+	reqBody          io.ReadCloser
+
+	reqBodyWriter bodyWriter
+
+	// Response.Body, provided to the caller.
+	respBody bodyReader
+
+	errOnce sync.Once
+	err     error
+}
+
+// abort terminates the RoundTrip.
+// It returns the first fatal error encountered by the RoundTrip call.
+func (rt *roundTripState) abort(err error) error {
+	rt.errOnce.Do(func() {
+		rt.err = err
+		rt.stopCancel()
+		rt.cc.endRequest()
+		switch e := err.(type) {
+		case *connectionError:
+			rt.cc.abort(e)
+		case *streamError:
+			rt.st.stream.CancelRead(uint64(e.code))
+			rt.st.stream.CancelWrite(uint64(e.code))
+		default:
+			rt.st.stream.CancelRead(uint64(errH3NoError))
+			rt.st.stream.CancelWrite(uint64(errH3NoError))
+		}
+	})
+	return rt.err
+}
+
+// closeReqBody closes the Request.Body, at most once.
+func (rt *roundTripState) closeReqBody() {
+	if rt.reqBody != nil {
+		rt.onceCloseReqBody.Do(func() {
+			rt.reqBody.Close()
+		})
+	}
+}
+
+// roundTrip sends a request on the connection. It returns
+// errClientConnUnusable, without closing the request body, when the
+// request may be retried using another connection. When comp is not
+// nil, we request and transparently decode compressed responses,
+// unless the request disables this behavior.
+func (cc *clientConn) roundTrip(req *oohttp.Request, comp *compression) (_ *oohttp.Response, err error) {
+	if !cc.startRequest() {
+		return nil, errClientConnUnusable
+	}
+	ctx := req.Context()
+
+	// Each request gets its own QUIC stream.
+	st, err := newConnStream(ctx, cc.qconn, streamTypeRequest)
+	if err != nil {
+		cc.endRequest()
+		if ctx.Err() != nil {
+			closeRequestBody(req)
+			return nil, ctx.Err()
+		}
+		cc.setClosed()
+		return nil, errClientConnUnusable
+	}
+	rt := &roundTripState{
+		cc:      cc,
+		st:      st,
+		reqBody: req.Body,
+		// Cancel reads/writes on the stream when the request expires.
+		stopCancel: context.AfterFunc(ctx, func() {
+			st.stream.CancelRead(uint64(errH3RequestCancelled))
+			st.stream.CancelWrite(uint64(errH3RequestCancelled))
+		}),
+	}
+	defer func() {
+		if err != nil {
+			rt.closeReqBody()
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			err = rt.abort(err)
+		}
+	}()
+
+	if req.Header.Get("Accept-Encoding") != "" || req.Header.Get("Range") != "" || req.Method == "HEAD" {
+		comp = nil
+	}
+	contentLength := actualContentLength(req)
+	headers, err := cc.encodeRequestHeaders(req, contentLength, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Write the HEADERS frame.
+	st.writeFrame(frameTypeHeaders, headers)
+	if err := st.Flush(); err != nil {
+		return nil, err
+	}
+
+	if contentLength != 0 {
+		rt.reqBodyWriter.st = st
+		rt.reqBodyWriter.remain = contentLength
+		rt.reqBodyWriter.flush = true
+		rt.reqBodyWriter.name = "request"
+		go copyRequestBody(rt)
+	} else {
+		rt.closeReqBody()
+		st.stream.CloseWrite()
+	}
+
+	// Read the response headers.
+	for {
+		ftype, err := st.readFrameHeader()
+		if err != nil {
+			return nil, err
+		}
+		switch ftype {
+		case frameTypeHeaders:
+			statusCode, h, err := cc.handleHeaders(st)
+			if err != nil {
+				return nil, err
+			}
+
+			if statusCode >= 100 && statusCode < 200 {
+				// We ignore informational responses.
+				continue
+			}
+
+			// We have the response headers.
+			// Set up the response and return it to the caller.
+			contentLength, err := parseResponseContentLength(req.Method, statusCode, h)
+			if err != nil {
+				return nil, err
+			}
+			rt.respBody.st = st
+			rt.respBody.remain = contentLength
+			state := cc.qconn.ConnectionState()
+			resp := &oohttp.Response{
+				Proto:         "HTTP/3.0",
+				ProtoMajor:    3,
+				Header:        h,
+				StatusCode:    statusCode,
+				Status:        strconv.Itoa(statusCode) + " " + oohttp.StatusText(statusCode),
+				ContentLength: contentLength,
+				Body:          (*transportResponseBody)(rt),
+				Trailer:       declaredTrailers(h),
+				Request:       req,
+				TLS:           &state,
+			}
+			if resp.Trailer != nil {
+				rt.respBody.trailer = cc.dec.readTrailers(resp.Trailer)
+			}
+			if req.Method == "HEAD" {
+				resp.Body = oohttp.NoBody
+				rt.abort(errRespBodyClosed)
+			} else if comp != nil && len(comp.decoders) > 0 {
+				comp.decoders.Decode(resp)
+			} else if comp != nil && strings.EqualFold(h.Get("Content-Encoding"), "gzip") {
+				h.Del("Content-Encoding")
+				h.Del("Content-Length")
+				resp.ContentLength = -1
+				resp.Uncompressed = true
+				resp.Body = &gzipReader{body: resp.Body}
+			}
+			return resp, nil
+		case frameTypePushPromise:
+			if err := cc.handlePushPromise(st); err != nil {
+				return nil, err
+			}
+		default:
+			if err := st.discardUnknownFrame(ftype); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// encodeRequestHeaders returns the encoded HEADERS frame payload of req.
+func (cc *clientConn) encodeRequestHeaders(req *oohttp.Request, contentLength int64, comp *compression) ([]byte, error) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	host, err := httpguts.PunycodeHostPort(host)
+	if err != nil {
+		return nil, err
+	}
+	if !httpguts.ValidHostHeader(host) {
+		return nil, errors.New("http3: invalid Host header")
+	}
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
+	pseudo := []headerField{{":authority", host}, {":method", method}}
+	if method != "CONNECT" {
+		pseudo = append(pseudo, headerField{":path", req.URL.RequestURI()}, headerField{":scheme", "https"})
+	}
+	fields := headerFields(req.Header, func(name string) bool {
+		return name == "host" || name == "content-length"
+	})
+	if _, found := req.Header["User-Agent"]; !found {
+		fields = append(fields, headerField{"user-agent", defaultUserAgent})
+	}
+	if contentLength > 0 || (contentLength == 0 && (method == "POST" || method == "PUT" || method == "PATCH")) {
+		fields = append(fields, headerField{"content-length", strconv.FormatInt(contentLength, 10)})
+	}
+	if comp != nil {
+		fields = append(fields, headerField{"accept-encoding", comp.acceptEncoding})
+	}
+	fields = orderHeaderFields(fields, req.Header[oohttp.HeaderOrderKey])
+	if err := validHeaderFields(fields); err != nil {
+		return nil, err
+	}
+	return cc.enc.encodeFields(pseudo, fields), nil
+}
+
+// actualContentLength returns a sanitized version of req.ContentLength,
+// where 0 actually means zero (not unknown) and -1 means unknown.
+func actualContentLength(req *oohttp.Request) int64 {
+	if req.Body == nil || req.Body == oohttp.NoBody {
+		return 0
+	}
+	if req.ContentLength != 0 {
+		return req.ContentLength
+	}
+	return -1
+}
+
+func copyRequestBody(rt *roundTripState) {
+	defer rt.closeReqBody()
+	_, err := io.Copy(&rt.reqBodyWriter, rt.reqBody)
+	if closeErr := rt.reqBodyWriter.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Something went wrong writing the body.
+		rt.abort(err)
+	} else {
+		// We wrote the whole body.
+		rt.st.stream.CloseWrite()
+	}
+}
+
+// transportResponseBody is the Response.Body returned by RoundTrip.
+type transportResponseBody roundTripState
+
+// Read is Response.Body.Read.
+func (b *transportResponseBody) Read(p []byte) (n int, err error) {
+	return b.respBody.Read(p)
+}
+
+var errRespBodyClosed = errors.New("response body closed")
+
+// Close is Response.Body.Close.
+// Closing the response body is how the caller signals that they're done with a request.
+func (b *transportResponseBody) Close() error {
+	rt := (*roundTripState)(b)
+	// Close the request body, which should wake up copyRequestBody if it's
+	// currently blocked reading the body.
+	rt.closeReqBody()
+	// Close the request stream, since we're done with the request.
+	// CancelWrite closes the sending half of the stream.
+	rt.st.stream.CancelWrite(uint64(errH3NoError))
+	// respBody.Close is responsible for closing the receiving half.
+	err := rt.respBody.Close()
+	if err == nil {
+		err = errRespBodyClosed
+	}
+	err = rt.abort(err)
+	if err == errRespBodyClosed {
+		// No other errors occurred before closing Response.Body,
+		// so consider this a successful request.
+		return nil
+	}
+	return err
+}
+
+// gzipReader lazily decodes a gzip-encoded response body.
+type gzipReader struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (gz *gzipReader) Read(p []byte) (int, error) {
+	if gz.err != nil {
+		return 0, gz.err
+	}
+	if gz.zr == nil {
+		gz.zr, gz.err = gzip.NewReader(gz.body)
+		if gz.err != nil {
+			return 0, gz.err
+		}
+	}
+	return gz.zr.Read(p)
+}
+
+func (gz *gzipReader) Close() error {
+	return gz.body.Close()
+}
+
+func parseResponseContentLength(method string, statusCode int, h oohttp.Header) (int64, error) {
+	clens := h["Content-Length"]
+	if len(clens) == 0 {
+		return -1, nil
+	}
+
+	// We allow duplicate Content-Length headers,
+	// but only if they all have the same value.
+	for _, v := range clens[1:] {
+		if clens[0] != v {
+			return -1, &streamError{errH3MessageError, "mismatching Content-Length headers"}
+		}
+	}
+
+	// "A server MUST NOT send a Content-Length header field in any response
+	// with a status code of 1xx (Informational) or 204 (No Content).
+	// A server MUST NOT send a Content-Length header field in any 2xx (Successful)
+	// response to a CONNECT request [...]"
+	// https://www.rfc-editor.org/rfc/rfc9110#section-8.6-8
+	if (statusCode >= 100 && statusCode < 200) ||
+		statusCode == 204 ||
+		(method == "CONNECT" && statusCode >= 200 && statusCode < 300) {
+		// This is a protocol violation, but a fairly harmless one.
+		// Just ignore the header.
+		return -1, nil
+	}
+
+	contentLen, err := strconv.ParseUint(clens[0], 10, 63)
+	if err != nil {
+		return -1, &streamError{errH3MessageError, "invalid Content-Length header"}
+	}
+	return int64(contentLen), nil
+}
+
+func (cc *clientConn) handleHeaders(st *stream) (statusCode int, h oohttp.Header, err error) {
+	haveStatus := false
+	h, err = cc.dec.decodeHeaders(st, func(name, value string) error {
+		if name != ":status" {
+			// "Endpoints MUST treat a request or response
+			// that contains undefined or invalid
+			// pseudo-header fields as malformed."
+			// https://www.rfc-editor.org/rfc/rfc9114.html#section-4.3-3
+			return &streamError{errH3MessageError, "undefined pseudo-header"}
+		}
+		if haveStatus {
+			return &streamError{errH3MessageError, "duplicate :status"}
+		}
+		haveStatus = true
+		statusCode, err = strconv.Atoi(value)
+		if err != nil {
+			return &streamError{errH3MessageError, "invalid :status"}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if !haveStatus {
+		// "[The :status] pseudo-header field MUST be included in all responses [...]"
+		// https://www.rfc-editor.org/rfc/rfc9114.html#section-4.3.2-1
+		return 0, nil, &streamError{errH3MessageError, "missing :status"}
+	}
+	return statusCode, h, nil
+}
+
+func (cc *clientConn) handlePushPromise(st *stream) error {
+	// "A client MUST treat receipt of a PUSH_PROMISE frame that contains a
+	// larger push ID than the client has advertised as a connection error of H3_ID_ERROR."
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.5-5
+	return &connectionError{
+		code:    errH3IDError,
+		message: "PUSH_PROMISE received when no MAX_PUSH_ID has been sent",
+	}
+}
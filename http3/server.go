@@ -0,0 +1,499 @@
+package http3
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// Server is an HTTP/3 server. The zero value is ready to use, but
+// ListenAndServe requires TLSConfig to contain a certificate.
+type Server struct {
+	// Handler handles the requests. If nil, we use oohttp.DefaultServeMux.
+	Handler oohttp.Handler
+
+	// TLSConfig is the TLS configuration used by ListenAndServe.
+	TLSConfig *tls.Config
+
+	mu        sync.Mutex
+	listeners map[QUICListener]struct{}
+	conns     map[QUICConn]struct{}
+	closed    bool
+}
+
+// ErrServerClosed is returned by Serve and ListenAndServe after Close.
+var ErrServerClosed = errors.New("http3: Server closed")
+
+// ListenAndServe listens on the UDP address using ListenQUIC and
+// then calls Serve.
+func (s *Server) ListenAndServe(address string) error {
+	l, err := ListenQUIC(address, s.TLSConfig)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections using l and serves each of them in a
+// background goroutine. Serve closes l when it returns.
+func (s *Server) Serve(l QUICListener) error {
+	defer l.Close()
+	if !track(&s.mu, &s.closed, &s.listeners, l, true) {
+		return ErrServerClosed
+	}
+	defer track(&s.mu, &s.closed, &s.listeners, l, false)
+	for {
+		qconn, err := l.Accept(context.Background())
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			return err
+		}
+		go s.ServeConn(qconn)
+	}
+}
+
+// ServeConn serves the requests received using qconn, until the
+// connection is closed. Use ServeConn with your own QUIC listener.
+func (s *Server) ServeConn(qconn QUICConn) {
+	if !track(&s.mu, &s.closed, &s.conns, qconn, true) {
+		qconn.CloseWithError(uint64(errH3NoError), "")
+		return
+	}
+	defer track(&s.mu, &s.closed, &s.conns, qconn, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sc := &serverConn{
+		srv:   s,
+		qconn: qconn,
+		ctx:   context.WithValue(ctx, oohttp.LocalAddrContextKey, qconn.LocalAddr()),
+		tls:   qconn.ConnectionState(),
+	}
+	sc.enc.init()
+
+	// Create control stream and send SETTINGS frame.
+	controlStream, err := newConnStream(ctx, sc.qconn, streamTypeControl)
+	if err != nil {
+		return
+	}
+	controlStream.writeSettings()
+	controlStream.Flush()
+
+	sc.acceptStreams(sc.qconn, sc)
+}
+
+// Close closes the listeners and the connections of s.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	listeners, conns := s.listeners, s.conns
+	s.listeners, s.conns = nil, nil
+	s.mu.Unlock()
+	for l := range listeners {
+		l.Close()
+	}
+	for qconn := range conns {
+		qconn.CloseWithError(uint64(errH3NoError), "")
+	}
+	return nil
+}
+
+// track adds v to or removes v from the set *m. It returns false when
+// adding to a closed server.
+func track[T comparable](mu *sync.Mutex, closed *bool, m *map[T]struct{}, v T, add bool) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	if !add {
+		delete(*m, v)
+		return true
+	}
+	if *closed {
+		return false
+	}
+	if *m == nil {
+		*m = make(map[T]struct{})
+	}
+	(*m)[v] = struct{}{}
+	return true
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+type serverConn struct {
+	srv   *Server
+	qconn QUICConn
+	ctx   context.Context
+	tls   tls.ConnectionState
+
+	genericConn // for handleUnidirectionalStream
+	enc         qpackEncoder
+	dec         qpackDecoder
+}
+
+func (sc *serverConn) handleControlStream(st *stream) error {
+	// "A SETTINGS frame MUST be sent as the first frame of each control stream [...]"
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.4-2
+	if err := st.readSettings(func(settingsType, settingsValue int64) error {
+		// We only use the static QPACK table and we do not limit the
+		// size of the field sections we send, so we ignore all settings.
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for {
+		ftype, err := st.readFrameHeader()
+		if err != nil {
+			return err
+		}
+		switch ftype {
+		case frameTypeCancelPush:
+			// "If a server receives a CANCEL_PUSH frame for a push ID
+			// that has not yet been mentioned by a PUSH_PROMISE frame,
+			// this MUST be treated as a connection error of type H3_ID_ERROR."
+			// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.3-8
+			return &connectionError{
+				code:    errH3IDError,
+				message: "CANCEL_PUSH for unsent push ID",
+			}
+		case frameTypeGoaway:
+			return errH3NoError
+		default:
+			// Unknown frames are ignored.
+			if err := st.discardUnknownFrame(ftype); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (sc *serverConn) handleEncoderStream(st *stream) error {
+	return discardStream(st)
+}
+
+func (sc *serverConn) handleDecoderStream(st *stream) error {
+	return discardStream(st)
+}
+
+func (sc *serverConn) handlePushStream(*stream) error {
+	// "[...] if a server receives a client-initiated push stream,
+	// this MUST be treated as a connection error of type H3_STREAM_CREATION_ERROR."
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-6.2.2-3
+	return &connectionError{
+		code:    errH3StreamCreationError,
+		message: "client created push stream",
+	}
+}
+
+func (sc *serverConn) handleRequestStream(st *stream) error {
+	req, err := sc.readRequest(st)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	req = req.WithContext(ctx)
+	rw := &responseWriter{st: st, enc: &sc.enc, req: req, header: make(oohttp.Header)}
+	if err := sc.serve(rw, req); err != nil {
+		return err
+	}
+	return rw.finish()
+}
+
+// readRequest reads the request headers from st.
+func (sc *serverConn) readRequest(st *stream) (*oohttp.Request, error) {
+	for {
+		ftype, err := st.readFrameHeader()
+		if err != nil {
+			return nil, err
+		}
+		if ftype == frameTypeHeaders {
+			break
+		}
+		if err := st.discardUnknownFrame(ftype); err != nil {
+			return nil, err
+		}
+	}
+	pseudo := make(map[string]string)
+	header, err := sc.dec.decodeHeaders(st, func(name, value string) error {
+		switch name {
+		case ":method", ":scheme", ":authority", ":path":
+			if _, found := pseudo[name]; found {
+				return &streamError{errH3MessageError, "duplicate " + name}
+			}
+			pseudo[name] = value
+			return nil
+		default:
+			return &streamError{errH3MessageError, "undefined pseudo-header"}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	method, authority, path := pseudo[":method"], pseudo[":authority"], pseudo[":path"]
+	req := &oohttp.Request{
+		Method:     method,
+		Proto:      "HTTP/3.0",
+		ProtoMajor: 3,
+		Header:     header,
+		Host:       authority,
+		RemoteAddr: sc.qconn.RemoteAddr().String(),
+		TLS:        &sc.tls,
+		Trailer:    declaredTrailers(header),
+	}
+	if req.Host == "" {
+		req.Host = header.Get("Host")
+	}
+	delete(header, "Host")
+	switch {
+	case method == "CONNECT":
+		// https://www.rfc-editor.org/rfc/rfc9114.html#section-4.4
+		if authority == "" || pseudo[":scheme"] != "" || path != "" {
+			return nil, &streamError{errH3MessageError, "malformed CONNECT request"}
+		}
+		req.URL = &url.URL{Host: authority}
+		req.RequestURI = authority
+	case method == "" || pseudo[":scheme"] == "" || path == "":
+		return nil, &streamError{errH3MessageError, "missing pseudo-header"}
+	default:
+		u, err := url.ParseRequestURI(path)
+		if err != nil {
+			return nil, &streamError{errH3MessageError, "invalid :path"}
+		}
+		req.URL = u
+		req.RequestURI = path
+	}
+	contentLength := int64(-1)
+	if v := header.Get("Content-Length"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 63)
+		if err != nil {
+			return nil, &streamError{errH3MessageError, "invalid Content-Length header"}
+		}
+		contentLength = int64(n)
+	}
+	req.ContentLength = contentLength
+	body := &bodyReader{st: st, remain: contentLength}
+	if req.Trailer != nil {
+		body.trailer = sc.dec.readTrailers(req.Trailer)
+	}
+	req.Body = body
+	return req.WithContext(sc.ctx), nil
+}
+
+// serve calls the handler, recovering from panics.
+func (sc *serverConn) serve(rw *responseWriter, req *oohttp.Request) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			if v != oohttp.ErrAbortHandler {
+				const size = 64 << 10
+				buf := make([]byte, size)
+				buf = buf[:runtime.Stack(buf, false)]
+				log.Printf("http3: panic serving %v: %v\n%s", req.RemoteAddr, v, buf)
+			}
+			err = &streamError{errH3InternalError, fmt.Sprint(v)}
+		}
+	}()
+	handler := sc.srv.Handler
+	if handler == nil {
+		handler = oohttp.DefaultServeMux
+	}
+	handler.ServeHTTP(rw, req)
+	return nil
+}
+
+// abort closes the connection with an error.
+func (sc *serverConn) abort(err error) {
+	abortConn(sc.qconn, err)
+}
+
+// responseWriterBufferSize is the amount of body data a responseWriter
+// buffers before sending a DATA frame.
+const responseWriterBufferSize = 16 << 10
+
+// responseWriter implements oohttp.ResponseWriter and oohttp.Flusher.
+type responseWriter struct {
+	st  *stream
+	enc *qpackEncoder
+	req *oohttp.Request
+
+	header        oohttp.Header
+	trailers      []string // the trailers declared before sending the header
+	status        int      // 0 until WriteHeader
+	contentLength int64    // -1 if unknown
+	sentHeader    bool
+	buf           []byte
+	written       int64
+}
+
+var _ oohttp.Flusher = &responseWriter{}
+
+func (rw *responseWriter) Header() oohttp.Header {
+	return rw.header
+}
+
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.status != 0 {
+		return
+	}
+	if code < 100 || code > 999 {
+		panic(fmt.Sprintf("invalid WriteHeader code %v", code))
+	}
+	if code >= 100 && code < 200 && code != 101 {
+		// Send the informational response right away.
+		fields := headerFields(rw.header, nil)
+		rw.st.writeFrame(frameTypeHeaders, rw.enc.encodeFields(
+			[]headerField{{":status", strconv.Itoa(code)}}, fields))
+		rw.st.Flush()
+		return
+	}
+	rw.status = code
+	rw.contentLength = -1
+	if v := rw.header.Get("Content-Length"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n >= 0 {
+			rw.contentLength = n
+		} else {
+			rw.header.Del("Content-Length")
+		}
+	}
+}
+
+func (rw *responseWriter) Write(p []byte) (int, error) {
+	if rw.status == 0 {
+		rw.WriteHeader(oohttp.StatusOK)
+	}
+	if !bodyAllowedForStatus(rw.status) {
+		return 0, oohttp.ErrBodyNotAllowed
+	}
+	if rw.req.Method == "HEAD" {
+		return len(p), nil
+	}
+	if rw.contentLength >= 0 && rw.written+int64(len(p)) > rw.contentLength {
+		return 0, oohttp.ErrContentLength
+	}
+	rw.written += int64(len(p))
+	rw.buf = append(rw.buf, p...)
+	if len(rw.buf) >= responseWriterBufferSize {
+		rw.sendHeader()
+		if err := rw.sendData(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (rw *responseWriter) Flush() {
+	rw.FlushError()
+}
+
+// FlushError flushes the buffered data to the client. The
+// oohttp.ResponseController uses this method.
+func (rw *responseWriter) FlushError() error {
+	if rw.status == 0 {
+		rw.WriteHeader(oohttp.StatusOK)
+	}
+	rw.sendHeader()
+	if err := rw.sendData(); err != nil {
+		return err
+	}
+	return rw.st.Flush()
+}
+
+// sendHeader writes the HEADERS frame of the final response, if needed.
+func (rw *responseWriter) sendHeader() {
+	if rw.sentHeader {
+		return
+	}
+	rw.sentHeader = true
+	h := rw.header.Clone()
+	if _, found := h["Content-Type"]; !found && bodyAllowedForStatus(rw.status) &&
+		h.Get("Content-Encoding") == "" && len(rw.buf) > 0 {
+		h.Set("Content-Type", oohttp.DetectContentType(rw.buf))
+	}
+	if _, found := h["Date"]; !found {
+		h.Set("Date", time.Now().UTC().Format(oohttp.TimeFormat))
+	}
+	for _, v := range h["Trailer"] {
+		for _, key := range strings.Split(v, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				rw.trailers = append(rw.trailers, oohttp.CanonicalHeaderKey(key))
+			}
+		}
+	}
+	fields := headerFields(h, func(name string) bool {
+		return strings.HasPrefix(name, strings.ToLower(oohttp.TrailerPrefix))
+	})
+	rw.st.writeFrame(frameTypeHeaders, rw.enc.encodeFields(
+		[]headerField{{":status", strconv.Itoa(rw.status)}}, fields))
+}
+
+// sendData writes the buffered body data as a DATA frame.
+func (rw *responseWriter) sendData() error {
+	if len(rw.buf) <= 0 {
+		return nil
+	}
+	err := rw.st.writeFrame(frameTypeData, rw.buf)
+	rw.buf = rw.buf[:0]
+	return err
+}
+
+// finish completes the response after the handler returns.
+func (rw *responseWriter) finish() error {
+	if rw.status == 0 {
+		rw.WriteHeader(oohttp.StatusOK)
+	}
+	if !rw.sentHeader && rw.contentLength < 0 && bodyAllowedForStatus(rw.status) &&
+		rw.req.Method != "HEAD" && len(rw.header["Trailer"]) == 0 {
+		rw.header.Set("Content-Length", strconv.Itoa(len(rw.buf)))
+	}
+	rw.sendHeader()
+	if err := rw.sendData(); err != nil {
+		return err
+	}
+	if rw.contentLength >= 0 && rw.written < rw.contentLength && rw.req.Method != "HEAD" {
+		return &streamError{errH3InternalError, "response body shorter than Content-Length"}
+	}
+	trailer := make(oohttp.Header)
+	for _, key := range rw.trailers {
+		if values := rw.header[key]; len(values) > 0 {
+			trailer[key] = values
+		}
+	}
+	for key, values := range rw.header {
+		if strings.HasPrefix(key, oohttp.TrailerPrefix) {
+			trailer[oohttp.CanonicalHeaderKey(strings.TrimPrefix(key, oohttp.TrailerPrefix))] = values
+		}
+	}
+	if len(trailer) > 0 {
+		rw.st.writeFrame(frameTypeHeaders, rw.enc.encodeFields(nil, headerFields(trailer, nil)))
+	}
+	return rw.st.Flush()
+}
+
+// bodyAllowedForStatus reports whether a given response status code
+// permits a body. See RFC 9110, Section 6.4.1.
+func bodyAllowedForStatus(status int) bool {
+	switch {
+	case status >= 100 && status <= 199:
+		return false
+	case status == 204:
+		return false
+	case status == 304:
+		return false
+	}
+	return true
+}
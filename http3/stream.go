@@ -0,0 +1,320 @@
+package http3
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// A stream wraps a QUIC stream, providing methods to read/write various values.
+type stream struct {
+	stream QUICStream
+	r      *bufio.Reader
+	w      *bufio.Writer
+
+	// lim is the current read limit.
+	// Reading a frame header sets the limit to the end of the frame.
+	// Reading past the limit or reading less than the limit and ending the frame
+	// results in an error.
+	// -1 indicates no limit.
+	lim int64
+}
+
+// newConnStream creates a new stream on a connection.
+// It writes the stream header for unidirectional streams.
+//
+// The stream returned by newStream is not flushed,
+// and will not be sent to the peer until the caller calls
+// Flush or writes enough data to the stream.
+func newConnStream(ctx context.Context, qconn QUICConn, stype streamType) (*stream, error) {
+	var qs QUICStream
+	var err error
+	if stype == streamTypeRequest {
+		// Request streams are bidirectional.
+		qs, err = qconn.OpenStream(ctx)
+	} else {
+		// All other streams are unidirectional.
+		qs, err = qconn.OpenUniStream(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	st := newStream(qs)
+	if stype != streamTypeRequest {
+		// Unidirectional stream header.
+		st.writeVarint(int64(stype))
+	}
+	return st, nil
+}
+
+func newStream(qs QUICStream) *stream {
+	return &stream{
+		stream: qs,
+		r:      bufio.NewReader(qs),
+		w:      bufio.NewWriter(qs),
+		lim:    -1, // no limit
+	}
+}
+
+// readFrameHeader reads the type and length fields of an HTTP/3 frame.
+// It sets the read limit to the end of the frame.
+//
+// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.1
+func (st *stream) readFrameHeader() (ftype frameType, err error) {
+	if st.lim >= 0 {
+		// We shouldn't call readFrameHeader before ending the previous frame.
+		return 0, errH3FrameError
+	}
+	ftype, err = readVarint[frameType](st)
+	if err != nil {
+		return 0, err
+	}
+	size, err := st.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	st.lim = size
+	return ftype, nil
+}
+
+// endFrame is called after reading a frame to reset the read limit.
+// It returns an error if the entire contents of a frame have not been read.
+func (st *stream) endFrame() error {
+	if st.lim != 0 {
+		return &connectionError{
+			code:    errH3FrameError,
+			message: "invalid HTTP/3 frame",
+		}
+	}
+	st.lim = -1
+	return nil
+}
+
+// ReadByte reads one byte from the stream.
+func (st *stream) ReadByte() (b byte, err error) {
+	if err := st.recordBytesRead(1); err != nil {
+		return 0, err
+	}
+	b, err = st.r.ReadByte()
+	if err != nil {
+		if err == io.EOF && st.lim < 0 {
+			return 0, io.EOF
+		}
+		return 0, errH3FrameError
+	}
+	return b, nil
+}
+
+// Read reads from the stream.
+func (st *stream) Read(b []byte) (int, error) {
+	n, err := st.r.Read(b)
+	if e2 := st.recordBytesRead(n); e2 != nil {
+		return 0, e2
+	}
+	if err == io.EOF {
+		if st.lim == 0 {
+			// EOF at end of frame, ignore.
+			return n, nil
+		} else if st.lim > 0 {
+			// EOF inside frame, error.
+			return 0, errH3FrameError
+		} else {
+			// EOF outside of frame, surface to caller.
+			return n, io.EOF
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// discardUnknownFrame discards an unknown frame.
+//
+// HTTP/3 requires that unknown frames be ignored on all streams.
+// However, a known frame appearing in an unexpected place is a fatal error,
+// so this returns an error if the frame is one we know.
+func (st *stream) discardUnknownFrame(ftype frameType) error {
+	switch ftype {
+	case frameTypeData,
+		frameTypeHeaders,
+		frameTypeCancelPush,
+		frameTypeSettings,
+		frameTypePushPromise,
+		frameTypeGoaway,
+		frameTypeMaxPushID:
+		return &connectionError{
+			code:    errH3FrameUnexpected,
+			message: "unexpected " + ftype.String() + " frame",
+		}
+	}
+	return st.discardFrame()
+}
+
+// discardFrame discards any remaining data in the current frame and resets the read limit.
+func (st *stream) discardFrame() error {
+	if _, err := st.r.Discard(int(st.lim)); err != nil {
+		return &streamError{errH3FrameError, err.Error()}
+	}
+	st.lim = -1
+	return nil
+}
+
+// Write writes to the stream.
+func (st *stream) Write(b []byte) (int, error) { return st.w.Write(b) }
+
+// Flush commits data written to the stream.
+func (st *stream) Flush() error { return st.w.Flush() }
+
+// writeFrame writes a complete frame of the given type.
+func (st *stream) writeFrame(ftype frameType, payload []byte) error {
+	st.writeVarint(int64(ftype))
+	st.writeVarint(int64(len(payload)))
+	_, err := st.Write(payload)
+	return err
+}
+
+// readVarint reads a QUIC variable-length integer from the stream.
+func (st *stream) readVarint() (v int64, err error) {
+	b, err := st.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	v = int64(b & 0x3f)
+	n := 1 << (b >> 6)
+	for i := 1; i < n; i++ {
+		b, err := st.r.ReadByte()
+		if err != nil {
+			return 0, errH3FrameError
+		}
+		v = (v << 8) | int64(b)
+	}
+	if err := st.recordBytesRead(n); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// readVarint reads a varint of a particular type.
+func readVarint[T ~int64 | ~uint64](st *stream) (T, error) {
+	v, err := st.readVarint()
+	return T(v), err
+}
+
+// writeVarint writes a QUIC variable-length integer to the stream.
+func (st *stream) writeVarint(v int64) {
+	switch {
+	case v <= (1<<6)-1:
+		st.w.WriteByte(byte(v))
+	case v <= (1<<14)-1:
+		st.w.WriteByte((1 << 6) | byte(v>>8))
+		st.w.WriteByte(byte(v))
+	case v <= (1<<30)-1:
+		st.w.WriteByte((2 << 6) | byte(v>>24))
+		st.w.WriteByte(byte(v >> 16))
+		st.w.WriteByte(byte(v >> 8))
+		st.w.WriteByte(byte(v))
+	case v <= (1<<62)-1:
+		st.w.WriteByte((3 << 6) | byte(v>>56))
+		st.w.WriteByte(byte(v >> 48))
+		st.w.WriteByte(byte(v >> 40))
+		st.w.WriteByte(byte(v >> 32))
+		st.w.WriteByte(byte(v >> 24))
+		st.w.WriteByte(byte(v >> 16))
+		st.w.WriteByte(byte(v >> 8))
+		st.w.WriteByte(byte(v))
+	default:
+		panic("varint too large")
+	}
+}
+
+// sizeVarint returns the size of the encoding of v as a QUIC
+// variable-length integer.
+func sizeVarint(v int64) int {
+	switch {
+	case v <= (1<<6)-1:
+		return 1
+	case v <= (1<<14)-1:
+		return 2
+	case v <= (1<<30)-1:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// recordBytesRead records that n bytes have been read.
+// It returns an error if the read passes the current limit.
+func (st *stream) recordBytesRead(n int) error {
+	if st.lim < 0 {
+		return nil
+	}
+	st.lim -= int64(n)
+	if st.lim < 0 {
+		return &connectionError{
+			code:    errH3FrameError,
+			message: "invalid HTTP/3 frame",
+		}
+	}
+	return nil
+}
+
+const (
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.4.1
+	settingsMaxFieldSectionSize = 0x06
+
+	// https://www.rfc-editor.org/rfc/rfc9204.html#section-5
+	settingsQPACKMaxTableCapacity = 0x01
+	settingsQPACKBlockedStreams   = 0x07
+)
+
+// writeSettings writes a complete SETTINGS frame.
+// Its parameter is a list of alternating setting types and values.
+func (st *stream) writeSettings(settings ...int64) {
+	var size int64
+	for _, s := range settings {
+		size += int64(sizeVarint(s))
+	}
+	st.writeVarint(int64(frameTypeSettings))
+	st.writeVarint(size)
+	for _, s := range settings {
+		st.writeVarint(s)
+	}
+}
+
+// readSettings reads a complete SETTINGS frame, including the frame header.
+func (st *stream) readSettings(f func(settingType, value int64) error) error {
+	frameType, err := st.readFrameHeader()
+	if err != nil || frameType != frameTypeSettings {
+		return &connectionError{
+			code:    errH3MissingSettings,
+			message: "settings not sent on control stream",
+		}
+	}
+	for st.lim > 0 {
+		settingsType, err := st.readVarint()
+		if err != nil {
+			return err
+		}
+		settingsValue, err := st.readVarint()
+		if err != nil {
+			return err
+		}
+
+		// Use of HTTP/2 settings where there is no corresponding HTTP/3 setting
+		// is an error.
+		// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.4.1-5
+		switch settingsType {
+		case 0x02, 0x03, 0x04, 0x05:
+			return &connectionError{
+				code:    errH3SettingsError,
+				message: "use of reserved setting",
+			}
+		}
+
+		if err := f(settingsType, settingsValue); err != nil {
+			return err
+		}
+	}
+	return st.endFrame()
+}
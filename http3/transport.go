@@ -0,0 +1,419 @@
+package http3
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// Transport is an HTTP/3 RoundTripper for https URLs. It pools the
+// connections by dial address and TLS server name, and it honours the
+// DialAddr and ServerName fields of the oohttp.ConnOverrides set using
+// oohttp.WithConnOverrides. The zero value is ready to use.
+type Transport struct {
+	// TLSClientConfig is the TLS configuration to use. If nil, we use
+	// the default configuration. We always require TLS 1.3 and, unless
+	// TLSClientConfig sets NextProtos, we use the "h3" ALPN.
+	TLSClientConfig *tls.Config
+
+	// Dial, if not nil, establishes QUIC connections to the "host:port"
+	// UDP address, using tlsConfig, whose ServerName is already set. Set
+	// this field to use a QUIC or TLS implementation other than the
+	// golang.org/x/net/quic package, e.g., to parrot the QUIC ClientHello
+	// of a browser.
+	Dial func(ctx context.Context, address string, tlsConfig *tls.Config) (QUICConn, error)
+
+	// DisableCompression, if true, prevents the Transport from requesting
+	// compression with an "Accept-Encoding: gzip" request header when the
+	// Request contains no existing Accept-Encoding value, as documented
+	// for oohttp.Transport.
+	DisableCompression bool
+
+	// ContentDecoders and AcceptEncoding configure the codings we request
+	// and transparently decode, as documented for oohttp.Transport.
+	ContentDecoders oohttp.ContentDecoders
+	AcceptEncoding  string
+
+	mu     sync.Mutex
+	conns  map[string]*clientConn
+	dials  map[string]*dialCall
+	dialer quicDialer
+}
+
+var _ oohttp.RoundTripper = &Transport{}
+
+// ConfigureTransport configures t1 to use HTTP/3 for the https servers
+// advertising it using the Alt-Svc header, by setting its HTTP3 field.
+// The returned Transport uses a clone of the TLSClientConfig of t1 with
+// the "h3" ALPN, and the compression settings of t1. Callers may further configure the HTTP3 field of t1,
+// e.g., to race HTTP/3 against TCP.
+func ConfigureTransport(t1 *oohttp.Transport) (*Transport, error) {
+	if t1.HTTP3 != nil {
+		return nil, errors.New("http3: HTTP/3 is already configured")
+	}
+	t3 := &Transport{
+		DisableCompression: t1.DisableCompression,
+		ContentDecoders:    slices.Clone(t1.ContentDecoders),
+		AcceptEncoding:     t1.AcceptEncoding,
+	}
+	if t1.TLSClientConfig != nil {
+		t3.TLSClientConfig = t1.TLSClientConfig.Clone()
+		t3.TLSClientConfig.NextProtos = nil
+	}
+	t1.HTTP3 = &oohttp.HTTP3{RoundTripper: t3}
+	return t3, nil
+}
+
+// compression configures how we request compressed responses.
+type compression struct {
+	acceptEncoding string
+	decoders       oohttp.ContentDecoders // nil means gzip only
+}
+
+// compression returns the compression configuration of t, or nil
+// if t does not request compression.
+func (t *Transport) compression() *compression {
+	if t.DisableCompression {
+		return nil
+	}
+	c := &compression{acceptEncoding: t.AcceptEncoding, decoders: t.ContentDecoders}
+	if c.acceptEncoding == "" {
+		c.acceptEncoding = "gzip"
+		if len(c.decoders) > 0 {
+			c.acceptEncoding = c.decoders.AcceptEncoding()
+		}
+	}
+	return c
+}
+
+// dialCall is an in-progress dial, which other requests wait for.
+type dialCall struct {
+	done chan struct{}
+	cc   *clientConn
+	err  error
+}
+
+// errClientConnUnusable indicates that a connection cannot send new
+// requests, which is safe to retry using another connection.
+var errClientConnUnusable = errors.New("http3: client connection is unusable")
+
+// RoundTrip implements oohttp.RoundTripper.
+func (t *Transport) RoundTrip(req *oohttp.Request) (*oohttp.Response, error) {
+	if req.URL == nil || req.URL.Scheme != "https" || req.URL.Host == "" {
+		closeRequestBody(req)
+		return nil, errors.New("http3: unsupported request URL")
+	}
+	ctx := req.Context()
+	overrides := oohttp.ConnOverridesFromContext(ctx)
+	if overrides.OmitServerName {
+		closeRequestBody(req)
+		return nil, errors.New("http3: omitting the server name is not supported")
+	}
+	address := overrides.DialAddr
+	if address == "" {
+		address = authorityAddr(req.URL.Host)
+	}
+	tlsConfig := initTLSConfig(t.TLSClientConfig)
+	if overrides.ServerName != "" {
+		tlsConfig.ServerName = overrides.ServerName
+	} else if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = req.URL.Hostname()
+	}
+	key := address + "|" + tlsConfig.ServerName
+	for {
+		cc, err := t.getConn(ctx, key, address, tlsConfig)
+		if err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+		resp, err := cc.roundTrip(req, t.compression())
+		if err == errClientConnUnusable {
+			t.removeConn(key, cc)
+			continue // nothing was sent, so we can retry
+		}
+		return resp, err
+	}
+}
+
+// authorityAddr returns the "host:port" address of authority, using
+// the default https port when authority does not contain a port.
+func authorityAddr(authority string) string {
+	if _, _, err := net.SplitHostPort(authority); err == nil {
+		return authority
+	}
+	host := authority
+	if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+		host = host[1 : len(host)-1]
+	}
+	return net.JoinHostPort(host, "443")
+}
+
+// closeRequestBody closes the body of req, if any.
+func closeRequestBody(req *oohttp.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// getConn returns a usable connection for key, dialing address using
+// tlsConfig when there is none. Concurrent calls share a single dial.
+func (t *Transport) getConn(ctx context.Context, key, address string, tlsConfig *tls.Config) (*clientConn, error) {
+	t.mu.Lock()
+	if cc := t.conns[key]; cc != nil && cc.usable() {
+		t.mu.Unlock()
+		return cc, nil
+	}
+	if call := t.dials[key]; call != nil {
+		t.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.cc, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &dialCall{done: make(chan struct{})}
+	if t.dials == nil {
+		t.dials = make(map[string]*dialCall)
+	}
+	t.dials[key] = call
+	t.mu.Unlock()
+
+	call.cc, call.err = t.dial(ctx, address, tlsConfig)
+	t.mu.Lock()
+	delete(t.dials, key)
+	if call.err == nil {
+		if t.conns == nil {
+			t.conns = make(map[string]*clientConn)
+		}
+		t.conns[key] = call.cc
+	}
+	t.mu.Unlock()
+	close(call.done)
+	return call.cc, call.err
+}
+
+// dial establishes a new connection.
+func (t *Transport) dial(ctx context.Context, address string, tlsConfig *tls.Config) (*clientConn, error) {
+	dial := t.Dial
+	if dial == nil {
+		dial = t.dialer.dial
+	}
+	qconn, err := dial(ctx, address, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := newClientConn(ctx, qconn)
+	if err != nil {
+		qconn.CloseWithError(uint64(errH3InternalError), "")
+		return nil, err
+	}
+	return cc, nil
+}
+
+// removeConn removes cc from the pool, if it is still there.
+func (t *Transport) removeConn(key string, cc *clientConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.conns[key] == cc {
+		delete(t.conns, key)
+	}
+}
+
+// CloseIdleConnections closes the connections not used by any request.
+func (t *Transport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, cc := range t.conns {
+		if cc.closeIfIdle() {
+			delete(t.conns, key)
+		}
+	}
+}
+
+// Close closes all the connections, interrupting the requests in
+// progress, and the QUIC endpoint, if we created one.
+func (t *Transport) Close() error {
+	t.mu.Lock()
+	conns := t.conns
+	t.conns = nil
+	t.mu.Unlock()
+	for _, cc := range conns {
+		cc.close()
+	}
+	t.dialer.close()
+	return nil
+}
+
+// clientConn is a client HTTP/3 connection.
+//
+// Multiple goroutines may invoke methods on a clientConn simultaneously.
+type clientConn struct {
+	qconn QUICConn
+	genericConn
+
+	enc qpackEncoder
+	dec qpackDecoder
+
+	mu       sync.Mutex
+	closed   bool // we received GOAWAY or the connection is closed
+	requests int  // number of requests in progress
+}
+
+func newClientConn(ctx context.Context, qconn QUICConn) (*clientConn, error) {
+	cc := &clientConn{
+		qconn: qconn,
+	}
+	cc.enc.init()
+
+	// Create control stream and send SETTINGS frame.
+	controlStream, err := newConnStream(ctx, cc.qconn, streamTypeControl)
+	if err != nil {
+		return nil, fmt.Errorf("http3: cannot create control stream: %v", err)
+	}
+	controlStream.writeSettings()
+	if err := controlStream.Flush(); err != nil {
+		return nil, fmt.Errorf("http3: cannot create control stream: %v", err)
+	}
+
+	go func() {
+		cc.acceptStreams(qconn, cc)
+		cc.setClosed()
+	}()
+	return cc, nil
+}
+
+// usable returns whether we can send new requests using cc.
+func (cc *clientConn) usable() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return !cc.closed
+}
+
+// setClosed prevents sending new requests using cc.
+func (cc *clientConn) setClosed() {
+	cc.mu.Lock()
+	cc.closed = true
+	cc.mu.Unlock()
+}
+
+// startRequest reserves cc for a new request, unless it is unusable.
+func (cc *clientConn) startRequest() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.closed {
+		return false
+	}
+	cc.requests++
+	return true
+}
+
+// endRequest releases the reservation made by startRequest.
+func (cc *clientConn) endRequest() {
+	cc.mu.Lock()
+	cc.requests--
+	cc.mu.Unlock()
+}
+
+// closeIfIdle closes cc if it has no requests in progress and returns
+// whether it did.
+func (cc *clientConn) closeIfIdle() bool {
+	cc.mu.Lock()
+	idle := cc.requests == 0
+	if idle {
+		cc.closed = true
+	}
+	cc.mu.Unlock()
+	if idle {
+		cc.qconn.CloseWithError(uint64(errH3NoError), "")
+	}
+	return idle
+}
+
+// close closes the connection. Any in-flight requests are canceled.
+func (cc *clientConn) close() {
+	cc.setClosed()
+	cc.qconn.CloseWithError(uint64(errH3NoError), "")
+}
+
+func (cc *clientConn) handleControlStream(st *stream) error {
+	// "A SETTINGS frame MUST be sent as the first frame of each control stream [...]"
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.4-2
+	if err := st.readSettings(func(settingsType, settingsValue int64) error {
+		// We only use the static QPACK table and we do not limit the
+		// size of the field sections we send, so we ignore all settings.
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for {
+		ftype, err := st.readFrameHeader()
+		if err != nil {
+			return err
+		}
+		switch ftype {
+		case frameTypeCancelPush:
+			// "If a CANCEL_PUSH frame is received that references a push ID
+			// greater than currently allowed on the connection,
+			// this MUST be treated as a connection error of type H3_ID_ERROR."
+			// https://www.rfc-editor.org/rfc/rfc9114.html#section-7.2.3-7
+			return &connectionError{
+				code:    errH3IDError,
+				message: "CANCEL_PUSH received when no MAX_PUSH_ID has been sent",
+			}
+		case frameTypeGoaway:
+			// The requests in progress may complete, but we must not
+			// send new requests using this connection.
+			cc.setClosed()
+			if err := st.discardFrame(); err != nil {
+				return err
+			}
+		default:
+			// Unknown frames are ignored.
+			if err := st.discardUnknownFrame(ftype); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (cc *clientConn) handleEncoderStream(st *stream) error {
+	return discardStream(st)
+}
+
+func (cc *clientConn) handleDecoderStream(st *stream) error {
+	return discardStream(st)
+}
+
+func (cc *clientConn) handlePushStream(*stream) error {
+	// "A client MUST treat receipt of a push stream as a connection error
+	// of type H3_ID_ERROR when no MAX_PUSH_ID frame has been sent [...]"
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-4.6-3
+	return &connectionError{
+		code:    errH3IDError,
+		message: "push stream created when no MAX_PUSH_ID has been sent",
+	}
+}
+
+func (cc *clientConn) handleRequestStream(st *stream) error {
+	// "Clients MUST treat receipt of a server-initiated bidirectional
+	// stream as a connection error of type H3_STREAM_CREATION_ERROR [...]"
+	// https://www.rfc-editor.org/rfc/rfc9114.html#section-6.1-3
+	return &connectionError{
+		code:    errH3StreamCreationError,
+		message: "server created bidirectional stream",
+	}
+}
+
+// abort closes the connection with an error.
+func (cc *clientConn) abort(err error) {
+	cc.setClosed()
+	abortConn(cc.qconn, err)
+}
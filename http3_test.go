@@ -0,0 +1,146 @@
+package http_test
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/http3"
+	httptest "github.com/ooni/oohttp/httptest"
+	"github.com/ooni/oohttp/internal/testcert"
+)
+
+// http3RoundTripperFunc is a RoundTripper calling a function.
+type http3RoundTripperFunc func(*Request) (*Response, error)
+
+func (fn http3RoundTripperFunc) RoundTrip(req *Request) (*Response, error) {
+	return fn(req)
+}
+
+func TestHTTP3(t *testing.T) {
+	// The handler advertises the HTTP/3 server and echoes the protocol.
+	cert, err := tls.X509KeyPair(testcert.LocalhostCert, testcert.LocalhostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := http3.ListenQUIC("127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	handler := HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Alt-Svc", `h3=":`+port+`"; ma=3600`)
+		io.WriteString(w, r.Proto)
+	})
+	h3srv := &http3.Server{Handler: handler}
+	go h3srv.Serve(l)
+	defer h3srv.Close()
+	srv := httptest.NewTLSServer(handler)
+	defer srv.Close()
+
+	// newTransport returns a Transport trusting the servers.
+	newTransport := func(t *testing.T) *Transport {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		t.Cleanup(txp.CloseIdleConnections)
+		return txp
+	}
+
+	// get sends a GET request and returns the response protocol.
+	get := func(t *testing.T, txp *Transport) string {
+		t.Helper()
+		req, _ := NewRequest("GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != resp.Proto {
+			t.Fatalf("unexpected body: %q", data)
+		}
+		return resp.Proto
+	}
+
+	t.Run("we switch to HTTP/3 after the server advertises it", func(t *testing.T) {
+		txp := newTransport(t)
+		t3, err := http3.ConfigureTransport(txp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer t3.Close()
+		if proto := get(t, txp); proto == "HTTP/3.0" {
+			t.Fatalf("unexpected protocol: %s", proto)
+		}
+		for i := 0; i < 2; i++ {
+			if proto := get(t, txp); proto != "HTTP/3.0" {
+				t.Fatalf("unexpected protocol: %s", proto)
+			}
+		}
+	})
+
+	t.Run("we race HTTP/3 against TCP", func(t *testing.T) {
+		txp := newTransport(t)
+		t3, err := http3.ConfigureTransport(txp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer t3.Close()
+		txp.HTTP3.Race = true
+		txp.HTTP3.RaceDelay = time.Minute
+		get(t, txp)
+		if proto := get(t, txp); proto != "HTTP/3.0" {
+			t.Fatalf("unexpected protocol: %s", proto)
+		}
+	})
+
+	for _, race := range []bool{false, true} {
+		t.Run("we fall back to TCP when HTTP/3 fails"+map[bool]string{true: " while racing"}[race], func(t *testing.T) {
+			var count int
+			txp := newTransport(t)
+			txp.HTTP3 = &HTTP3{
+				RoundTripper: http3RoundTripperFunc(func(req *Request) (*Response, error) {
+					count++
+					if dialAddr := ConnOverridesFromContext(req.Context()).DialAddr; dialAddr != "127.0.0.1:"+port {
+						t.Errorf("unexpected dial address: %s", dialAddr)
+					}
+					return nil, errors.New("mocked error")
+				}),
+				Race: race,
+			}
+			for i := 0; i < 3; i++ {
+				if proto := get(t, txp); proto == "HTTP/3.0" {
+					t.Fatalf("unexpected protocol: %s", proto)
+				}
+			}
+			// The first request uses TCP, while the other ones fall back
+			// to TCP, whose responses advertise HTTP/3 again.
+			if count != 2 {
+				t.Fatalf("expected two HTTP/3 attempts, got %d", count)
+			}
+		})
+	}
+
+	t.Run("we do not use HTTP/3 with proxies", func(t *testing.T) {
+		txp := newTransport(t)
+		txp.HTTP3 = &HTTP3{
+			RoundTripper: http3RoundTripperFunc(func(req *Request) (*Response, error) {
+				t.Error("unexpected HTTP/3 request")
+				return nil, errors.New("mocked error")
+			}),
+		}
+		get(t, txp)
+		txp.Proxy = func(*Request) (*url.URL, error) { return url.Parse("http://127.0.0.1:1") }
+		req, _ := NewRequest("GET", srv.URL, nil)
+		if _, err := txp.RoundTrip(req); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,236 @@
+package httptest
+
+// This file is an ooni/oohttp extension. It parses the ClientHello
+// to compute the JA3 and JA4 fingerprints.
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// clientHello contains the ClientHello fields used by JA3 and JA4.
+type clientHello struct {
+	version           uint16
+	cipherSuites      []uint16
+	extensions        []uint16
+	curves            []uint16
+	pointFormats      []uint8
+	signatureAlgs     []uint16
+	alpnProtocols     []string
+	supportedVersions []uint16
+}
+
+// errInvalidClientHello indicates that we cannot parse the ClientHello.
+var errInvalidClientHello = errors.New("httptest: invalid ClientHello")
+
+// helloReader reads big-endian values from a byte slice.
+type helloReader struct {
+	data []byte
+	err  error
+}
+
+func (r *helloReader) bytes(n int) []byte {
+	if r.err != nil || n > len(r.data) {
+		r.err = errInvalidClientHello
+		return nil
+	}
+	out := r.data[:n]
+	r.data = r.data[n:]
+	return out
+}
+
+func (r *helloReader) uint8() uint8 {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *helloReader) uint16() uint16 {
+	if b := r.bytes(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+// uint16s reads a vector of uint16 values whose length in bytes
+// is encoded using lengthSize bytes.
+func (r *helloReader) uint16s(lengthSize int) []uint16 {
+	var length int
+	if lengthSize == 1 {
+		length = int(r.uint8())
+	} else {
+		length = int(r.uint16())
+	}
+	vector := &helloReader{data: r.bytes(length)}
+	var out []uint16
+	for len(vector.data) >= 2 {
+		out = append(out, vector.uint16())
+	}
+	return out
+}
+
+// parseClientHello parses the ClientHello handshake message.
+func parseClientHello(msg []byte) (*clientHello, error) {
+	r := &helloReader{data: msg}
+	const typeClientHello = 1
+	if r.uint8() != typeClientHello {
+		return nil, errInvalidClientHello
+	}
+	r.bytes(3) // length
+	ch := &clientHello{version: r.uint16()}
+	r.bytes(32)             // random
+	r.bytes(int(r.uint8())) // session_id
+	ch.cipherSuites = r.uint16s(2)
+	r.bytes(int(r.uint8())) // compression_methods
+	if r.err == nil && len(r.data) > 0 {
+		exts := &helloReader{data: r.bytes(int(r.uint16()))}
+		for exts.err == nil && len(exts.data) > 0 {
+			extType := exts.uint16()
+			ext := &helloReader{data: exts.bytes(int(exts.uint16()))}
+			ch.extensions = append(ch.extensions, extType)
+			switch extType {
+			case 10: // supported_groups
+				ch.curves = ext.uint16s(2)
+			case 11: // ec_point_formats
+				ch.pointFormats = ext.bytes(int(ext.uint8()))
+			case 13: // signature_algorithms
+				ch.signatureAlgs = ext.uint16s(2)
+			case 16: // application_layer_protocol_negotiation
+				list := &helloReader{data: ext.bytes(int(ext.uint16()))}
+				for list.err == nil && len(list.data) > 0 {
+					ch.alpnProtocols = append(ch.alpnProtocols, string(list.bytes(int(list.uint8()))))
+				}
+			case 43: // supported_versions
+				ch.supportedVersions = ext.uint16s(1)
+			}
+		}
+		if exts.err != nil {
+			r.err = exts.err
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return ch, nil
+}
+
+// isGREASE returns whether v is a GREASE value (RFC 8701).
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+// withoutGREASE returns a copy of values without GREASE values.
+func withoutGREASE(values []uint16) []uint16 {
+	out := []uint16{}
+	for _, v := range values {
+		if !isGREASE(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// joinUint16s joins values using the given format and separator.
+func joinUint16s(values []uint16, format, sep string) string {
+	var out []string
+	for _, v := range values {
+		out = append(out, fmt.Sprintf(format, v))
+	}
+	return strings.Join(out, sep)
+}
+
+// ja3 returns the JA3 string, i.e., "SSLVersion,Ciphers,Extensions,
+// EllipticCurves,EllipticCurvePointFormats" without GREASE values.
+func (ch *clientHello) ja3() string {
+	var formats []string
+	for _, v := range ch.pointFormats {
+		formats = append(formats, strconv.Itoa(int(v)))
+	}
+	return strings.Join([]string{
+		strconv.Itoa(int(ch.version)),
+		joinUint16s(withoutGREASE(ch.cipherSuites), "%d", "-"),
+		joinUint16s(withoutGREASE(ch.extensions), "%d", "-"),
+		joinUint16s(withoutGREASE(ch.curves), "%d", "-"),
+		strings.Join(formats, "-"),
+	}, ",")
+}
+
+// ja3Digest returns the MD5 digest of the JA3 string in hex format.
+func (ch *clientHello) ja3Digest() string {
+	sum := md5.Sum([]byte(ch.ja3()))
+	return hex.EncodeToString(sum[:])
+}
+
+// ja4 returns the JA4 fingerprint (i.e., JA4_a, JA4_b and JA4_c).
+func (ch *clientHello) ja4() string {
+	version := ch.version
+	if versions := withoutGREASE(ch.supportedVersions); len(versions) > 0 {
+		version = slices.Max(versions)
+	}
+	versionString := map[uint16]string{
+		0x0304: "13", 0x0303: "12", 0x0302: "11", 0x0301: "10", 0x0300: "s3",
+	}[version]
+	if versionString == "" {
+		versionString = "00"
+	}
+
+	ciphers := withoutGREASE(ch.cipherSuites)
+	extensions := withoutGREASE(ch.extensions)
+	sni := "i"
+	for _, ext := range extensions {
+		if ext == 0 {
+			sni = "d"
+		}
+	}
+	alpn := "00"
+	if len(ch.alpnProtocols) > 0 && ch.alpnProtocols[0] != "" {
+		first := ch.alpnProtocols[0]
+		alpn = first[:1] + first[len(first)-1:]
+		if !isAlphanumeric(first[0]) || !isAlphanumeric(first[len(first)-1]) {
+			alpn = hex.EncodeToString([]byte{first[0]})[:1] + hex.EncodeToString([]byte{first[len(first)-1]})[1:]
+		}
+	}
+	ja4a := fmt.Sprintf("t%s%s%02d%02d%s", versionString, sni, min(len(ciphers), 99), min(len(extensions), 99), alpn)
+
+	sort.Slice(ciphers, func(i, j int) bool { return ciphers[i] < ciphers[j] })
+	ja4b := ja4Hash(joinUint16s(ciphers, "%04x", ","))
+
+	var sortedExts []uint16
+	for _, ext := range extensions {
+		if ext != 0 && ext != 16 { // server_name and ALPN
+			sortedExts = append(sortedExts, ext)
+		}
+	}
+	sort.Slice(sortedExts, func(i, j int) bool { return sortedExts[i] < sortedExts[j] })
+	ja4c := joinUint16s(sortedExts, "%04x", ",")
+	if algs := withoutGREASE(ch.signatureAlgs); len(algs) > 0 {
+		ja4c += "_" + joinUint16s(algs, "%04x", ",")
+	}
+	if len(sortedExts) <= 0 {
+		ja4c = ""
+	}
+	return ja4a + "_" + ja4b + "_" + ja4Hash(ja4c)
+}
+
+// ja4Hash returns the truncated SHA256 used by JA4.
+func ja4Hash(s string) string {
+	if s == "" {
+		return "000000000000"
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// isAlphanumeric returns whether c is an ASCII letter or digit.
+func isAlphanumeric(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
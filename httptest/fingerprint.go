@@ -0,0 +1,502 @@
+package httptest
+
+// This file is an ooni/oohttp extension. It implements a test server
+// reporting the TLS and HTTP fingerprints of the requests it receives.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	http "github.com/ooni/oohttp"
+	"golang.org/x/net/http2/hpack"
+)
+
+// Fingerprint contains the fingerprints of a request received
+// by a [FingerprintServer].
+type Fingerprint struct {
+	// Proto is the protocol version of the request (e.g., "HTTP/2.0").
+	Proto string
+
+	// Method is the request method.
+	Method string
+
+	// RequestURI is the request target as sent by the client.
+	RequestURI string
+
+	// ClientHello contains the raw ClientHello handshake message
+	// sent by the client when establishing the connection.
+	ClientHello []byte
+
+	// JA3 is the JA3 string of the ClientHello.
+	JA3 string
+
+	// JA3Digest is the MD5 digest of JA3 in hex format.
+	JA3Digest string
+
+	// JA4 is the JA4 fingerprint of the ClientHello.
+	JA4 string
+
+	// HTTP2 contains the HTTP/2 fingerprint of the connection,
+	// as observed from the frames the client sent before the first
+	// HEADERS frame and from the first HEADERS frame. Use its String
+	// method to obtain the Akamai representation. This field is nil
+	// for HTTP/1.x requests.
+	HTTP2 *http.HTTP2Fingerprint
+
+	// HeaderOrder contains the names of the request header fields
+	// in the order used by the client, excluding pseudo-header
+	// fields. The names are as they appeared on the wire.
+	HeaderOrder []string
+}
+
+// A FingerprintServer is a TLS [Server] supporting HTTP/1.1 and HTTP/2
+// that records the [Fingerprint] of each request it receives. This
+// allows checking offline the fingerprint of a Transport configuration.
+type FingerprintServer struct {
+	*Server
+
+	mu           sync.Mutex
+	fingerprints []*Fingerprint
+}
+
+// NewFingerprintServer starts and returns a new [FingerprintServer].
+// The caller should call Close when finished, to shut it down.
+//
+// If handler is nil, the server responds to each request with the
+// JSON serialization of the request's [Fingerprint]. Otherwise, handler
+// may use [RequestFingerprint] to obtain the request's [Fingerprint].
+func NewFingerprintServer(handler http.Handler) *FingerprintServer {
+	if handler == nil {
+		handler = http.HandlerFunc(serveFingerprint)
+	}
+	fs := &FingerprintServer{}
+	fs.Server = NewUnstartedServer(fs.wrapHandler(handler))
+	fs.Server.EnableHTTP2 = true
+	fs.Server.TLS = &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+	fs.Server.Config.TLSServerFactory = newFingerprintConn
+	fs.Server.Config.ConnContext = func(ctx context.Context, c net.Conn) context.Context {
+		if fc, ok := c.(*fingerprintConn); ok {
+			ctx = context.WithValue(ctx, fingerprintConnKey{}, fc)
+		}
+		return ctx
+	}
+	fs.Server.StartTLS()
+	return fs
+}
+
+// Fingerprints returns the fingerprints of the requests received
+// so far, in the order in which the server received them.
+func (fs *FingerprintServer) Fingerprints() []*Fingerprint {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return append([]*Fingerprint{}, fs.fingerprints...)
+}
+
+// Reset forgets the fingerprints of the requests received so far.
+func (fs *FingerprintServer) Reset() {
+	fs.mu.Lock()
+	fs.fingerprints = nil
+	fs.mu.Unlock()
+}
+
+type fingerprintKey struct{}
+
+type fingerprintConnKey struct{}
+
+// RequestFingerprint returns the [Fingerprint] of a request received
+// by a [FingerprintServer], or nil if r does not have a fingerprint.
+func RequestFingerprint(r *http.Request) *Fingerprint {
+	fp, _ := r.Context().Value(fingerprintKey{}).(*Fingerprint)
+	return fp
+}
+
+// serveFingerprint is the default handler of a FingerprintServer.
+func serveFingerprint(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(RequestFingerprint(r))
+}
+
+// wrapHandler returns a handler that computes the request
+// fingerprint before invoking handler.
+func (fs *FingerprintServer) wrapHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fp := &Fingerprint{
+			Proto:      r.Proto,
+			Method:     r.Method,
+			RequestURI: r.RequestURI,
+		}
+		if fc, ok := r.Context().Value(fingerprintConnKey{}).(*fingerprintConn); ok {
+			fc.fill(fp, r)
+		}
+		fs.mu.Lock()
+		fs.fingerprints = append(fs.fingerprints, fp)
+		fs.mu.Unlock()
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), fingerprintKey{}, fp)))
+	})
+}
+
+// fingerprintConn is the TLSConn created by the TLSServerFactory
+// of a FingerprintServer. It records the raw ClientHello and the
+// plaintext bytes sent by the client.
+type fingerprintConn struct {
+	*tls.Conn
+	hello *helloRecorder
+
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// data contains the plaintext bytes we have not parsed yet.
+	data []byte
+
+	// h1Pending indicates that data starts with the HTTP/1.x
+	// request whose fingerprint we have already computed.
+	h1Pending bool
+
+	// h2 is the lazily created HTTP/2 frames parser.
+	h2 *h2FingerprintParser
+}
+
+// newFingerprintConn is the TLSServerFactory of a FingerprintServer.
+func newFingerprintConn(conn net.Conn, config *tls.Config) http.TLSConn {
+	hello := &helloRecorder{Conn: conn}
+	return &fingerprintConn{Conn: tls.Server(hello, config), hello: hello}
+}
+
+// Read implements net.Conn.
+func (c *fingerprintConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.mu.Lock()
+	c.data = append(c.data, p[:n]...)
+	c.mu.Unlock()
+	return n, err
+}
+
+// fill fills the fields of fp depending on the connection.
+func (c *fingerprintConn) fill(fp *Fingerprint, r *http.Request) {
+	if raw := c.hello.clientHello(); raw != nil {
+		fp.ClientHello = raw
+		if ch, err := parseClientHello(raw); err == nil {
+			fp.JA3 = ch.ja3()
+			fp.JA3Digest = ch.ja3Digest()
+			fp.JA4 = ch.ja4()
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if r.ProtoMajor == 2 {
+		if c.h2 == nil {
+			c.h2 = newH2FingerprintParser()
+		}
+		c.data = c.h2.parse(c.data)
+		fp.HTTP2 = c.h2.fingerprint()
+		fp.HeaderOrder = c.h2.headerOrder(r)
+		return
+	}
+	fp.HeaderOrder = c.h1HeaderOrder()
+}
+
+// h1HeaderOrder returns the header order of the current HTTP/1.x
+// request. Because HTTP/1.x requests on a connection are sequential,
+// the current request follows the previous one, if any, whose body
+// has been consumed by the time we handle the current request.
+func (c *fingerprintConn) h1HeaderOrder() []string {
+	if c.h1Pending {
+		r := bytes.NewReader(c.data)
+		br := bufio.NewReader(r)
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			return nil
+		}
+		if _, err := io.Copy(io.Discard, req.Body); err != nil {
+			return nil
+		}
+		consumed := len(c.data) - r.Len() - br.Buffered()
+		c.data = c.data[consumed:]
+	}
+	end := bytes.Index(c.data, []byte("\r\n\r\n"))
+	if end < 0 {
+		return nil
+	}
+	c.h1Pending = true
+	lines := strings.Split(string(c.data[:end]), "\r\n")
+	var order []string
+	for _, line := range lines[1:] {
+		if name, _, found := strings.Cut(line, ":"); found {
+			order = append(order, name)
+		}
+	}
+	return order
+}
+
+// helloRecorder is a net.Conn recording the first TLS
+// handshake message, i.e., the ClientHello.
+type helloRecorder struct {
+	net.Conn
+
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// records contains the raw bytes read so far.
+	records []byte
+
+	// hello is the ClientHello once complete.
+	hello []byte
+
+	// done indicates we stopped recording.
+	done bool
+}
+
+// Read implements net.Conn.
+func (hr *helloRecorder) Read(p []byte) (int, error) {
+	n, err := hr.Conn.Read(p)
+	hr.mu.Lock()
+	if !hr.done {
+		hr.records = append(hr.records, p[:n]...)
+		hr.hello, hr.done = extractClientHello(hr.records)
+		if hr.done {
+			hr.records = nil
+		}
+	}
+	hr.mu.Unlock()
+	return n, err
+}
+
+// clientHello returns the ClientHello or nil.
+func (hr *helloRecorder) clientHello() []byte {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	return hr.hello
+}
+
+// extractClientHello extracts the first handshake message from the given
+// TLS records. It returns whether we should stop recording, which happens
+// when we have the message or when the records are not handshake records.
+func extractClientHello(records []byte) ([]byte, bool) {
+	var msg []byte
+	for len(records) >= 5 {
+		const recordTypeHandshake = 22
+		if records[0] != recordTypeHandshake {
+			return nil, true
+		}
+		length := int(binary.BigEndian.Uint16(records[3:5]))
+		if len(records) < 5+length {
+			break
+		}
+		msg = append(msg, records[5:5+length]...)
+		records = records[5+length:]
+		if len(msg) >= 4 {
+			msgLength := 4 + (int(msg[1])<<16 | int(msg[2])<<8 | int(msg[3]))
+			if len(msg) >= msgLength {
+				return msg[:msgLength], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// h2FingerprintParser parses the HTTP/2 frames sent by the client.
+type h2FingerprintParser struct {
+	prefaceDone bool
+	decoder     *hpack.Decoder
+	fp          *http.HTTP2Fingerprint
+	fpDone      bool
+
+	// blockStream and block contain the HEADERS block we are reading.
+	blockStream uint32
+	block       []byte
+
+	// streams contains the header blocks we have not consumed yet.
+	streams []*h2FingerprintStream
+}
+
+// h2FingerprintStream contains the headers of a stream.
+type h2FingerprintStream struct {
+	method    string
+	authority string
+	path      string
+	order     []string
+}
+
+func newH2FingerprintParser() *h2FingerprintParser {
+	return &h2FingerprintParser{
+		decoder: hpack.NewDecoder(4096, nil),
+		fp:      &http.HTTP2Fingerprint{},
+	}
+}
+
+// HTTP/2 frame types and flags used by the h2FingerprintParser.
+const (
+	h2FrameHeaders      = 0x1
+	h2FramePriority     = 0x2
+	h2FrameSettings     = 0x4
+	h2FrameWindowUpdate = 0x8
+	h2FrameContinuation = 0x9
+
+	h2FlagAck        = 0x1
+	h2FlagEndHeaders = 0x4
+	h2FlagPadded     = 0x8
+	h2FlagPriority   = 0x20
+)
+
+// parse parses the complete frames in data and returns the remaining bytes.
+func (p *h2FingerprintParser) parse(data []byte) []byte {
+	if !p.prefaceDone {
+		if len(data) < len(h2ClientPreface) {
+			return data
+		}
+		data = data[len(h2ClientPreface):]
+		p.prefaceDone = true
+	}
+	for len(data) >= 9 {
+		length := int(data[0])<<16 | int(data[1])<<8 | int(data[2])
+		if len(data) < 9+length {
+			break
+		}
+		ftype, flags := data[3], data[4]
+		streamID := binary.BigEndian.Uint32(data[5:9]) & (1<<31 - 1)
+		p.parseFrame(ftype, flags, streamID, data[9:9+length])
+		data = data[9+length:]
+	}
+	return data
+}
+
+// h2ClientPreface is the HTTP/2 client connection preface.
+const h2ClientPreface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// parseFrame parses a single frame.
+func (p *h2FingerprintParser) parseFrame(ftype, flags uint8, streamID uint32, payload []byte) {
+	switch ftype {
+	case h2FrameSettings:
+		if flags&h2FlagAck != 0 || p.fpDone || p.fp.Settings != nil {
+			return
+		}
+		p.fp.Settings = []http.HTTP2Setting{}
+		for ; len(payload) >= 6; payload = payload[6:] {
+			p.fp.Settings = append(p.fp.Settings, http.HTTP2Setting{
+				ID:  http.HTTP2SettingID(binary.BigEndian.Uint16(payload)),
+				Val: binary.BigEndian.Uint32(payload[2:]),
+			})
+		}
+
+	case h2FrameWindowUpdate:
+		if streamID == 0 && !p.fpDone && len(payload) >= 4 {
+			p.fp.ConnectionFlow = binary.BigEndian.Uint32(payload) & (1<<31 - 1)
+		}
+
+	case h2FramePriority:
+		if !p.fpDone && len(payload) >= 5 {
+			p.fp.Priorities = append(p.fp.Priorities, http.HTTP2PriorityFrame{
+				StreamID:           streamID,
+				HTTP2PriorityParam: parseH2PriorityParam(payload),
+			})
+		}
+
+	case h2FrameHeaders:
+		if flags&h2FlagPadded != 0 {
+			if len(payload) < 1 || int(payload[0]) > len(payload)-1 {
+				return
+			}
+			payload = payload[1 : len(payload)-int(payload[0])]
+		}
+		if flags&h2FlagPriority != 0 {
+			if len(payload) < 5 {
+				return
+			}
+			if !p.fpDone {
+				p.fp.HeadersPriority = parseH2PriorityParam(payload)
+			}
+			payload = payload[5:]
+		}
+		p.blockStream = streamID
+		p.block = append([]byte{}, payload...)
+		if flags&h2FlagEndHeaders != 0 {
+			p.endHeaders()
+		}
+
+	case h2FrameContinuation:
+		if streamID != p.blockStream {
+			return
+		}
+		p.block = append(p.block, payload...)
+		if flags&h2FlagEndHeaders != 0 {
+			p.endHeaders()
+		}
+	}
+}
+
+// parseH2PriorityParam parses the priority fields of
+// PRIORITY and HEADERS frames.
+func parseH2PriorityParam(payload []byte) http.HTTP2PriorityParam {
+	dep := binary.BigEndian.Uint32(payload)
+	return http.HTTP2PriorityParam{
+		StreamDep: dep & (1<<31 - 1),
+		Exclusive: dep&(1<<31) != 0,
+		Weight:    payload[4],
+	}
+}
+
+// endHeaders decodes the current header block.
+func (p *h2FingerprintParser) endHeaders() {
+	fields, err := p.decoder.DecodeFull(p.block)
+	p.block = nil
+	if err != nil {
+		return
+	}
+	stream := &h2FingerprintStream{}
+	var pseudo []string
+	for _, field := range fields {
+		switch {
+		case field.Name == ":method":
+			stream.method = field.Value
+		case field.Name == ":authority":
+			stream.authority = field.Value
+		case field.Name == ":path":
+			stream.path = field.Value
+		}
+		if field.IsPseudo() {
+			pseudo = append(pseudo, field.Name)
+			continue
+		}
+		stream.order = append(stream.order, field.Name)
+	}
+	if stream.method == "" {
+		return // e.g., trailers
+	}
+	if !p.fpDone {
+		p.fp.PseudoHeaderOrder = pseudo
+		p.fpDone = true
+	}
+	p.streams = append(p.streams, stream)
+}
+
+// fingerprint returns a copy of the fingerprint or nil if we
+// have not seen the first HEADERS frame yet.
+func (p *h2FingerprintParser) fingerprint() *http.HTTP2Fingerprint {
+	if !p.fpDone {
+		return nil
+	}
+	fp := *p.fp
+	return &fp
+}
+
+// headerOrder returns the header order of the first unconsumed stream
+// matching r. Because HTTP/2 handlers run concurrently, we cannot rely
+// on the order of streams and we match on the pseudo-header fields.
+func (p *h2FingerprintParser) headerOrder(r *http.Request) []string {
+	for idx, stream := range p.streams {
+		if stream.method == r.Method && stream.path == r.RequestURI &&
+			(stream.authority == "" || stream.authority == r.Host) {
+			p.streams = append(p.streams[:idx], p.streams[idx+1:]...)
+			return stream.order
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package httptest
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	http "github.com/ooni/oohttp"
+)
+
+// buildClientHello returns a ClientHello handshake message
+// containing the given cipher suites and extensions.
+func buildClientHello(ciphers []uint16, extensions [][]byte) []byte {
+	body := []byte{0x03, 0x03}               // legacy_version
+	body = append(body, make([]byte, 32)...) // random
+	body = append(body, 0)                   // session_id
+	body = binary.BigEndian.AppendUint16(body, uint16(2*len(ciphers)))
+	for _, c := range ciphers {
+		body = binary.BigEndian.AppendUint16(body, c)
+	}
+	body = append(body, 1, 0) // compression_methods
+	var exts []byte
+	for _, ext := range extensions {
+		exts = append(exts, ext...)
+	}
+	body = binary.BigEndian.AppendUint16(body, uint16(len(exts)))
+	body = append(body, exts...)
+	return append([]byte{1, 0, byte(len(body) >> 8), byte(len(body))}, body...)
+}
+
+// buildExtension returns an extension with the given type and data.
+func buildExtension(extType uint16, data ...byte) []byte {
+	ext := binary.BigEndian.AppendUint16(nil, extType)
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(data)))
+	return append(ext, data...)
+}
+
+func TestClientHelloFingerprints(t *testing.T) {
+	msg := buildClientHello([]uint16{0x0a0a, 0x1301, 0xc02f, 0x002f}, [][]byte{
+		buildExtension(0x1a1a),
+		buildExtension(0, 0, 14, 0, 0, 11, 'e', 'x', 'a', 'm', 'p', 'l', 'e', '.', 'c', 'o', 'm'),
+		buildExtension(10, 0, 6, 0x2a, 0x2a, 0, 29, 0, 23),
+		buildExtension(11, 1, 0),
+		buildExtension(13, 0, 4, 0x04, 0x03, 0x08, 0x04),
+		buildExtension(16, 0, 12, 2, 'h', '2', 8, 'h', 't', 't', 'p', '/', '1', '.', '1'),
+		buildExtension(43, 6, 0x3a, 0x3a, 0x03, 0x04, 0x03, 0x03),
+	})
+	ch, err := parseClientHello(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("JA3", func(t *testing.T) {
+		const want = "771,4865-49199-47,0-10-11-13-16-43,29-23,0"
+		if got := ch.ja3(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("JA4", func(t *testing.T) {
+		hash := func(s string) string {
+			sum := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(sum[:])[:12]
+		}
+		want := "t13d0306h2_" + hash("002f,1301,c02f") + "_" + hash("000a,000b,000d,002b_0403,0804")
+		if got := ch.ja4(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("we reject truncated messages", func(t *testing.T) {
+		if _, err := parseClientHello(msg[:50]); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestFingerprintServer(t *testing.T) {
+	fs := NewFingerprintServer(nil)
+	defer fs.Close()
+
+	t.Run("with HTTP/2", func(t *testing.T) {
+		fs.Reset()
+		const akamai = "1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p"
+		h2fp, err := http.ParseHTTP2Fingerprint(akamai)
+		if err != nil {
+			t.Fatal(err)
+		}
+		txp := fs.Client().Transport.(*http.Transport)
+		txp.HTTP2Fingerprint = h2fp
+		defer func() { txp.HTTP2Fingerprint = nil }()
+		defer txp.CloseIdleConnections()
+		req, _ := http.NewRequest("GET", fs.URL+"/h2", nil)
+		req.Header.Set("X-A", "a")
+		req.Header.Set("X-B", "b")
+		req.Header[http.HeaderOrderKey] = []string{"x-b", "x-a"}
+		resp, err := fs.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var fp Fingerprint
+		if err := json.NewDecoder(resp.Body).Decode(&fp); err != nil {
+			t.Fatal(err)
+		}
+		if fp.Proto != "HTTP/2.0" || fp.RequestURI != "/h2" {
+			t.Fatalf("unexpected request: %+v", fp)
+		}
+		if fp.HTTP2 == nil || fp.HTTP2.String() != akamai {
+			t.Fatalf("unexpected HTTP/2 fingerprint: %v", fp.HTTP2)
+		}
+		if len(fp.HeaderOrder) < 2 || !reflect.DeepEqual(fp.HeaderOrder[:2], []string{"x-b", "x-a"}) {
+			t.Fatalf("unexpected header order: %v", fp.HeaderOrder)
+		}
+		if len(fp.ClientHello) <= 0 || !strings.HasPrefix(fp.JA3, "771,") || !strings.HasPrefix(fp.JA4, "t13") {
+			t.Fatalf("unexpected TLS fingerprint: %+v", fp)
+		}
+		if fps := fs.Fingerprints(); len(fps) != 1 || fps[0].JA3Digest != fp.JA3Digest {
+			t.Fatalf("unexpected fingerprints: %v", fps)
+		}
+	})
+
+	t.Run("with HTTP/1.1", func(t *testing.T) {
+		fs.Reset()
+		certpool := x509.NewCertPool()
+		certpool.AddCert(fs.Certificate())
+		txp := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certpool}}
+		defer txp.CloseIdleConnections()
+		client := &http.Client{Transport: txp}
+		for _, order := range [][]string{{"User-Agent", "X-B", "X-A"}, {"X-A", "user-agent", "X-B"}} {
+			req, _ := http.NewRequest("POST", fs.URL+"/h1", strings.NewReader("body"))
+			req.Header.Set("X-A", "a")
+			req.Header.Set("X-B", "b")
+			req.Header[http.HeaderOrderKey] = order
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		fps := fs.Fingerprints()
+		if len(fps) != 2 {
+			t.Fatalf("expected two fingerprints, got %d", len(fps))
+		}
+		for idx, want := range [][]string{{"User-Agent", "X-B", "X-A"}, {"X-A", "user-agent", "X-B"}} {
+			fp := fps[idx]
+			if fp.Proto != "HTTP/1.1" || fp.HTTP2 != nil {
+				t.Fatalf("unexpected request: %+v", fp)
+			}
+			if len(fp.HeaderOrder) < 3 || !reflect.DeepEqual(fp.HeaderOrder[:3], want) {
+				t.Fatalf("request %d: unexpected header order: %v", idx, fp.HeaderOrder)
+			}
+		}
+		if fps[0].JA3 == "" || fps[0].JA3 != fps[1].JA3 {
+			t.Fatal("expected the same JA3 for requests on the same connection")
+		}
+	})
+}
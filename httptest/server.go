@@ -180,7 +180,7 @@ func (s *Server) StartTLS() {
 		},
 		ForceAttemptHTTP2: s.EnableHTTP2,
 	}
-	s.Listener = tls.NewListener(s.Listener, s.TLS)
+	s.Listener = http.NewTLSListener(s.Listener, s.TLS, s.Config.TLSServerFactory)
 	s.URL = "https://" + s.Listener.Addr().String()
 	s.wrap()
 	s.goServe()
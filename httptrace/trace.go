@@ -135,6 +135,27 @@ type ClientTrace struct {
 	// request and any body. It may be called multiple times
 	// in the case of retried requests.
 	WroteRequest func(WroteRequestInfo)
+
+	// AltSvcStart is an ooni/oohttp extension. It is called when the
+	// Transport sends the request to an alternative service (RFC 7838)
+	// of the origin, which it learned from an Alt-Svc header.
+	AltSvcStart func(AltSvcInfo)
+
+	// AltSvcDone is an ooni/oohttp extension. It is called when the
+	// alternative service returns the response headers or fails. On
+	// failure, the Transport forgets the alternative and, if possible,
+	// retries the request using the origin.
+	AltSvcDone func(AltSvcInfo, error)
+
+	// ProxyHopStart is an ooni/oohttp extension. It is called when the
+	// Transport asks a proxy, using SOCKS or an HTTP/1.1 CONNECT request,
+	// to connect to the server or, when using the chain of proxies
+	// returned by its ProxyChain function, to the next proxy.
+	ProxyHopStart func(ProxyHopInfo)
+
+	// ProxyHopDone is an ooni/oohttp extension. It is called when the
+	// proxy connected to the server or to the next proxy, or failed to.
+	ProxyHopDone func(ProxyHopInfo, error)
 }
 
 // WroteRequestInfo contains information provided to the WroteRequest
@@ -200,6 +221,26 @@ type DNSDoneInfo struct {
 	Coalesced bool
 }
 
+// AltSvcInfo is an ooni/oohttp extension. It contains information about
+// the alternative service used for a request.
+type AltSvcInfo struct {
+	// Protocol is the ALPN protocol ID of the alternative, e.g., "h3".
+	Protocol string
+
+	// Addr is the "host:port" address of the alternative.
+	Addr string
+}
+
+// ProxyHopInfo is an ooni/oohttp extension. It contains information
+// about a proxy asked to connect to the server or to the next proxy.
+type ProxyHopInfo struct {
+	// Proxy is the URL of the proxy, without its password.
+	Proxy string
+
+	// Addr is the "host:port" address of the server or of the next proxy.
+	Addr string
+}
+
 func (t *ClientTrace) hasNetHooks() bool {
 	if t == nil {
 		return false
@@ -0,0 +1,71 @@
+package brotli
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+// tests holds some simple test cases, compressed using the encoder of
+// github.com/andybalholm/brotli with quality 11.
+var tests = []struct {
+	name, uncompressed, compressed string
+}{
+	{
+		"hello",
+		"hello, world\n",
+		"\x0b\x06\x80\x68\x65\x6c\x6c\x6f\x2c\x20\x77\x6f\x72\x6c\x64\x0a\x03",
+	},
+	{
+		"hello-1000x",
+		strings.Repeat("hello, world\n", 1000),
+		"\x1b\xc7\x32\xf8\x8d\x94\x6e\xde\x44\x09\x52\x86\xd6\x0a\x2c\x6c" +
+			"\x6f\x6a\x96\xc7\xaa\x01\x0d\xbc\x5a\xd7\xa9\x00\x00",
+	},
+}
+
+func TestSamples(t *testing.T) {
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(test.compressed))
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.uncompressed {
+				t.Errorf("got %q want %q", got, test.uncompressed)
+			}
+		})
+	}
+}
+
+func TestReset(t *testing.T) {
+	r := NewReader(nil)
+	for _, test := range tests {
+		r.Reset(strings.NewReader(test.compressed))
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if string(got) != test.uncompressed {
+			t.Errorf("%s: got %q want %q", test.name, got, test.uncompressed)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, test := range tests {
+		for i := 0; i < len(test.compressed)-1; i++ {
+			r := NewReader(strings.NewReader(test.compressed[:i]))
+			if _, err := io.ReadAll(r); err == nil {
+				t.Errorf("%s: no error for input truncated to %d bytes", test.name, i)
+			}
+		}
+	}
+	r := NewReader(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}))
+	if _, err := io.ReadAll(r); err == nil {
+		t.Error("no error for invalid input")
+	}
+}
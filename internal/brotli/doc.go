@@ -0,0 +1,6 @@
+// Package brotli provides a decompressor for brotli streams, described
+// in RFC 7932. It contains the decoder of github.com/andybalholm/brotli
+// v1.1.1, without the encoder, and it is licensed under the terms of the
+// MIT license in the LICENSE file. Unlike the original, the Reader fails
+// with io.ErrUnexpectedEOF when the stream is truncated.
+package brotli
@@ -7,7 +7,9 @@
 package http
 
 import (
+	"context"
 	"errors"
+	"net"
 	"sync"
 	"time"
 )
@@ -47,6 +49,8 @@ type http2clientConnPool struct {
 
 type http2clientConn struct{}
 
+type http2ClientConn struct{}
+
 type http2clientConnIdleState struct {
 	canTakeNewRequest bool
 }
@@ -77,3 +81,37 @@ type http2noCachedConnError struct{}
 func (http2noCachedConnError) IsHTTP2NoCachedConnError() {}
 
 func (http2noCachedConnError) Error() string { return "http2: no cached connection was available" }
+
+// The ooni/oohttp extensions depending on the bundled HTTP/2 implementation.
+// The Transport and the Server never call most of these functions because
+// useH2C and useHTTP2Proxy are false when omitBundledHTTP2 is true.
+
+func (t *Transport) upgradeH2Partition(*connectMethod, string, TLSConn) (RoundTripper, bool) {
+	return nil, false
+}
+
+func (t *Transport) http2ProxyTunnel(context.Context, *connectMethod) (net.Conn, error) {
+	return nil, nil
+}
+
+func (t *Transport) upgradeHTTP2Proxy(context.Context, *connectMethod, *persistConn) (net.Conn, error) {
+	return nil, nil
+}
+
+func (t *Transport) closeIdleHTTP2ProxyConns() {}
+
+func (t *Transport) closeIdleH2CConns() {}
+
+func (t *Transport) dialH2CPriorKnowledge(*connectMethod, *persistConn) (RoundTripper, error) {
+	return nil, nil
+}
+
+func (t *Transport) roundTripCachedH2C(*Request, *connectMethod) (*Response, error) {
+	return nil, http2ErrNoCachedConn
+}
+
+func (t *Transport) upgradeH2C(*transportRequest, *connectMethod, *persistConn) (*Response, error) {
+	panic(noHTTP2)
+}
+
+func (c *conn) serveH2C(context.Context, *response) bool { return false }
@@ -0,0 +1,80 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows reaching the server
+// through a chain of proxies, each of which is reached through the
+// previous one using SOCKS or HTTP CONNECT.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// proxyChainForRequest returns the proxies to use for req according to
+// t.ProxyChain, excluding the last one, which it returns separately.
+func (t *Transport) proxyChainForRequest(req *Request) (chain []*url.URL, last *url.URL, err error) {
+	proxies, err := t.ProxyChain(req)
+	if err != nil || len(proxies) <= 0 {
+		return nil, nil, err
+	}
+	for _, proxyURL := range proxies {
+		if proxyURL == nil {
+			return nil, nil, errors.New("http: nil proxy URL in proxy chain")
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" && !isSOCKSProxy(proxyURL) {
+			return nil, nil, fmt.Errorf("http: unsupported proxy scheme %q in proxy chain", proxyURL.Scheme)
+		}
+	}
+	return proxies[:len(proxies)-1], proxies[len(proxies)-1], nil
+}
+
+// proxyChainKey returns the connectMethodKey representation of chain.
+func proxyChainKey(chain []*url.URL) string {
+	var hops []string
+	for _, proxyURL := range chain {
+		hops = append(hops, proxyURL.String())
+	}
+	return strings.Join(hops, ",")
+}
+
+// dialProxyChain uses pconn.conn, which is connected to the first proxy
+// of the chain of cm, to reach the next proxies, up to cm.proxyURL.
+func (t *Transport) dialProxyChain(ctx context.Context, cm *connectMethod, pconn *persistConn, trace *httptrace.ClientTrace) error {
+	hops := append(append([]*url.URL{}, cm.proxyChain...), cm.proxyURL)
+	for idx := 0; idx < len(hops)-1; idx++ {
+		hop, next := hops[idx], hops[idx+1]
+		if err := t.connectThroughProxy(ctx, pconn.conn, hop, canonicalAddr(next), trace); err != nil {
+			return err
+		}
+		if next.Scheme == "https" {
+			if err := pconn.addTLS(ctx, next.Hostname(), nil, trace); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// connectThroughProxy asks the proxy at the other end of conn to
+// connect to target, using SOCKS or HTTP CONNECT, invoking the
+// ProxyHopStart and ProxyHopDone hooks of trace.
+func (t *Transport) connectThroughProxy(ctx context.Context, conn net.Conn, proxyURL *url.URL, target string, trace *httptrace.ClientTrace) (err error) {
+	info := httptrace.ProxyHopInfo{Proxy: proxyURL.Redacted(), Addr: target}
+	if trace != nil && trace.ProxyHopStart != nil {
+		trace.ProxyHopStart(info)
+	}
+	if isSOCKSProxy(proxyURL) {
+		err = t.dialSOCKS(ctx, conn, proxyURL, target)
+	} else {
+		err = t.dialHTTPConnect(ctx, conn, proxyURL, target)
+	}
+	if trace != nil && trace.ProxyHopDone != nil {
+		trace.ProxyHopDone(info, err)
+	}
+	return err
+}
@@ -0,0 +1,202 @@
+package http_test
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// serveSOCKS5 runs a SOCKS5 proxy without authentication using l
+// and sends the address of each CONNECT request to targets.
+func serveSOCKS5(l net.Listener, targets chan<- string) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			buf := make([]byte, 262)
+			// version and authentication methods
+			if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+				return
+			}
+			if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+				return
+			}
+			if _, err := conn.Write([]byte{5, 0}); err != nil {
+				return
+			}
+			// CONNECT request with an IPv4 address
+			if _, err := io.ReadFull(conn, buf[:10]); err != nil || buf[3] != 1 {
+				return
+			}
+			target := net.JoinHostPort(net.IP(buf[4:8]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(buf[8:]))))
+			targets <- target
+			upstream, err := net.Dial("tcp", target)
+			if err != nil {
+				conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+				return
+			}
+			defer upstream.Close()
+			if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+				return
+			}
+			go io.Copy(upstream, conn)
+			io.Copy(conn, upstream)
+		}()
+	}
+}
+
+func TestTransportProxyChain(t *testing.T) {
+	srv := httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	httpProxy := httptest.NewServer(&tunnelProxy{})
+	defer httpProxy.Close()
+	httpProxyURL, _ := url.Parse(httpProxy.URL)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	socksTargets := make(chan string, 10)
+	go serveSOCKS5(l, socksTargets)
+	socksURL, _ := url.Parse("socks5://" + l.Addr().String())
+
+	t.Run("we reach the server through all the proxies", func(t *testing.T) {
+		var mu sync.Mutex
+		var connected []string
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		txp.ProxyChain = func(*Request) ([]*url.URL, error) {
+			return []*url.URL{socksURL, httpProxyURL}, nil
+		}
+		txp.OnProxyConnectResponse = func(ctx context.Context, proxyURL *url.URL, req *Request, resp *Response) error {
+			mu.Lock()
+			connected = append(connected, proxyURL.String()+" "+req.Host)
+			mu.Unlock()
+			return nil
+		}
+		client := &Client{Transport: txp}
+		for idx := 0; idx < 2; idx++ {
+			resp, err := client.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if string(data) != "hello" {
+				t.Fatalf("unexpected body: %q", data)
+			}
+		}
+		if got := <-socksTargets; got != httpProxyURL.Host {
+			t.Fatalf("SOCKS5 proxy connected to %s", got)
+		}
+		select {
+		case got := <-socksTargets:
+			t.Fatalf("did not reuse the connection: SOCKS5 proxy connected to %s", got)
+		default:
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if want := httpProxy.URL + " " + srv.Listener.Addr().String(); len(connected) != 1 || connected[0] != want {
+			t.Fatalf("unexpected CONNECT requests: %v", connected)
+		}
+	})
+
+	t.Run("we trace each hop of the chain, including the last one", func(t *testing.T) {
+		var events []string
+		trace := &httptrace.ClientTrace{
+			ProxyHopStart: func(info httptrace.ProxyHopInfo) {
+				events = append(events, "start "+info.Proxy+" "+info.Addr)
+			},
+			ProxyHopDone: func(info httptrace.ProxyHopInfo, err error) {
+				events = append(events, fmt.Sprintf("done %s %s %v", info.Proxy, info.Addr, err))
+			},
+		}
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		txp.ProxyChain = func(*Request) ([]*url.URL, error) {
+			return []*url.URL{socksURL, httpProxyURL}, nil
+		}
+		req, _ := NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), "GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		<-socksTargets
+		target := srv.Listener.Addr().String()
+		want := []string{
+			"start " + socksURL.String() + " " + httpProxyURL.Host,
+			"done " + socksURL.String() + " " + httpProxyURL.Host + " <nil>",
+			"start " + httpProxyURL.String() + " " + target,
+			"done " + httpProxyURL.String() + " " + target + " <nil>",
+		}
+		if !slices.Equal(events, want) {
+			t.Fatalf("unexpected events: %q", events)
+		}
+	})
+
+	t.Run("we trace the hop that fails", func(t *testing.T) {
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		closed.Close()
+		unreachableURL := &url.URL{Scheme: "socks5", Host: closed.Addr().String()}
+		authURL := *httpProxyURL
+		authURL.User = url.UserPassword("user", "secret")
+		var events []string
+		trace := &httptrace.ClientTrace{
+			ProxyHopStart: func(info httptrace.ProxyHopInfo) {
+				events = append(events, "start "+info.Proxy+" "+info.Addr)
+			},
+			ProxyHopDone: func(info httptrace.ProxyHopInfo, err error) {
+				events = append(events, fmt.Sprintf("done %s %s %v", info.Proxy, info.Addr, err))
+			},
+		}
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		txp.ProxyChain = func(*Request) ([]*url.URL, error) {
+			return []*url.URL{&authURL, unreachableURL}, nil
+		}
+		req, _ := NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), "GET", srv.URL, nil)
+		if _, err := txp.RoundTrip(req); err == nil {
+			t.Fatal("expected an error")
+		}
+		redacted := "http://user:xxxxx@" + httpProxyURL.Host
+		want := []string{
+			"start " + redacted + " " + unreachableURL.Host,
+			"done " + redacted + " " + unreachableURL.Host + " Bad Gateway",
+		}
+		if !slices.Equal(events, want) {
+			t.Fatalf("unexpected events: %q", events)
+		}
+	})
+
+	t.Run("we reject unsupported proxy schemes", func(t *testing.T) {
+		txp := &Transport{ProxyChain: func(*Request) ([]*url.URL, error) {
+			return []*url.URL{{Scheme: "ftp", Host: "127.0.0.1:21"}, httpProxyURL}, nil
+		}}
+		req, _ := NewRequest("GET", srv.URL, nil)
+		if _, err := txp.RoundTrip(req); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package proxyserver
+
+import (
+	"encoding/base64"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/httputil"
+)
+
+// HTTPProxy is an HTTP forward proxy. It handles CONNECT requests by
+// connecting to the target and tunnelling the data, using either
+// HTTP/1.1 or HTTP/2, and forwards absolute-URI requests (e.g., "GET
+// http://example.com/ HTTP/1.1") to their target. It responds to
+// other requests with 400 Bad Request.
+type HTTPProxy struct {
+	Hooks
+
+	// Authenticate, if not nil, checks the credentials in the Basic
+	// Proxy-Authorization header of each request. We respond with 407
+	// Proxy Authentication Required when they are missing or when
+	// Authenticate returns false.
+	Authenticate func(username, password string) bool
+
+	// Transport, if not nil, forwards absolute-URI requests. If nil,
+	// we use an oohttp Transport using Dial to connect.
+	Transport oohttp.RoundTripper
+
+	once      sync.Once
+	transport oohttp.RoundTripper
+}
+
+var _ oohttp.Handler = &HTTPProxy{}
+
+// ServeHTTP implements oohttp.Handler.
+func (p *HTTPProxy) ServeHTTP(w oohttp.ResponseWriter, r *oohttp.Request) {
+	username, ok := p.authenticate(r)
+	if !ok {
+		w.Header().Set("Proxy-Authenticate", `Basic realm="proxy"`)
+		oohttp.Error(w, "proxy authentication required", oohttp.StatusProxyAuthRequired)
+		return
+	}
+	req := &Request{ClientAddr: r.RemoteAddr, Username: username}
+	switch {
+	case r.Method == "CONNECT":
+		req.Protocol, req.Target = "connect", r.Host
+		p.serveConnect(w, r, req)
+	case r.URL.IsAbs() && r.URL.Host != "":
+		req.Protocol, req.Target = "http", targetAddr(r)
+		p.serveForward(w, r, req)
+	default:
+		oohttp.Error(w, "not a proxy request", oohttp.StatusBadRequest)
+	}
+}
+
+// authenticate returns the username of the client and whether the
+// client is authorized to use the proxy.
+func (p *HTTPProxy) authenticate(r *oohttp.Request) (string, bool) {
+	if p.Authenticate == nil {
+		return "", true
+	}
+	const prefix = "Basic "
+	auth := r.Header.Get("Proxy-Authorization")
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	data, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
+	if err != nil {
+		return "", false
+	}
+	username, password, ok := strings.Cut(string(data), ":")
+	if !ok || !p.Authenticate(username, password) {
+		return "", false
+	}
+	return username, true
+}
+
+// serveConnect serves a CONNECT request.
+func (p *HTTPProxy) serveConnect(w oohttp.ResponseWriter, r *oohttp.Request, req *Request) {
+	if err := p.onRequest(req); err != nil {
+		oohttp.Error(w, err.Error(), oohttp.StatusForbidden)
+		return
+	}
+	target, err := p.dial(r.Context(), "tcp", req.Target)
+	if err != nil {
+		oohttp.Error(w, err.Error(), oohttp.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(oohttp.StatusOK)
+	if r.ProtoMajor == 1 {
+		conn, brw, err := w.(oohttp.Hijacker).Hijack()
+		if err != nil {
+			target.Close()
+			return
+		}
+		client := struct {
+			io.Reader
+			io.Writer
+			io.Closer
+		}{brw, conn, conn}
+		p.relay(req, client, target)
+		return
+	}
+	// With HTTP/2, the tunnel is the stream, which lasts as long as the
+	// handler is running.
+	w.(oohttp.Flusher).Flush()
+	client := struct {
+		io.Reader
+		io.Writer
+		io.Closer
+	}{r.Body, flushWriter{w}, r.Body}
+	p.relay(req, client, target)
+}
+
+// flushWriter flushes after each write.
+type flushWriter struct {
+	w oohttp.ResponseWriter
+}
+
+func (fw flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	fw.w.(oohttp.Flusher).Flush()
+	return n, err
+}
+
+// serveForward serves an absolute-URI request.
+func (p *HTTPProxy) serveForward(w oohttp.ResponseWriter, r *oohttp.Request, req *Request) {
+	if err := p.onRequest(req); err != nil {
+		oohttp.Error(w, err.Error(), oohttp.StatusForbidden)
+		return
+	}
+	var reqBody *countingReader
+	var respBody *countingReader
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			if pr.Out.Body != nil {
+				reqBody = &countingReader{ReadCloser: pr.Out.Body}
+				pr.Out.Body = reqBody
+			}
+		},
+		Transport: p.roundTripper(),
+		ModifyResponse: func(resp *oohttp.Response) error {
+			respBody = &countingReader{ReadCloser: resp.Body}
+			resp.Body = respBody
+			return nil
+		},
+	}
+	rp.ServeHTTP(w, r)
+	var sent, received int64
+	if reqBody != nil {
+		sent = reqBody.n.Load()
+	}
+	if respBody != nil {
+		received = respBody.n.Load()
+	}
+	p.onClose(req, sent, received)
+}
+
+// roundTripper returns the RoundTripper forwarding requests.
+func (p *HTTPProxy) roundTripper() oohttp.RoundTripper {
+	if p.Transport != nil {
+		return p.Transport
+	}
+	p.once.Do(func() {
+		p.transport = &oohttp.Transport{
+			DialContext:       p.dial,
+			ForceAttemptHTTP2: true,
+		}
+	})
+	return p.transport
+}
+
+// targetAddr returns the "host:port" target of an absolute-URI request.
+func targetAddr(r *oohttp.Request) string {
+	if port := r.URL.Port(); port != "" {
+		return r.URL.Host
+	}
+	port := "80"
+	if r.URL.Scheme == "https" {
+		port = "443"
+	}
+	return net.JoinHostPort(r.URL.Hostname(), port)
+}
@@ -0,0 +1,147 @@
+// Package proxyserver implements the server side of the proxy protocols
+// supported by the oohttp Transport: an HTTP forward proxy Handler,
+// supporting CONNECT, absolute-URI requests and Proxy-Authorization,
+// and a SOCKS5 server. Both have hooks for logging the connections and
+// for injecting faults, which makes them handy in tests.
+//
+// This package is an ooni/oohttp extension.
+package proxyserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync/atomic"
+)
+
+// A Request describes a request received by a proxy server to reach
+// a target on behalf of a client.
+type Request struct {
+	// Protocol is "http" for absolute-URI requests, "connect" for
+	// HTTP CONNECT requests, and "socks5" for SOCKS5 requests.
+	Protocol string
+
+	// ClientAddr is the address of the client.
+	ClientAddr string
+
+	// Target is the "host:port" address of the target.
+	Target string
+
+	// Username is the authenticated username, if any.
+	Username string
+}
+
+// Hooks contains the hooks shared by the proxy servers.
+type Hooks struct {
+	// OnRequest, if not nil, is called before serving each request.
+	// If it returns an error, the proxy server refuses the request.
+	// Use it to log the requests and to reject some of them.
+	OnRequest func(req *Request) error
+
+	// Dial, if not nil, connects to the target of a request. Use it to
+	// inject faults, e.g., by returning errors or connections that
+	// misbehave. If nil, we use a net.Dialer.
+	Dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// OnClose, if not nil, is called after serving each request, with
+	// the number of bytes sent by the client to the target and received
+	// by the client from the target, excluding the HTTP headers.
+	OnClose func(req *Request, sent, received int64)
+}
+
+// onRequest calls h.OnRequest, if set.
+func (h *Hooks) onRequest(req *Request) error {
+	if h.OnRequest != nil {
+		return h.OnRequest(req)
+	}
+	return nil
+}
+
+// dial connects to address using h.Dial, if set.
+func (h *Hooks) dial(ctx context.Context, network, address string) (net.Conn, error) {
+	if h.Dial != nil {
+		return h.Dial(ctx, network, address)
+	}
+	var d net.Dialer
+	return d.DialContext(ctx, network, address)
+}
+
+// onClose calls h.OnClose, if set.
+func (h *Hooks) onClose(req *Request, sent, received int64) {
+	if h.OnClose != nil {
+		h.OnClose(req, sent, received)
+	}
+}
+
+// relay copies data between client and target until both directions
+// are done, then closes them and calls h.OnClose.
+func (h *Hooks) relay(req *Request, client io.ReadWriteCloser, target net.Conn) {
+	var sent int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sent, _ = io.Copy(target, client)
+		if cw, ok := target.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		} else {
+			target.Close()
+		}
+	}()
+	received, _ := io.Copy(client, target)
+	client.Close()
+	<-done
+	target.Close()
+	h.onClose(req, sent, received)
+}
+
+// countingReader counts the bytes read from an io.ReadCloser.
+type countingReader struct {
+	io.ReadCloser
+	n atomic.Int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n.Add(int64(n))
+	return n, err
+}
+
+// A LocalServer is a proxy server listening on a loopback address,
+// which is useful in tests.
+type LocalServer struct {
+	// URL is the proxy URL, e.g., "socks5://127.0.0.1:54321".
+	URL *url.URL
+
+	// Listener is the listener used by the server.
+	Listener net.Listener
+}
+
+// NewLocalSOCKS5Server starts s on a loopback address. It panics if it
+// cannot listen. To run an HTTPProxy on a loopback address, use the
+// oohttp httptest package, e.g., httptest.NewServer(&HTTPProxy{}).
+func NewLocalSOCKS5Server(s *SOCKS5Server) *LocalServer {
+	l := newLocalListener()
+	go s.Serve(l)
+	return &LocalServer{
+		URL:      &url.URL{Scheme: "socks5", Host: l.Addr().String()},
+		Listener: l,
+	}
+}
+
+// Close stops accepting connections. The connections that the server
+// is serving continue until either the client or the target closes.
+func (ls *LocalServer) Close() error {
+	return ls.Listener.Close()
+}
+
+func newLocalListener() net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
+			panic(fmt.Sprintf("proxyserver: failed to listen on a port: %v", err))
+		}
+	}
+	return l
+}
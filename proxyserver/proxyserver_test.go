@@ -0,0 +1,245 @@
+package proxyserver_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	oohttp "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	"github.com/ooni/oohttp/proxyserver"
+)
+
+// recorder records the requests and the closes of a proxy server.
+type recorder struct {
+	mu       sync.Mutex
+	requests []proxyserver.Request
+	received int64
+	closed   chan struct{}
+}
+
+// newRecorder returns a new recorder.
+func newRecorder() *recorder {
+	return &recorder{closed: make(chan struct{}, 16)}
+}
+
+func (r *recorder) hooks() proxyserver.Hooks {
+	return proxyserver.Hooks{
+		OnRequest: func(req *proxyserver.Request) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.requests = append(r.requests, *req)
+			return nil
+		},
+		OnClose: func(req *proxyserver.Request, sent, received int64) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.received += received
+			r.closed <- struct{}{}
+		},
+	}
+}
+
+// get fetches URL using txp and returns the body.
+func get(t *testing.T, txp *oohttp.Transport, URL string) string {
+	t.Helper()
+	req, _ := oohttp.NewRequest("GET", URL, nil)
+	resp, err := txp.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("unexpected status: %s", resp.Status)
+	}
+	return string(data)
+}
+
+func TestHTTPProxy(t *testing.T) {
+	handler := oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+		w.Write([]byte("hello"))
+	})
+	plain := httptest.NewServer(handler)
+	defer plain.Close()
+	secure := httptest.NewTLSServer(handler)
+	defer secure.Close()
+
+	// newTransport returns a Transport using proxyURL and trusting secure.
+	newTransport := func(proxyURL *url.URL) *oohttp.Transport {
+		txp := secure.Client().Transport.(*oohttp.Transport).Clone()
+		txp.Proxy = oohttp.ProxyURL(proxyURL)
+		return txp
+	}
+
+	t.Run("we forward requests and CONNECT using credentials", func(t *testing.T) {
+		rec := newRecorder()
+		proxy := httptest.NewServer(&proxyserver.HTTPProxy{
+			Hooks: rec.hooks(),
+			Authenticate: func(username, password string) bool {
+				return username == "user" && password == "secret"
+			},
+		})
+		defer proxy.Close()
+		proxyURL, _ := url.Parse(proxy.URL)
+		proxyURL.User = url.UserPassword("user", "secret")
+		txp := newTransport(proxyURL)
+		for _, URL := range []string{plain.URL, secure.URL} {
+			if got := get(t, txp, URL); got != "hello" {
+				t.Fatalf("unexpected body: %q", got)
+			}
+		}
+		txp.CloseIdleConnections() // terminates the tunnel
+		<-rec.closed
+		<-rec.closed
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		if len(rec.requests) != 2 {
+			t.Fatalf("expected two requests, got %d", len(rec.requests))
+		}
+		if r := rec.requests[0]; r.Protocol != "http" || r.Target != plain.Listener.Addr().String() || r.Username != "user" {
+			t.Fatalf("unexpected request: %+v", r)
+		}
+		if r := rec.requests[1]; r.Protocol != "connect" || r.Target != secure.Listener.Addr().String() {
+			t.Fatalf("unexpected request: %+v", r)
+		}
+		if rec.received <= int64(len("hello")) {
+			t.Fatalf("unexpected number of received bytes: %d", rec.received)
+		}
+	})
+
+	t.Run("we require the credentials", func(t *testing.T) {
+		proxy := httptest.NewServer(&proxyserver.HTTPProxy{
+			Authenticate: func(username, password string) bool { return false },
+		})
+		defer proxy.Close()
+		proxyURL, _ := url.Parse(proxy.URL)
+		txp := newTransport(proxyURL)
+		defer txp.CloseIdleConnections()
+		req, _ := oohttp.NewRequest("GET", plain.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != oohttp.StatusProxyAuthRequired || resp.Header.Get("Proxy-Authenticate") == "" {
+			t.Fatalf("unexpected response: %s %v", resp.Status, resp.Header)
+		}
+		req, _ = oohttp.NewRequest("GET", secure.URL, nil)
+		if _, err := txp.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "Proxy Authentication Required") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("we can inject faults", func(t *testing.T) {
+		proxy := httptest.NewServer(&proxyserver.HTTPProxy{Hooks: proxyserver.Hooks{
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return nil, errors.New("mocked error")
+			},
+		}})
+		defer proxy.Close()
+		proxyURL, _ := url.Parse(proxy.URL)
+		txp := newTransport(proxyURL)
+		defer txp.CloseIdleConnections()
+		req, _ := oohttp.NewRequest("GET", secure.URL, nil)
+		if _, err := txp.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "Bad Gateway") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("we support HTTP/2 CONNECT", func(t *testing.T) {
+		rec := newRecorder()
+		proxy := httptest.NewUnstartedServer(&proxyserver.HTTPProxy{Hooks: rec.hooks()})
+		proxy.EnableHTTP2 = true
+		proxy.StartTLS()
+		defer proxy.Close()
+		proxyURL, _ := url.Parse(proxy.URL)
+		txp := newTransport(proxyURL)
+		defer txp.CloseIdleConnections()
+		txp.HTTP2Proxy = &oohttp.HTTP2Proxy{}
+		txp.TLSClientConfig.RootCAs.AddCert(proxy.Certificate())
+		if got := get(t, txp, secure.URL); got != "hello" {
+			t.Fatalf("unexpected body: %q", got)
+		}
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		if len(rec.requests) != 1 || rec.requests[0].Protocol != "connect" {
+			t.Fatalf("unexpected requests: %+v", rec.requests)
+		}
+	})
+}
+
+func TestSOCKS5Server(t *testing.T) {
+	srv := httptest.NewTLSServer(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	t.Run("we CONNECT using credentials", func(t *testing.T) {
+		rec := newRecorder()
+		proxy := proxyserver.NewLocalSOCKS5Server(&proxyserver.SOCKS5Server{
+			Hooks: rec.hooks(),
+			Authenticate: func(username, password string) bool {
+				return username == "user" && password == "secret"
+			},
+		})
+		defer proxy.Close()
+		proxyURL := *proxy.URL
+		proxyURL.User = url.UserPassword("user", "secret")
+		txp := srv.Client().Transport.(*oohttp.Transport).Clone()
+		txp.Proxy = oohttp.ProxyURL(&proxyURL)
+		defer txp.CloseIdleConnections()
+		if got := get(t, txp, srv.URL); got != "hello" {
+			t.Fatalf("unexpected body: %q", got)
+		}
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		if len(rec.requests) != 1 {
+			t.Fatalf("expected one request, got %d", len(rec.requests))
+		}
+		if r := rec.requests[0]; r.Protocol != "socks5" || r.Target != srv.Listener.Addr().String() || r.Username != "user" {
+			t.Fatalf("unexpected request: %+v", r)
+		}
+	})
+
+	t.Run("we reject wrong credentials and refused requests", func(t *testing.T) {
+		proxy := proxyserver.NewLocalSOCKS5Server(&proxyserver.SOCKS5Server{
+			Hooks: proxyserver.Hooks{
+				OnRequest: func(req *proxyserver.Request) error {
+					return errors.New("mocked error")
+				},
+			},
+			Authenticate: func(username, password string) bool {
+				return password == "secret"
+			},
+		})
+		defer proxy.Close()
+		for _, tc := range []struct {
+			password string
+			errText  string
+		}{
+			{"wrong", "username/password authentication failed"},
+			{"secret", "connection not allowed by ruleset"},
+		} {
+			proxyURL := *proxy.URL
+			proxyURL.User = url.UserPassword("user", tc.password)
+			txp := &oohttp.Transport{
+				Proxy:           oohttp.ProxyURL(&proxyURL),
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			}
+			req, _ := oohttp.NewRequest("GET", srv.URL, nil)
+			if _, err := txp.RoundTrip(req); err == nil || !strings.Contains(err.Error(), tc.errText) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	})
+}
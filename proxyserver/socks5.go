@@ -0,0 +1,220 @@
+package proxyserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// SOCKS5 protocol constants.
+const (
+	socksVersion5                 = 0x05
+	socksAuthNotRequired          = 0x00
+	socksAuthUsernamePassword     = 0x02
+	socksAuthNoAcceptableMethods  = 0xff
+	socksAuthUsernamePasswordVer  = 0x01
+	socksCmdConnect               = 0x01
+	socksAddrTypeIPv4             = 0x01
+	socksAddrTypeFQDN             = 0x03
+	socksAddrTypeIPv6             = 0x04
+	socksReplySucceeded           = 0x00
+	socksReplyGeneralFailure      = 0x01
+	socksReplyNotAllowed          = 0x02
+	socksReplyHostUnreachable     = 0x04
+	socksReplyConnectionRefused   = 0x05
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddrNotSupported    = 0x08
+)
+
+// SOCKS5Server is a SOCKS5 server supporting the CONNECT command. It
+// replies to the BIND and UDP ASSOCIATE commands with "command not
+// supported". Host names are resolved by Dial.
+type SOCKS5Server struct {
+	Hooks
+
+	// Authenticate, if not nil, requires the clients to authenticate
+	// using the username/password method (RFC 1929) and checks their
+	// credentials. If nil, the server does not require authentication.
+	Authenticate func(username, password string) bool
+
+	// HandshakeTimeout, if positive, is the maximum time to read the
+	// request of the client, including the authentication.
+	HandshakeTimeout time.Duration
+}
+
+// Serve accepts connections using l and serves each of them in a
+// background goroutine. It returns the error returned by l.Accept.
+func (s *SOCKS5Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves the SOCKS5 client using conn, which it closes when done.
+func (s *SOCKS5Server) ServeConn(conn net.Conn) {
+	defer conn.Close()
+	if s.HandshakeTimeout > 0 {
+		conn.SetDeadline(time.Now().Add(s.HandshakeTimeout))
+	}
+	username, err := s.handshake(conn)
+	if err != nil {
+		return
+	}
+	req := &Request{Protocol: "socks5", ClientAddr: conn.RemoteAddr().String(), Username: username}
+	cmd, target, err := readSOCKSRequest(conn)
+	if err != nil {
+		if errors.Is(err, errSOCKSAddrType) {
+			writeSOCKSReply(conn, socksReplyAddrNotSupported, nil)
+		}
+		return
+	}
+	req.Target = target
+	if cmd != socksCmdConnect {
+		writeSOCKSReply(conn, socksReplyCommandNotSupported, nil)
+		return
+	}
+	if err := s.onRequest(req); err != nil {
+		writeSOCKSReply(conn, socksReplyNotAllowed, nil)
+		return
+	}
+	upstream, err := s.dial(context.Background(), "tcp", target)
+	if err != nil {
+		writeSOCKSReply(conn, socksDialErrorReply(err), nil)
+		return
+	}
+	if err := writeSOCKSReply(conn, socksReplySucceeded, upstream.LocalAddr()); err != nil {
+		upstream.Close()
+		return
+	}
+	conn.SetDeadline(time.Time{})
+	s.relay(req, conn, upstream)
+}
+
+// handshake negotiates the authentication method with the client and
+// authenticates it, returning the username, if any.
+func (s *SOCKS5Server) handshake(conn net.Conn) (string, error) {
+	var b [255]byte
+	if _, err := io.ReadFull(conn, b[:2]); err != nil {
+		return "", err
+	}
+	if b[0] != socksVersion5 {
+		return "", errors.New("unexpected protocol version " + strconv.Itoa(int(b[0])))
+	}
+	methods := b[:b[1]]
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+	want := byte(socksAuthNotRequired)
+	if s.Authenticate != nil {
+		want = socksAuthUsernamePassword
+	}
+	found := false
+	for _, m := range methods {
+		found = found || m == want
+	}
+	if !found {
+		conn.Write([]byte{socksVersion5, socksAuthNoAcceptableMethods})
+		return "", errors.New("no acceptable authentication methods")
+	}
+	if _, err := conn.Write([]byte{socksVersion5, want}); err != nil {
+		return "", err
+	}
+	if s.Authenticate == nil {
+		return "", nil
+	}
+	if _, err := io.ReadFull(conn, b[:2]); err != nil {
+		return "", err
+	}
+	if b[0] != socksAuthUsernamePasswordVer {
+		return "", errors.New("invalid username/password version")
+	}
+	username := make([]byte, b[1])
+	if _, err := io.ReadFull(conn, username); err != nil {
+		return "", err
+	}
+	if _, err := io.ReadFull(conn, b[:1]); err != nil {
+		return "", err
+	}
+	password := make([]byte, b[0])
+	if _, err := io.ReadFull(conn, password); err != nil {
+		return "", err
+	}
+	if !s.Authenticate(string(username), string(password)) {
+		conn.Write([]byte{socksAuthUsernamePasswordVer, 1})
+		return "", errors.New("username/password authentication failed")
+	}
+	if _, err := conn.Write([]byte{socksAuthUsernamePasswordVer, 0}); err != nil {
+		return "", err
+	}
+	return string(username), nil
+}
+
+// errSOCKSAddrType indicates an unsupported address type.
+var errSOCKSAddrType = errors.New("unknown address type")
+
+// readSOCKSRequest reads the request of the client and returns the
+// command and the "host:port" target.
+func readSOCKSRequest(r io.Reader) (byte, string, error) {
+	var b [255]byte
+	if _, err := io.ReadFull(r, b[:4]); err != nil {
+		return 0, "", err
+	}
+	if b[0] != socksVersion5 {
+		return 0, "", errors.New("unexpected protocol version " + strconv.Itoa(int(b[0])))
+	}
+	cmd := b[1]
+	var host string
+	switch b[3] {
+	case socksAddrTypeIPv4, socksAddrTypeIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if b[3] == socksAddrTypeIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return 0, "", err
+		}
+		host = ip.String()
+	case socksAddrTypeFQDN:
+		if _, err := io.ReadFull(r, b[:1]); err != nil {
+			return 0, "", err
+		}
+		name := make([]byte, b[0])
+		if _, err := io.ReadFull(r, name); err != nil {
+			return 0, "", err
+		}
+		host = string(name)
+	default:
+		return 0, "", errSOCKSAddrType
+	}
+	if _, err := io.ReadFull(r, b[:2]); err != nil {
+		return 0, "", err
+	}
+	port := int(b[0])<<8 | int(b[1])
+	return cmd, net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
+// writeSOCKSReply writes a reply with the given code and bound address.
+func writeSOCKSReply(w io.Writer, code byte, addr net.Addr) error {
+	ip, port := net.IPv4zero, 0
+	if ta, ok := addr.(*net.TCPAddr); ok {
+		ip, port = ta.IP, ta.Port
+	}
+	b := []byte{socksVersion5, code, 0}
+	if ip4 := ip.To4(); ip4 != nil {
+		b = append(b, socksAddrTypeIPv4)
+		b = append(b, ip4...)
+	} else {
+		b = append(b, socksAddrTypeIPv6)
+		b = append(b, ip.To16()...)
+	}
+	b = append(b, byte(port>>8), byte(port))
+	_, err := w.Write(b)
+	return err
+}
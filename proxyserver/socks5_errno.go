@@ -0,0 +1,22 @@
+//go:build !plan9
+
+package proxyserver
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// socksDialErrorReply maps a dial error to a reply code.
+func socksDialErrorReply(err error) byte {
+	var dnsErr *net.DNSError
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return socksReplyConnectionRefused
+	case errors.As(err, &dnsErr), errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return socksReplyHostUnreachable
+	default:
+		return socksReplyGeneralFailure
+	}
+}
@@ -0,0 +1,15 @@
+package proxyserver
+
+import (
+	"errors"
+	"net"
+)
+
+// socksDialErrorReply maps a dial error to a reply code.
+func socksDialErrorReply(err error) byte {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return socksReplyHostUnreachable
+	}
+	return socksReplyGeneralFailure
+}
@@ -668,13 +668,23 @@ func (r *Request) write(w io.Writer, usingProxy bool, extraHeaders Header, waitF
 		return err
 	}
 
+	// When the user asked for a specific header order, collect the
+	// header lines and write them all at once when we're done.
+	hw, htrace := w, trace
+	var orderWriter *headerOrderWriter
+	headerOrder := r.Header.headerOrder()
+	if len(headerOrder) > 0 {
+		orderWriter = &headerOrderWriter{}
+		hw, htrace = orderWriter, nil
+	}
+
 	// Header lines
-	_, err = fmt.Fprintf(w, "Host: %s\r\n", host)
+	_, err = fmt.Fprintf(hw, "Host: %s\r\n", host)
 	if err != nil {
 		return err
 	}
-	if trace != nil && trace.WroteHeaderField != nil {
-		trace.WroteHeaderField("Host", []string{host})
+	if htrace != nil && htrace.WroteHeaderField != nil {
+		htrace.WroteHeaderField("Host", []string{host})
 	}
 
 	// Use the defaultUserAgent unless the Header contains one, which
@@ -686,12 +696,12 @@ func (r *Request) write(w io.Writer, usingProxy bool, extraHeaders Header, waitF
 	if userAgent != "" {
 		userAgent = headerNewlineToSpace.Replace(userAgent)
 		userAgent = textproto.TrimString(userAgent)
-		_, err = fmt.Fprintf(w, "User-Agent: %s\r\n", userAgent)
+		_, err = fmt.Fprintf(hw, "User-Agent: %s\r\n", userAgent)
 		if err != nil {
 			return err
 		}
-		if trace != nil && trace.WroteHeaderField != nil {
-			trace.WroteHeaderField("User-Agent", []string{userAgent})
+		if htrace != nil && htrace.WroteHeaderField != nil {
+			htrace.WroteHeaderField("User-Agent", []string{userAgent})
 		}
 	}
 
@@ -700,18 +710,25 @@ func (r *Request) write(w io.Writer, usingProxy bool, extraHeaders Header, waitF
 	if err != nil {
 		return err
 	}
-	err = tw.writeHeader(w, trace)
+	err = tw.writeHeader(hw, htrace)
 	if err != nil {
 		return err
 	}
 
-	err = r.Header.writeSubset(w, reqWriteExcludeHeader, trace)
+	err = r.Header.writeSubset(hw, reqWriteExcludeHeader, htrace)
 	if err != nil {
 		return err
 	}
 
 	if extraHeaders != nil {
-		err = extraHeaders.write(w, trace)
+		err = extraHeaders.write(hw, htrace)
+		if err != nil {
+			return err
+		}
+	}
+
+	if orderWriter != nil {
+		err = orderWriter.writeOrdered(w, headerOrder, trace)
 		if err != nil {
 			return err
 		}
@@ -2006,6 +2006,10 @@ func (c *conn) serve(ctx context.Context) {
 			}
 		}
 
+		if c.server.H2C && c.tlsState == nil && c.serveH2C(ctx, w) { // oohttp ext
+			return
+		}
+
 		// Expect 100 Continue support
 		req := w.req
 		if req.expectsContinue() {
@@ -2908,6 +2912,32 @@ type Server struct {
 	// value.
 	ConnContext func(ctx context.Context, c net.Conn) context.Context
 
+	// TLSServerFactory is an ooni/oohttp extension. If this field is not
+	// nil, ServeTLS and ListenAndServeTLS use it to wrap each accepted
+	// connection into a TLSConn. Otherwise we'll default to using the
+	// oohttp.TLSServerFactory global factory. (But, if you call Serve
+	// with your own TLS listener, you'll completely bypass this
+	// per-Server-or-global TLSServerFactory mechanism.)
+	TLSServerFactory func(conn net.Conn, config *tls.Config) TLSConn
+
+	// H2C is an ooni/oohttp extension. If this field is true, the Server
+	// also serves HTTP/2 over cleartext TCP (h2c) on connections without
+	// TLS, both when clients start with the HTTP/2 connection preface
+	// (prior knowledge) and when their first request asks to upgrade to
+	// h2c (RFC 7540 Section 3.2). Otherwise, we only use HTTP/1.x.
+	H2C bool
+
+	// ExtendedConnect is an ooni/oohttp extension. If this field is true,
+	// the bundled HTTP/2 server advertises SETTINGS_ENABLE_CONNECT_PROTOCOL
+	// and accepts extended CONNECT requests (RFC 8441). Handlers find their
+	// :protocol pseudo-header field in the ":protocol" request header, which
+	// is also where the Transport expects it when sending them. Otherwise,
+	// the server rejects requests with a :protocol pseudo-header field.
+	ExtendedConnect bool
+
+	h2cOnce sync.Once
+	h2c     *http2Server // lazily created by h2cServer
+
 	inShutdown atomic.Bool // true when server is in shutdown
 
 	disableKeepAlives atomic.Bool
@@ -3320,7 +3350,7 @@ func (srv *Server) ServeTLS(l net.Listener, certFile, keyFile string) error {
 		}
 	}
 
-	tlsListener := tls.NewListener(l, config)
+	tlsListener := NewTLSListener(l, config, srv.tlsServerFactory)
 	return srv.Serve(tlsListener)
 }
 
@@ -0,0 +1,106 @@
+package socks
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// A Listener is a pending BIND request. The proxy server accepts a
+// single connection for each BIND request.
+type Listener struct {
+	conn    net.Conn
+	addr    *Addr
+	network string
+	version int
+
+	mu        sync.Mutex
+	accepting bool
+	closed    bool
+}
+
+var _ net.Listener = &Listener{}
+
+// Bind asks the proxy server to accept a connection from the peer at
+// address on the provided network using the BIND command. The Addr
+// of the returned Listener is the address where the proxy server is
+// listening, which the caller usually communicates to the peer using
+// another connection, as in FTP. When the peer address is unknown,
+// the address host may be an unspecified IP address, e.g., "0.0.0.0",
+// and the port may be zero.
+func (d *Dialer) Bind(ctx context.Context, network, address string) (*Listener, error) {
+	if err := d.validateTarget(network, address); err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdBind.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	c, err := d.dialProxy(ctx)
+	if err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdBind.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	a, err := d.handshake(ctx, c, CmdBind, address)
+	if err != nil {
+		c.Close()
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdBind.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	if a.IP != nil && a.IP.IsUnspecified() {
+		// Like for UDP ASSOCIATE, the proxy server is listening on the
+		// same address we are connected to.
+		if ra, ok := c.RemoteAddr().(*net.TCPAddr); ok {
+			a.IP = ra.IP
+		}
+	}
+	return &Listener{conn: c, addr: a, network: network, version: d.version()}, nil
+}
+
+// Accept waits for the peer to connect to the proxy server and returns
+// a Conn whose BoundAddr is the address of the peer.
+func (l *Listener) Accept() (net.Conn, error) {
+	l.mu.Lock()
+	if l.accepting || l.closed {
+		l.mu.Unlock()
+		return nil, &net.OpError{Op: "accept", Net: l.network, Addr: l.addr, Err: net.ErrClosed}
+	}
+	l.accepting = true
+	l.mu.Unlock()
+
+	var (
+		a   *Addr
+		err error
+	)
+	if l.version == Version4 {
+		a, err = readReply4(l.conn)
+	} else {
+		a, err = readReply5(l.conn)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err == nil && l.closed {
+		err = net.ErrClosed
+	}
+	l.closed = true
+	if err != nil {
+		l.conn.Close()
+		return nil, &net.OpError{Op: "accept", Net: l.network, Addr: l.addr, Err: err}
+	}
+	return &Conn{Conn: l.conn, boundAddr: a}, nil
+}
+
+// Close closes the listener unless Accept has already returned a
+// connection, which Close does not close.
+func (l *Listener) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	return l.conn.Close()
+}
+
+// Addr returns the address where the proxy server is listening.
+func (l *Listener) Addr() net.Addr {
+	return l.addr
+}
@@ -0,0 +1,575 @@
+// Package socks provides a SOCKS client implementation supporting the
+// versions 4, 4a and 5 of the protocol.
+//
+// SOCKS protocol version 5 is defined in RFC 1928.
+// Username/Password authentication for SOCKS version 5 is defined in
+// RFC 1929.
+//
+// This package is an ooni/oohttp extension. We derived it from the
+// golang.org/x/net/internal/socks package, which only implements the
+// CONNECT command, and we added the BIND and UDP ASSOCIATE commands
+// as well as SOCKS4 and SOCKS4a. The oohttp Transport uses this package
+// for SOCKS proxies, and you can use it directly, e.g., to send DNS
+// queries over UDP through the same proxy used by the Transport.
+package socks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// A Command represents a SOCKS command.
+type Command int
+
+func (cmd Command) String() string {
+	switch cmd {
+	case CmdConnect:
+		return "socks connect"
+	case CmdBind:
+		return "socks bind"
+	case CmdUDPAssociate:
+		return "socks udp associate"
+	default:
+		return "socks " + strconv.Itoa(int(cmd))
+	}
+}
+
+// An AuthMethod represents a SOCKS authentication method.
+type AuthMethod int
+
+// A Reply represents a SOCKS command reply code.
+type Reply int
+
+func (code Reply) String() string {
+	switch code {
+	case StatusSucceeded:
+		return "succeeded"
+	case 0x01:
+		return "general SOCKS server failure"
+	case 0x02:
+		return "connection not allowed by ruleset"
+	case 0x03:
+		return "network unreachable"
+	case 0x04:
+		return "host unreachable"
+	case 0x05:
+		return "connection refused"
+	case 0x06:
+		return "TTL expired"
+	case 0x07:
+		return "command not supported"
+	case 0x08:
+		return "address type not supported"
+	case status4Granted:
+		return "request granted"
+	case 0x5b:
+		return "request rejected or failed"
+	case 0x5c:
+		return "request rejected because the server cannot connect to identd"
+	case 0x5d:
+		return "request rejected because identd reported a different user ID"
+	default:
+		return "unknown code: " + strconv.Itoa(int(code))
+	}
+}
+
+// Wire protocol constants.
+const (
+	Version4 = 0x04
+	Version5 = 0x05
+
+	AddrTypeIPv4 = 0x01
+	AddrTypeFQDN = 0x03
+	AddrTypeIPv6 = 0x04
+
+	CmdConnect      Command = 0x01 // establishes an active-open forward proxy connection
+	CmdBind         Command = 0x02 // establishes a passive-open forward proxy connection
+	CmdUDPAssociate Command = 0x03 // establishes a UDP relay (SOCKS5 only)
+
+	AuthMethodNotRequired         AuthMethod = 0x00 // no authentication required
+	AuthMethodUsernamePassword    AuthMethod = 0x02 // use username/password
+	AuthMethodNoAcceptableMethods AuthMethod = 0xff // no acceptable authentication methods
+
+	StatusSucceeded Reply = 0x00
+
+	status4Granted Reply = 0x5a
+)
+
+// An Addr represents a SOCKS-specific address.
+// Either Name or IP is used exclusively.
+type Addr struct {
+	Name string // fully-qualified domain name
+	IP   net.IP
+	Port int
+}
+
+func (a *Addr) Network() string { return "socks" }
+
+func (a *Addr) String() string {
+	if a == nil {
+		return "<nil>"
+	}
+	port := strconv.Itoa(a.Port)
+	if a.IP == nil {
+		return net.JoinHostPort(a.Name, port)
+	}
+	return net.JoinHostPort(a.IP.String(), port)
+}
+
+// A Conn represents a forward proxy connection.
+type Conn struct {
+	net.Conn
+
+	boundAddr net.Addr
+}
+
+// BoundAddr returns the address assigned by the proxy server for
+// connecting to the command target address from the proxy server.
+// For connections returned by Listener.Accept, it is instead the
+// address of the peer that connected to the proxy server.
+func (c *Conn) BoundAddr() net.Addr {
+	if c == nil {
+		return nil
+	}
+	return c.boundAddr
+}
+
+// A Dialer holds SOCKS-specific options.
+type Dialer struct {
+	// Version is the version of the protocol, which is Version5 when
+	// this field is zero. Use Version4 for SOCKS4 and SOCKS4a, which
+	// only support the CONNECT and BIND commands and IPv4.
+	Version int
+
+	proxyNetwork string // network between a proxy server and a client
+	proxyAddress string // proxy server address
+
+	// ProxyDial specifies the optional dial function for
+	// establishing the transport connection.
+	ProxyDial func(context.Context, string, string) (net.Conn, error)
+
+	// ProxyListenPacket specifies the optional function for creating
+	// the local UDP socket used by ListenPacket.
+	ProxyListenPacket func(context.Context, string, string) (net.PacketConn, error)
+
+	// AuthMethods specifies the list of request authentication
+	// methods. SOCKS5 only.
+	// If empty, SOCKS client requests only AuthMethodNotRequired.
+	AuthMethods []AuthMethod
+
+	// Authenticate specifies the optional authentication
+	// function. It must be non-nil when AuthMethods is not empty.
+	// It must return an error when the authentication is failed.
+	// SOCKS5 only.
+	Authenticate func(context.Context, io.ReadWriter, AuthMethod) error
+
+	// UserID is the user ID sent to SOCKS4 servers.
+	UserID string
+
+	// LookupIPAddr specifies the optional function resolving the host
+	// names of the target addresses locally, in which case the proxy
+	// server only receives IP addresses. If nil, the Dialer sends host
+	// names to the proxy server, which resolves them, as in SOCKS4a
+	// and in SOCKS5 with remote DNS (i.e., "socks5h").
+	LookupIPAddr func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewDialer returns a new SOCKS5 Dialer that dials through the provided
+// proxy server's network and address.
+func NewDialer(network, address string) *Dialer {
+	return &Dialer{proxyNetwork: network, proxyAddress: address}
+}
+
+// DialContext connects to the provided address on the provided
+// network using the CONNECT command.
+//
+// The returned error value may be a net.OpError. When the Op field of
+// net.OpError contains Dial, the Source field contains a proxy server
+// address and the Addr field contains a command target address.
+//
+// See func Dial of the net package of standard library for a
+// description of the network and address parameters.
+func (d *Dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if err := d.validateTarget(network, address); err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	if ctx == nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: errors.New("nil context")}
+	}
+	c, err := d.dialProxy(ctx)
+	if err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	a, err := d.handshake(ctx, c, CmdConnect, address)
+	if err != nil {
+		c.Close()
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	return &Conn{Conn: c, boundAddr: a}, nil
+}
+
+// DialWithConn initiates a connection from SOCKS server to the target
+// network and address using the connection c that is already
+// connected to the SOCKS server.
+//
+// It returns the connection's local address assigned by the SOCKS
+// server.
+func (d *Dialer) DialWithConn(ctx context.Context, c net.Conn, network, address string) (net.Addr, error) {
+	if err := d.validateTarget(network, address); err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	if ctx == nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: errors.New("nil context")}
+	}
+	a, err := d.handshake(ctx, c, CmdConnect, address)
+	if err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	return a, nil
+}
+
+// Dial connects to the provided address on the provided network.
+//
+// Unlike DialContext, it returns a raw transport connection instead
+// of a forward proxy connection.
+//
+// Deprecated: Use DialContext or DialWithConn instead.
+func (d *Dialer) Dial(network, address string) (net.Conn, error) {
+	if err := d.validateTarget(network, address); err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	var err error
+	var c net.Conn
+	if d.ProxyDial != nil {
+		c, err = d.ProxyDial(context.Background(), d.proxyNetwork, d.proxyAddress)
+	} else {
+		c, err = net.Dial(d.proxyNetwork, d.proxyAddress)
+	}
+	if err != nil {
+		proxy, dst, _ := d.pathAddrs(address)
+		return nil, &net.OpError{Op: CmdConnect.String(), Net: network, Source: proxy, Addr: dst, Err: err}
+	}
+	if _, err := d.DialWithConn(context.Background(), c, network, address); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// dialProxy connects to the proxy server.
+func (d *Dialer) dialProxy(ctx context.Context) (net.Conn, error) {
+	if d.ProxyDial != nil {
+		return d.ProxyDial(ctx, d.proxyNetwork, d.proxyAddress)
+	}
+	var dd net.Dialer
+	return dd.DialContext(ctx, d.proxyNetwork, d.proxyAddress)
+}
+
+func (d *Dialer) validateTarget(network, address string) error {
+	switch network {
+	case "tcp", "tcp6", "tcp4":
+	default:
+		return errors.New("network not implemented")
+	}
+	return nil
+}
+
+func (d *Dialer) pathAddrs(address string) (proxy, dst net.Addr, err error) {
+	for i, s := range []string{d.proxyAddress, address} {
+		host, port, err := splitHostPort(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		a := &Addr{Port: port}
+		a.IP = net.ParseIP(host)
+		if a.IP == nil {
+			a.Name = host
+		}
+		if i == 0 {
+			proxy = a
+		} else {
+			dst = a
+		}
+	}
+	return
+}
+
+func (d *Dialer) version() int {
+	if d.Version == 0 {
+		return Version5
+	}
+	return d.Version
+}
+
+var (
+	noDeadline   = time.Time{}
+	aLongTimeAgo = time.Unix(1, 0)
+)
+
+// withContext runs fn, which performs I/O using c, making sure that
+// the I/O is interrupted when ctx is done.
+func withContext(ctx context.Context, c net.Conn, fn func() error) (err error) {
+	if deadline, ok := ctx.Deadline(); ok && !deadline.IsZero() {
+		c.SetDeadline(deadline)
+		defer c.SetDeadline(noDeadline)
+	}
+	if ctx.Done() != nil {
+		stop := context.AfterFunc(ctx, func() {
+			c.SetDeadline(aLongTimeAgo)
+		})
+		defer func() {
+			if !stop() && err != nil {
+				err = ctx.Err()
+			}
+		}()
+	}
+	return fn()
+}
+
+// handshake sends the cmd request for address using c, which is
+// connected to the proxy server, and returns the address in the reply.
+func (d *Dialer) handshake(ctx context.Context, c net.Conn, cmd Command, address string) (*Addr, error) {
+	host, port, err := splitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if host, err = d.resolve(ctx, host); err != nil {
+		return nil, err
+	}
+	var a *Addr
+	err = withContext(ctx, c, func() (err error) {
+		switch d.version() {
+		case Version4:
+			if err := d.request4(c, cmd, host, port); err != nil {
+				return err
+			}
+			a, err = readReply4(c)
+			return err
+		case Version5:
+			if err := d.authenticate(ctx, c); err != nil {
+				return err
+			}
+			if err := writeRequest5(c, cmd, host, port); err != nil {
+				return err
+			}
+			a, err = readReply5(c)
+			return err
+		default:
+			return errors.New("unsupported protocol version " + strconv.Itoa(d.Version))
+		}
+	})
+	return a, err
+}
+
+// resolve returns the IP address to use for host when resolving host
+// names locally and host otherwise.
+func (d *Dialer) resolve(ctx context.Context, host string) (string, error) {
+	if d.LookupIPAddr == nil || net.ParseIP(host) != nil {
+		return host, nil
+	}
+	addrs, err := d.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	for _, addr := range addrs {
+		if d.version() == Version5 || addr.IP.To4() != nil {
+			return addr.IP.String(), nil
+		}
+	}
+	return "", errors.New("no suitable address found for " + host)
+}
+
+// authenticate negotiates the SOCKS5 authentication method using c and
+// authenticates using d.Authenticate.
+func (d *Dialer) authenticate(ctx context.Context, c net.Conn) error {
+	b := make([]byte, 0, 3)
+	b = append(b, Version5)
+	if len(d.AuthMethods) == 0 || d.Authenticate == nil {
+		b = append(b, 1, byte(AuthMethodNotRequired))
+	} else {
+		ams := d.AuthMethods
+		if len(ams) > 255 {
+			return errors.New("too many authentication methods")
+		}
+		b = append(b, byte(len(ams)))
+		for _, am := range ams {
+			b = append(b, byte(am))
+		}
+	}
+	if _, err := c.Write(b); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(c, b[:2]); err != nil {
+		return err
+	}
+	if b[0] != Version5 {
+		return errors.New("unexpected protocol version " + strconv.Itoa(int(b[0])))
+	}
+	am := AuthMethod(b[1])
+	if am == AuthMethodNoAcceptableMethods {
+		return errors.New("no acceptable authentication methods")
+	}
+	if d.Authenticate != nil {
+		return d.Authenticate(ctx, c, am)
+	}
+	if am != AuthMethodNotRequired {
+		return errors.New("unsupported authentication method " + strconv.Itoa(int(am)))
+	}
+	return nil
+}
+
+// writeRequest5 writes the SOCKS5 request for cmd, host and port.
+func writeRequest5(w io.Writer, cmd Command, host string, port int) error {
+	b, err := appendAddr5([]byte{Version5, byte(cmd), 0}, host, port)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// readReply5 reads a SOCKS5 reply and returns its address.
+func readReply5(r io.Reader) (*Addr, error) {
+	var b [3]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+	if b[0] != Version5 {
+		return nil, errors.New("unexpected protocol version " + strconv.Itoa(int(b[0])))
+	}
+	if cmdErr := Reply(b[1]); cmdErr != StatusSucceeded {
+		return nil, errors.New("unknown error " + cmdErr.String())
+	}
+	if b[2] != 0 {
+		return nil, errors.New("non-zero reserved field")
+	}
+	return readAddr5(r)
+}
+
+// appendAddr5 appends the SOCKS5 encoding of host and port to b.
+func appendAddr5(b []byte, host string, port int) ([]byte, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			b = append(b, AddrTypeIPv4)
+			b = append(b, ip4...)
+		} else {
+			b = append(b, AddrTypeIPv6)
+			b = append(b, ip.To16()...)
+		}
+	} else {
+		if len(host) > 255 {
+			return nil, errors.New("FQDN too long")
+		}
+		b = append(b, AddrTypeFQDN)
+		b = append(b, byte(len(host)))
+		b = append(b, host...)
+	}
+	b = append(b, byte(port>>8), byte(port))
+	return b, nil
+}
+
+// readAddr5 reads a SOCKS5 address.
+func readAddr5(r io.Reader) (*Addr, error) {
+	var b [255]byte
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return nil, err
+	}
+	a := &Addr{}
+	var l int
+	switch b[0] {
+	case AddrTypeIPv4:
+		l = net.IPv4len
+		a.IP = make(net.IP, net.IPv4len)
+	case AddrTypeIPv6:
+		l = net.IPv6len
+		a.IP = make(net.IP, net.IPv6len)
+	case AddrTypeFQDN:
+		if _, err := io.ReadFull(r, b[:1]); err != nil {
+			return nil, err
+		}
+		l = int(b[0])
+	default:
+		return nil, errors.New("unknown address type " + strconv.Itoa(int(b[0])))
+	}
+	if _, err := io.ReadFull(r, b[:l+2]); err != nil {
+		return nil, err
+	}
+	if a.IP != nil {
+		copy(a.IP, b[:l])
+	} else {
+		a.Name = string(b[:l])
+	}
+	a.Port = int(b[l])<<8 | int(b[l+1])
+	return a, nil
+}
+
+func splitHostPort(address string) (string, int, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+	portnum, err := strconv.Atoi(port)
+	if err != nil {
+		return "", 0, err
+	}
+	if 1 > portnum || portnum > 0xffff {
+		return "", 0, errors.New("port number out of range " + port)
+	}
+	return host, portnum, nil
+}
+
+// UsernamePassword are the credentials for the username/password
+// authentication method.
+type UsernamePassword struct {
+	Username string
+	Password string
+}
+
+// Authenticate authenticates a pair of username and password with the
+// proxy server.
+func (up *UsernamePassword) Authenticate(ctx context.Context, rw io.ReadWriter, auth AuthMethod) error {
+	switch auth {
+	case AuthMethodNotRequired:
+		return nil
+	case AuthMethodUsernamePassword:
+		if len(up.Username) == 0 || len(up.Username) > 255 || len(up.Password) > 255 {
+			return errors.New("invalid username/password")
+		}
+		b := []byte{authUsernamePasswordVersion}
+		b = append(b, byte(len(up.Username)))
+		b = append(b, up.Username...)
+		b = append(b, byte(len(up.Password)))
+		b = append(b, up.Password...)
+		if _, err := rw.Write(b); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(rw, b[:2]); err != nil {
+			return err
+		}
+		if b[0] != authUsernamePasswordVersion {
+			return errors.New("invalid username/password version")
+		}
+		if b[1] != authStatusSucceeded {
+			return errors.New("username/password authentication failed")
+		}
+		return nil
+	}
+	return errors.New("unsupported authentication method " + strconv.Itoa(int(auth)))
+}
+
+const (
+	authUsernamePasswordVersion = 0x01
+	authStatusSucceeded         = 0x00
+)
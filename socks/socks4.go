@@ -0,0 +1,59 @@
+package socks
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strings"
+)
+
+// request4 writes the SOCKS4 request for cmd, host and port. When host
+// is not an IP address, it writes a SOCKS4a request.
+func (d *Dialer) request4(w io.Writer, cmd Command, host string, port int) error {
+	if cmd != CmdConnect && cmd != CmdBind {
+		return errors.New("SOCKS4 does not support " + cmd.String())
+	}
+	if strings.IndexByte(d.UserID, 0) >= 0 {
+		return errors.New("invalid user ID")
+	}
+	b := []byte{Version4, byte(cmd), byte(port >> 8), byte(port)}
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		if strings.IndexByte(host, 0) >= 0 {
+			return errors.New("invalid host name")
+		}
+		// SOCKS4a: an invalid IP address whose last byte is not zero.
+		b = append(b, 0, 0, 0, 1)
+	case ip.To4() == nil:
+		return errors.New("SOCKS4 does not support IPv6 addresses")
+	default:
+		b = append(b, ip.To4()...)
+	}
+	b = append(b, d.UserID...)
+	b = append(b, 0)
+	if ip == nil {
+		b = append(b, host...)
+		b = append(b, 0)
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readReply4 reads a SOCKS4 reply and returns its address.
+func readReply4(r io.Reader) (*Addr, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+	if b[0] != 0 {
+		return nil, errors.New("unexpected reply version")
+	}
+	if cmdErr := Reply(b[1]); cmdErr != status4Granted {
+		return nil, errors.New("unknown error " + cmdErr.String())
+	}
+	return &Addr{
+		IP:   net.IPv4(b[4], b[5], b[6], b[7]),
+		Port: int(b[2])<<8 | int(b[3]),
+	}, nil
+}
@@ -0,0 +1,428 @@
+package socks_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ooni/oohttp/socks"
+)
+
+// server is a SOCKS server for testing.
+type server struct {
+	l        net.Listener
+	password string // when not empty, require username/password auth
+
+	mu      sync.Mutex
+	targets []string // the target addresses received by the server
+}
+
+// newServer starts a new server.
+func newServer(t *testing.T, password string) *server {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	s := &server{l: l, password: password}
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(c)
+		}
+	}()
+	return s
+}
+
+// lastTarget returns the last target address received by the server.
+func (s *server) lastTarget() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.targets) <= 0 {
+		return ""
+	}
+	return s.targets[len(s.targets)-1]
+}
+
+func (s *server) handle(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	version, err := r.ReadByte()
+	if err != nil {
+		return
+	}
+	var (
+		cmd    byte
+		target string
+		reply  func(ip net.IP, port int, ok bool)
+	)
+	switch version {
+	case 4:
+		var hdr [7]byte
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			return
+		}
+		if _, err := r.ReadString(0); err != nil { // user ID
+			return
+		}
+		cmd = hdr[0]
+		host := net.IP(hdr[3:7]).String()
+		if hdr[3] == 0 && hdr[4] == 0 && hdr[5] == 0 && hdr[6] != 0 {
+			if host, err = r.ReadString(0); err != nil {
+				return
+			}
+			host = host[:len(host)-1]
+		}
+		target = net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(hdr[1:]))))
+		reply = func(ip net.IP, port int, ok bool) {
+			b := []byte{0, 0x5a, byte(port >> 8), byte(port)}
+			if !ok {
+				b[1] = 0x5b
+			}
+			c.Write(append(b, ip.To4()...))
+		}
+	case 5:
+		n, _ := r.ReadByte()
+		methods := make([]byte, n)
+		if _, err := io.ReadFull(r, methods); err != nil {
+			return
+		}
+		if s.password == "" {
+			c.Write([]byte{5, 0})
+		} else {
+			if !bytes.Contains(methods, []byte{2}) {
+				c.Write([]byte{5, 0xff})
+				return
+			}
+			c.Write([]byte{5, 2})
+			r.ReadByte()
+			n, _ := r.ReadByte()
+			r.Discard(int(n))
+			n, _ = r.ReadByte()
+			password := make([]byte, n)
+			io.ReadFull(r, password)
+			if string(password) != s.password {
+				c.Write([]byte{1, 1})
+				return
+			}
+			c.Write([]byte{1, 0})
+		}
+		var hdr [4]byte
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			return
+		}
+		cmd = hdr[1]
+		var host string
+		switch hdr[3] {
+		case 1:
+			ip := make(net.IP, 4)
+			io.ReadFull(r, ip)
+			host = ip.String()
+		case 4:
+			ip := make(net.IP, 16)
+			io.ReadFull(r, ip)
+			host = ip.String()
+		case 3:
+			n, _ := r.ReadByte()
+			name := make([]byte, n)
+			io.ReadFull(r, name)
+			host = string(name)
+		}
+		var port [2]byte
+		if _, err := io.ReadFull(r, port[:]); err != nil {
+			return
+		}
+		target = net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:]))))
+		reply = func(ip net.IP, port int, ok bool) {
+			b := []byte{5, 0, 0, 1}
+			if !ok {
+				b[1] = 1
+			}
+			b = append(b, ip.To4()...)
+			c.Write(append(b, byte(port>>8), byte(port)))
+		}
+	default:
+		return
+	}
+	s.mu.Lock()
+	s.targets = append(s.targets, target)
+	s.mu.Unlock()
+
+	var upstream net.Conn
+	switch cmd {
+	case 1:
+		if upstream, err = net.Dial("tcp", target); err != nil {
+			reply(net.IPv4zero, 0, false)
+			return
+		}
+		la := upstream.LocalAddr().(*net.TCPAddr)
+		reply(la.IP, la.Port, true)
+	case 2:
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			reply(net.IPv4zero, 0, false)
+			return
+		}
+		defer l.Close()
+		reply(net.IPv4zero, l.Addr().(*net.TCPAddr).Port, true)
+		if upstream, err = l.Accept(); err != nil {
+			return
+		}
+		ra := upstream.RemoteAddr().(*net.TCPAddr)
+		reply(ra.IP, ra.Port, true)
+	case 3:
+		s.relay(c, target, func(port int) { reply(net.IPv4zero, port, true) })
+		return
+	default:
+		reply(net.IPv4zero, 0, false)
+		return
+	}
+	defer upstream.Close()
+	go io.Copy(upstream, r)
+	io.Copy(c, upstream)
+}
+
+// relay implements UDP ASSOCIATE, where client is the address of the
+// UDP socket of the client and ready sends the reply.
+func (s *server) relay(c net.Conn, client string, ready func(port int)) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return
+	}
+	defer pc.Close()
+	clientAddr, err := net.ResolveUDPAddr("udp", client)
+	if err != nil {
+		return
+	}
+	ready(pc.LocalAddr().(*net.UDPAddr).Port)
+	go func() {
+		io.Copy(io.Discard, c)
+		pc.Close()
+	}()
+	buf := make([]byte, 2048)
+	for {
+		n, from, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if from.String() == clientAddr.String() {
+			// 0 0 0 1 a.b.c.d port
+			if n < 10 || buf[3] != 1 {
+				continue
+			}
+			pc.WriteTo(buf[10:n], &net.UDPAddr{IP: net.IP(buf[4:8]), Port: int(binary.BigEndian.Uint16(buf[8:]))})
+			continue
+		}
+		ua := from.(*net.UDPAddr)
+		b := append([]byte{0, 0, 0, 1}, ua.IP.To4()...)
+		b = append(b, byte(ua.Port>>8), byte(ua.Port))
+		pc.WriteTo(append(b, buf[:n]...), clientAddr)
+	}
+}
+
+// newEchoServer starts a TCP echo server.
+func newEchoServer(t *testing.T) net.Listener {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				io.Copy(c, c)
+			}()
+		}
+	}()
+	return l
+}
+
+// checkEcho checks that c is connected to an echo server.
+func checkEcho(t *testing.T, c net.Conn) {
+	t.Helper()
+	if _, err := c.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(c, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "hello" {
+		t.Fatalf("unexpected echo: %q", buf)
+	}
+}
+
+// dialerFromURL returns the dialer for the given URL.
+func dialerFromURL(t *testing.T, rawURL string) *socks.Dialer {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := socks.FromURL(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestDialer(t *testing.T) {
+	echo := newEchoServer(t)
+	_, port, _ := net.SplitHostPort(echo.Addr().String())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("we can CONNECT using username and password", func(t *testing.T) {
+		s := newServer(t, "secret")
+		d := dialerFromURL(t, "socks5h://user:secret@"+s.l.Addr().String())
+		c, err := d.DialContext(ctx, "tcp", echo.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+		checkEcho(t, c)
+		if c.(*socks.Conn).BoundAddr() == nil {
+			t.Fatal("expected a bound address")
+		}
+	})
+
+	t.Run("we fail with the wrong password", func(t *testing.T) {
+		s := newServer(t, "secret")
+		d := dialerFromURL(t, "socks5://user:wrong@"+s.l.Addr().String())
+		if _, err := d.DialContext(ctx, "tcp", echo.Addr().String()); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("we send host names to the proxy or resolve them", func(t *testing.T) {
+		for _, tc := range []struct {
+			scheme string
+			target string
+		}{
+			{"socks5h", "localhost:" + port},
+			{"socks4a", "localhost:" + port},
+			{"socks4", "127.0.0.1:" + port},
+		} {
+			s := newServer(t, "")
+			d := dialerFromURL(t, tc.scheme+"://user@"+s.l.Addr().String())
+			d.LookupIPAddr = nil
+			if tc.scheme == "socks4" {
+				d.LookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+					return []net.IPAddr{{IP: net.ParseIP("::1")}, {IP: net.IPv4(127, 0, 0, 1)}}, nil
+				}
+			}
+			c, err := d.DialContext(ctx, "tcp", "localhost:"+port)
+			if err != nil {
+				t.Fatal(tc.scheme, err)
+			}
+			checkEcho(t, c)
+			c.Close()
+			if got := s.lastTarget(); got != tc.target {
+				t.Fatalf("%s: the proxy received %s", tc.scheme, got)
+			}
+		}
+	})
+
+	t.Run("we can BIND", func(t *testing.T) {
+		for _, scheme := range []string{"socks5", "socks4"} {
+			s := newServer(t, "")
+			d := dialerFromURL(t, scheme+"://"+s.l.Addr().String())
+			l, err := d.Bind(ctx, "tcp", "0.0.0.0:1")
+			if err != nil {
+				t.Fatal(scheme, err)
+			}
+			if l.Addr().(*socks.Addr).IP.IsUnspecified() {
+				t.Fatal("expected the proxy address")
+			}
+			peer, err := net.Dial("tcp", l.Addr().String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := l.Accept()
+			if err != nil {
+				t.Fatal(scheme, err)
+			}
+			if got, want := c.(*socks.Conn).BoundAddr().String(), peer.LocalAddr().String(); got != want {
+				t.Fatalf("%s: got peer address %s, want %s", scheme, got, want)
+			}
+			if _, err := l.Accept(); err == nil {
+				t.Fatal("expected an error for the second Accept")
+			}
+			go io.Copy(peer, peer)
+			checkEcho(t, c)
+			c.Close()
+			peer.Close()
+		}
+	})
+
+	t.Run("we can UDP ASSOCIATE", func(t *testing.T) {
+		echo, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer echo.Close()
+		go func() {
+			buf := make([]byte, 2048)
+			for {
+				n, from, err := echo.ReadFrom(buf)
+				if err != nil {
+					return
+				}
+				echo.WriteTo(buf[:n], from)
+			}
+		}()
+		s := newServer(t, "")
+		d := dialerFromURL(t, "socks5://"+s.l.Addr().String())
+		pc, err := d.ListenPacket(ctx, "udp", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pc.Close()
+		if got := pc.RelayAddr().(*net.UDPAddr); !got.IP.IsLoopback() {
+			t.Fatalf("unexpected relay address: %s", got)
+		}
+		pc.SetDeadline(time.Now().Add(10 * time.Second))
+		if _, err := pc.WriteTo([]byte("query"), echo.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, 1024)
+		n, from, err := pc.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != "query" || from.String() != echo.LocalAddr().String() {
+			t.Fatalf("unexpected datagram from %s: %q", from, buf[:n])
+		}
+	})
+
+	t.Run("SOCKS4 does not support UDP ASSOCIATE", func(t *testing.T) {
+		d := dialerFromURL(t, "socks4a://127.0.0.1:1")
+		if _, err := d.ListenPacket(ctx, "udp", ""); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("we reject unsupported schemes", func(t *testing.T) {
+		if _, err := socks.FromURL(&url.URL{Scheme: "http", Host: "127.0.0.1"}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package socks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// maxUDPHeaderLen is the maximum length of the header that SOCKS5
+// prepends to each UDP datagram, which is the case of a 255-byte
+// domain name.
+const maxUDPHeaderLen = 3 + 1 + 1 + 255 + 2
+
+// A PacketConn is a UDP relay created using the UDP ASSOCIATE command.
+// Its ReadFrom and WriteTo methods exchange datagrams with the targets
+// through the proxy server. The relay lasts as long as the control
+// connection with the proxy server, which Close closes.
+type PacketConn struct {
+	net.PacketConn
+
+	ctrl  net.Conn
+	relay *net.UDPAddr
+	once  sync.Once
+}
+
+var _ net.PacketConn = &PacketConn{}
+
+// ListenPacket asks the proxy server to relay UDP datagrams using
+// the UDP ASSOCIATE command and returns the resulting PacketConn.
+// The network must be "udp", "udp4" or "udp6". The address is the
+// local address of the UDP socket used to talk with the proxy server,
+// which is also the address we send to the proxy server. When the
+// address is empty or has no host, we use the local IP address of
+// the control connection.
+func (d *Dialer) ListenPacket(ctx context.Context, network, address string) (*PacketConn, error) {
+	opError := func(err error) error {
+		proxy, _, _ := d.pathAddrs(d.proxyAddress)
+		return &net.OpError{Op: CmdUDPAssociate.String(), Net: network, Source: proxy, Err: err}
+	}
+	switch network {
+	case "udp", "udp4", "udp6":
+	default:
+		return nil, opError(errors.New("network not implemented"))
+	}
+	if d.version() != Version5 {
+		return nil, opError(errors.New("SOCKS4 does not support " + CmdUDPAssociate.String()))
+	}
+	c, err := d.dialProxy(ctx)
+	if err != nil {
+		return nil, opError(err)
+	}
+	pc, err := d.listenPacket(ctx, network, address, c)
+	if err != nil {
+		c.Close()
+		return nil, opError(err)
+	}
+	a, err := d.handshake(ctx, c, CmdUDPAssociate, pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		c.Close()
+		return nil, opError(err)
+	}
+	relay, err := d.relayAddr(ctx, c, a)
+	if err != nil {
+		pc.Close()
+		c.Close()
+		return nil, opError(err)
+	}
+	conn := &PacketConn{PacketConn: pc, ctrl: c, relay: relay}
+	go func() {
+		// The proxy server closes the control connection when the
+		// association terminates, which is also when we close it.
+		io.Copy(io.Discard, c)
+		conn.Close()
+	}()
+	return conn, nil
+}
+
+// listenPacket creates the UDP socket for talking with the proxy
+// server, where c is the control connection.
+func (d *Dialer) listenPacket(ctx context.Context, network, address string, c net.Conn) (net.PacketConn, error) {
+	host, port := "", "0"
+	if address != "" {
+		var err error
+		if host, port, err = net.SplitHostPort(address); err != nil {
+			return nil, err
+		}
+	}
+	if host == "" {
+		if la, ok := c.LocalAddr().(*net.TCPAddr); ok {
+			host = la.IP.String()
+		}
+	}
+	address = net.JoinHostPort(host, port)
+	if d.ProxyListenPacket != nil {
+		return d.ProxyListenPacket(ctx, network, address)
+	}
+	var lc net.ListenConfig
+	return lc.ListenPacket(ctx, network, address)
+}
+
+// relayAddr returns the UDP address of the relay given the address a
+// in the reply to the UDP ASSOCIATE command.
+func (d *Dialer) relayAddr(ctx context.Context, c net.Conn, a *Addr) (*net.UDPAddr, error) {
+	if a.IP != nil && !a.IP.IsUnspecified() {
+		return &net.UDPAddr{IP: a.IP, Port: a.Port}, nil
+	}
+	if a.IP == nil {
+		lookup := d.LookupIPAddr
+		if lookup == nil {
+			lookup = net.DefaultResolver.LookupIPAddr
+		}
+		addrs, err := lookup(ctx, a.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(addrs) <= 0 {
+			return nil, errors.New("no address found for " + a.Name)
+		}
+		return &net.UDPAddr{IP: addrs[0].IP, Port: a.Port}, nil
+	}
+	// The proxy server is relaying on the same address we are connected to.
+	ra, ok := c.RemoteAddr().(*net.TCPAddr)
+	if !ok {
+		return nil, errors.New("cannot determine the relay address")
+	}
+	return &net.UDPAddr{IP: ra.IP, Port: a.Port}, nil
+}
+
+// RelayAddr returns the address of the UDP relay of the proxy server.
+func (c *PacketConn) RelayAddr() net.Addr {
+	return c.relay
+}
+
+// ReadFrom reads a datagram relayed by the proxy server and returns
+// the address of the sender, which is a *net.UDPAddr or, if the proxy
+// server provides a domain name, an *Addr. We discard the datagrams
+// that do not come from the relay, that are malformed, and that are
+// fragments, since we do not implement reassembly.
+func (c *PacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	buf := make([]byte, len(p)+maxUDPHeaderLen)
+	for {
+		n, from, err := c.PacketConn.ReadFrom(buf)
+		if err != nil {
+			return 0, nil, err
+		}
+		if ua, ok := from.(*net.UDPAddr); !ok || !ua.IP.Equal(c.relay.IP) || ua.Port != c.relay.Port {
+			continue
+		}
+		if n < 3 || buf[0] != 0 || buf[1] != 0 || buf[2] != 0 {
+			continue
+		}
+		r := bytes.NewReader(buf[3:n])
+		a, err := readAddr5(r)
+		if err != nil {
+			continue
+		}
+		n = copy(p, buf[n-r.Len():n])
+		if a.IP != nil {
+			return n, &net.UDPAddr{IP: a.IP, Port: a.Port}, nil
+		}
+		return n, a, nil
+	}
+}
+
+// WriteTo sends the datagram p to addr through the proxy server. When
+// addr is an *Addr containing a domain name, the proxy server resolves
+// it. Otherwise, addr must be a *net.UDPAddr or its String method must
+// return an "ip:port" or "host:port" string.
+func (c *PacketConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	var (
+		host string
+		port int
+		err  error
+	)
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		host, port = a.IP.String(), a.Port
+	case *Addr:
+		host, port = a.Name, a.Port
+		if a.IP != nil {
+			host = a.IP.String()
+		}
+	default:
+		host, port, err = splitHostPort(addr.String())
+	}
+	if err == nil && (port < 0 || port > 0xffff) {
+		err = errors.New("port number out of range " + strconv.Itoa(port))
+	}
+	var b []byte
+	if err == nil {
+		b, err = appendAddr5(make([]byte, 3, maxUDPHeaderLen+len(p)), host, port)
+	}
+	if err != nil {
+		return 0, &net.OpError{Op: "write", Net: "udp", Source: c.LocalAddr(), Addr: addr, Err: err}
+	}
+	if _, err := c.PacketConn.WriteTo(append(b, p...), c.relay); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close closes the UDP socket and the control connection.
+func (c *PacketConn) Close() (err error) {
+	c.once.Do(func() {
+		err = c.PacketConn.Close()
+		c.ctrl.Close()
+	})
+	return
+}
@@ -0,0 +1,53 @@
+package socks
+
+import (
+	"errors"
+	"net"
+	"net/url"
+)
+
+// FromURL returns a Dialer for the proxy server at u, whose scheme
+// must be "socks5", "socks5h", "socks4" or "socks4a":
+//
+//   - with "socks5" and "socks5h", the Dialer speaks SOCKS5 and sends
+//     host names to the proxy server, which resolves them (this is
+//     what net/http does for "socks5" as well), and authenticates
+//     using the username and password in u, if any;
+//
+//   - with "socks4", the Dialer speaks SOCKS4 and resolves host names
+//     using net.DefaultResolver, while with "socks4a" it sends them
+//     to the proxy server; in both cases, the username in u, if any,
+//     is the user ID.
+//
+// When u has no port, the Dialer uses port 1080. To resolve host
+// names locally when using SOCKS5, set the LookupIPAddr field.
+func FromURL(u *url.URL) (*Dialer, error) {
+	port := u.Port()
+	if port == "" {
+		port = "1080"
+	}
+	d := NewDialer("tcp", net.JoinHostPort(u.Hostname(), port))
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		if u.User != nil {
+			auth := &UsernamePassword{Username: u.User.Username()}
+			auth.Password, _ = u.User.Password()
+			d.AuthMethods = []AuthMethod{
+				AuthMethodNotRequired,
+				AuthMethodUsernamePassword,
+			}
+			d.Authenticate = auth.Authenticate
+		}
+	case "socks4", "socks4a":
+		d.Version = Version4
+		if u.User != nil {
+			d.UserID = u.User.Username()
+		}
+		if u.Scheme == "socks4" {
+			d.LookupIPAddr = net.DefaultResolver.LookupIPAddr
+		}
+	default:
+		return nil, errors.New("socks: unsupported proxy scheme " + u.Scheme)
+	}
+	return d, nil
+}
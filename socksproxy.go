@@ -0,0 +1,40 @@
+package http
+
+// This file is an ooni/oohttp extension. It uses the socks package to
+// talk with SOCKS proxies, which adds support for "socks5h", "socks4"
+// and "socks4a" proxy URLs to the upstream support for "socks5".
+
+import (
+	"context"
+	"net"
+	"net/url"
+
+	"github.com/ooni/oohttp/socks"
+)
+
+// isSOCKSProxy returns whether proxyURL is the URL of a SOCKS proxy.
+func isSOCKSProxy(proxyURL *url.URL) bool {
+	switch proxyURL.Scheme {
+	case "socks5", "socks5h", "socks4", "socks4a":
+		return true
+	default:
+		return false
+	}
+}
+
+// dialSOCKS asks the SOCKS proxy at proxyURL, which conn is connected
+// to, to connect to target. Like upstream, we send the host name of
+// target to "socks5" proxies. Only with "socks4" proxies, which do
+// not support host names, we resolve it using t.lookupHostTraced, which
+// invokes the DNSStart and DNSDone hooks.
+func (t *Transport) dialSOCKS(ctx context.Context, conn net.Conn, proxyURL *url.URL, target string) error {
+	d, err := socks.FromURL(proxyURL)
+	if err != nil {
+		return err
+	}
+	if d.LookupIPAddr != nil {
+		d.LookupIPAddr = t.lookupHostTraced
+	}
+	_, err = d.DialWithConn(ctx, conn, "tcp", target)
+	return err
+}
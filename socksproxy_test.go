@@ -0,0 +1,147 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// serveSOCKS4 runs a SOCKS4 proxy using l and sends the address of
+// each CONNECT request to targets.
+func serveSOCKS4(l net.Listener, targets chan<- string) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			br := bufio.NewReader(conn)
+			// version, command, port and IPv4 address
+			buf := make([]byte, 8)
+			if _, err := io.ReadFull(br, buf); err != nil || buf[0] != 4 || buf[1] != 1 {
+				return
+			}
+			// user ID
+			if _, err := br.ReadString(0); err != nil {
+				return
+			}
+			target := net.JoinHostPort(net.IP(buf[4:8]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(buf[2:4]))))
+			targets <- target
+			upstream, err := net.Dial("tcp", target)
+			if err != nil {
+				conn.Write([]byte{0, 91, 0, 0, 0, 0, 0, 0})
+				return
+			}
+			defer upstream.Close()
+			if _, err := conn.Write([]byte{0, 90, 0, 0, 0, 0, 0, 0}); err != nil {
+				return
+			}
+			go io.Copy(upstream, br)
+			io.Copy(conn, upstream)
+		}()
+	}
+}
+
+func TestTransportSOCKS5HProxy(t *testing.T) {
+	srv := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	targets := make(chan string, 1)
+	go serveSOCKS5(l, targets)
+
+	t.Run("we use socks5h proxies", func(t *testing.T) {
+		proxyURL := &url.URL{Scheme: "socks5h", Host: l.Addr().String()}
+		txp := &Transport{Proxy: ProxyURL(proxyURL)}
+		defer txp.CloseIdleConnections()
+		req, _ := NewRequest("GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(data) != "hello" {
+			t.Fatalf("unexpected body: %q", data)
+		}
+		if got := <-targets; got != srv.Listener.Addr().String() {
+			t.Fatalf("SOCKS5 proxy connected to %s", got)
+		}
+	})
+}
+
+func TestTransportSOCKS4Proxy(t *testing.T) {
+	srv := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	targets := make(chan string, 1)
+	go serveSOCKS4(l, targets)
+
+	t.Run("we trace the local lookup of socks4 proxies", func(t *testing.T) {
+		proxyURL := &url.URL{Scheme: "socks4", Host: l.Addr().String()}
+		txp := &Transport{
+			Proxy: ProxyURL(proxyURL),
+			Resolver: ResolverFunc(func(ctx context.Context, host string) ([]net.IPAddr, error) {
+				if host != "example.com" {
+					t.Errorf("unexpected lookup of %s", host)
+				}
+				return []net.IPAddr{{IP: net.IPv4(127, 0, 0, 1)}}, nil
+			}),
+		}
+		defer txp.CloseIdleConnections()
+		var started, done []string
+		trace := &httptrace.ClientTrace{
+			DNSStart: func(info httptrace.DNSStartInfo) {
+				started = append(started, info.Host)
+			},
+			DNSDone: func(info httptrace.DNSDoneInfo) {
+				done = append(done, fmt.Sprint(info.Addrs, info.Err))
+			},
+		}
+		ctx := httptrace.WithClientTrace(context.Background(), trace)
+		req, _ := NewRequestWithContext(ctx, "GET", "http://example.com:"+port, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(data) != "hello" {
+			t.Fatalf("unexpected body: %q", data)
+		}
+		if got := <-targets; got != srv.Listener.Addr().String() {
+			t.Fatalf("SOCKS4 proxy connected to %s", got)
+		}
+		if len(started) != 1 || started[0] != "example.com" {
+			t.Fatalf("unexpected DNSStart calls: %v", started)
+		}
+		if len(done) != 1 || done[0] != "[{127.0.0.1 }] <nil>" {
+			t.Fatalf("unexpected DNSDone calls: %v", done)
+		}
+	})
+}
@@ -0,0 +1,388 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// This file is an ooni/oohttp extension. It converts requests and responses
+// between this package and net/http. The TestStdlibConversion test uses
+// reflection to ensure that we convert every exported field, such that
+// the test fails when a merge from upstream adds new fields.
+
+// RequestFromStdlib converts a net/http Request into a Request.
+//
+// The returned Request shares the URL, Header, Trailer, Body and other
+// reference-type fields with stdReq. The Response field and, recursively,
+// its Request field are converted as well, preserving the redirect chain.
+func RequestFromStdlib(stdReq *http.Request) *Request {
+	return newStdlibConverter().requestFromStdlib(stdReq)
+}
+
+// RequestToStdlib converts a Request into a net/http Request. It is
+// the inverse of RequestFromStdlib.
+func RequestToStdlib(req *Request) *http.Request {
+	return newStdlibConverter().requestToStdlib(req)
+}
+
+// ResponseFromStdlib converts a net/http Response into a Response.
+//
+// The returned Response shares the Header, Trailer and other reference-type
+// fields with stdResp. Its Body wraps the Body of stdResp such that trailers
+// arriving while reading the body are also visible in the Trailer of the
+// returned Response. The Request field and, recursively, its Response field
+// are converted as well, preserving the redirect chain.
+func ResponseFromStdlib(stdResp *http.Response) *Response {
+	return newStdlibConverter().responseFromStdlib(stdResp)
+}
+
+// ResponseToStdlib converts a Response into a net/http Response. It is
+// the inverse of ResponseFromStdlib.
+func ResponseToStdlib(resp *Response) *http.Response {
+	return newStdlibConverter().responseToStdlib(resp)
+}
+
+// stdlibConverter converts between this package and net/http. It remembers
+// the conversions it performed, so that converting the same pointer twice
+// yields the same result and converting back yields the original pointer.
+type stdlibConverter struct {
+	fromStdReq  map[*http.Request]*Request
+	toStdReq    map[*Request]*http.Request
+	fromStdResp map[*http.Response]*Response
+	toStdResp   map[*Response]*http.Response
+}
+
+func newStdlibConverter() *stdlibConverter {
+	return &stdlibConverter{
+		fromStdReq:  make(map[*http.Request]*Request),
+		toStdReq:    make(map[*Request]*http.Request),
+		fromStdResp: make(map[*http.Response]*Response),
+		toStdResp:   make(map[*Response]*http.Response),
+	}
+}
+
+func (c *stdlibConverter) requestFromStdlib(stdReq *http.Request) *Request {
+	if stdReq == nil {
+		return nil
+	}
+	if req, found := c.fromStdReq[stdReq]; found {
+		return req
+	}
+	req := &Request{
+		Method:           stdReq.Method,
+		URL:              stdReq.URL,
+		Proto:            stdReq.Proto,
+		ProtoMajor:       stdReq.ProtoMajor,
+		ProtoMinor:       stdReq.ProtoMinor,
+		Header:           Header(stdReq.Header),
+		Body:             stdReq.Body,
+		GetBody:          stdReq.GetBody,
+		ContentLength:    stdReq.ContentLength,
+		TransferEncoding: stdReq.TransferEncoding,
+		Close:            stdReq.Close,
+		Host:             stdReq.Host,
+		Form:             stdReq.Form,
+		PostForm:         stdReq.PostForm,
+		MultipartForm:    stdReq.MultipartForm,
+		Trailer:          Header(stdReq.Trailer),
+		RemoteAddr:       stdReq.RemoteAddr,
+		RequestURI:       stdReq.RequestURI,
+		TLS:              stdReq.TLS,
+		Cancel:           stdReq.Cancel,
+		ctx:              stdReq.Context(),
+	}
+
+	// http.NoBody is a global var with oohttp.NoBody being its analogue
+	// this guards against undefined content length in case when stdReq.Body == http.NoBody
+	if req.Body == http.NoBody {
+		req.Body = NoBody
+	}
+
+	c.fromStdReq[stdReq] = req
+	c.toStdReq[req] = stdReq
+	requestVersionFieldsFromStdlib(req, stdReq)
+	req.Response = c.responseFromStdlib(stdReq.Response)
+	return req
+}
+
+func (c *stdlibConverter) requestToStdlib(req *Request) *http.Request {
+	if req == nil {
+		return nil
+	}
+	if stdReq, found := c.toStdReq[req]; found {
+		return stdReq
+	}
+	stdReq := (&http.Request{
+		Method:           req.Method,
+		URL:              req.URL,
+		Proto:            req.Proto,
+		ProtoMajor:       req.ProtoMajor,
+		ProtoMinor:       req.ProtoMinor,
+		Header:           http.Header(req.Header),
+		Body:             req.Body,
+		GetBody:          req.GetBody,
+		ContentLength:    req.ContentLength,
+		TransferEncoding: req.TransferEncoding,
+		Close:            req.Close,
+		Host:             req.Host,
+		Form:             req.Form,
+		PostForm:         req.PostForm,
+		MultipartForm:    req.MultipartForm,
+		Trailer:          http.Header(req.Trailer),
+		RemoteAddr:       req.RemoteAddr,
+		RequestURI:       req.RequestURI,
+		TLS:              req.TLS,
+		Cancel:           req.Cancel,
+	}).WithContext(req.Context())
+
+	if stdReq.Body == NoBody {
+		stdReq.Body = http.NoBody
+	}
+
+	c.toStdReq[req] = stdReq
+	c.fromStdReq[stdReq] = req
+	requestVersionFieldsToStdlib(req, stdReq)
+	stdReq.Response = c.responseToStdlib(req.Response)
+	return stdReq
+}
+
+func (c *stdlibConverter) responseFromStdlib(stdResp *http.Response) *Response {
+	if stdResp == nil {
+		return nil
+	}
+	if resp, found := c.fromStdResp[stdResp]; found {
+		return resp
+	}
+	resp := &Response{
+		Status:           stdResp.Status,
+		StatusCode:       stdResp.StatusCode,
+		Proto:            stdResp.Proto,
+		ProtoMajor:       stdResp.ProtoMajor,
+		ProtoMinor:       stdResp.ProtoMinor,
+		Header:           Header(stdResp.Header),
+		ContentLength:    stdResp.ContentLength,
+		TransferEncoding: stdResp.TransferEncoding,
+		Close:            stdResp.Close,
+		Uncompressed:     stdResp.Uncompressed,
+		Trailer:          Header(stdResp.Trailer),
+		TLS:              stdResp.TLS,
+	}
+	resp.Body = newTrailerSyncBody(stdResp.Body, func() {
+		resp.Trailer = syncTrailer(resp.Trailer, Header(stdResp.Trailer))
+	})
+	if stdResp.Body == http.NoBody {
+		resp.Body = NoBody
+	}
+
+	c.fromStdResp[stdResp] = resp
+	c.toStdResp[resp] = stdResp
+	resp.Request = c.requestFromStdlib(stdResp.Request)
+	return resp
+}
+
+func (c *stdlibConverter) responseToStdlib(resp *Response) *http.Response {
+	if resp == nil {
+		return nil
+	}
+	if stdResp, found := c.toStdResp[resp]; found {
+		return stdResp
+	}
+	stdResp := &http.Response{
+		Status:           resp.Status,
+		StatusCode:       resp.StatusCode,
+		Proto:            resp.Proto,
+		ProtoMajor:       resp.ProtoMajor,
+		ProtoMinor:       resp.ProtoMinor,
+		Header:           http.Header(resp.Header),
+		ContentLength:    resp.ContentLength,
+		TransferEncoding: resp.TransferEncoding,
+		Close:            resp.Close,
+		Uncompressed:     resp.Uncompressed,
+		Trailer:          http.Header(resp.Trailer),
+		TLS:              resp.TLS,
+	}
+	stdResp.Body = newTrailerSyncBody(resp.Body, func() {
+		stdResp.Trailer = http.Header(syncTrailer(Header(stdResp.Trailer), resp.Trailer))
+	})
+	if resp.Body == NoBody {
+		stdResp.Body = http.NoBody
+	}
+
+	c.toStdResp[resp] = stdResp
+	c.fromStdResp[stdResp] = resp
+	stdResp.Request = c.requestToStdlib(resp.Request)
+	return stdResp
+}
+
+// syncTrailer returns the dst trailer after copying into it the values
+// of src. When dst is nil, we return src, to share the same map.
+func syncTrailer(dst, src Header) Header {
+	if dst == nil {
+		return src
+	}
+	for k, vv := range src {
+		dst[k] = vv
+	}
+	return dst
+}
+
+// newTrailerSyncBody wraps body such that sync is called when we
+// read EOF from body and when body is closed. This allows us to sync
+// trailers arriving after we have converted a response. If body also
+// implements io.Writer (e.g., for 101 Switching Protocols responses),
+// so does the returned body.
+func newTrailerSyncBody(body io.ReadCloser, sync func()) io.ReadCloser {
+	if body == nil {
+		return nil
+	}
+	tsb := &trailerSyncBody{body: body, sync: sync}
+	if w, ok := body.(io.Writer); ok {
+		return &trailerSyncWriterBody{trailerSyncBody: tsb, w: w}
+	}
+	return tsb
+}
+
+// trailerSyncBody is the body returned by newTrailerSyncBody.
+type trailerSyncBody struct {
+	body io.ReadCloser
+	sync func()
+}
+
+// Read implements io.Reader.
+func (b *trailerSyncBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	if err == io.EOF {
+		b.sync()
+	}
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *trailerSyncBody) Close() error {
+	err := b.body.Close()
+	b.sync()
+	return err
+}
+
+// trailerSyncWriterBody is a trailerSyncBody that is also an io.Writer.
+type trailerSyncWriterBody struct {
+	*trailerSyncBody
+	w io.Writer
+}
+
+// Write implements io.Writer.
+func (b *trailerSyncWriterBody) Write(p []byte) (int, error) {
+	return b.w.Write(p)
+}
+
+// pathValuesToStdlib copies the path values of req into stdReq.
+func pathValuesToStdlib(req *Request, stdReq *http.Request) {
+	if req.pat != nil {
+		idx := 0
+		for _, seg := range req.pat.segments {
+			if seg.wild && seg.s != "" {
+				if idx < len(req.matches) {
+					stdReq.SetPathValue(seg.s, req.matches[idx])
+				}
+				idx++
+			}
+		}
+	}
+	for name, value := range req.otherValues {
+		stdReq.SetPathValue(name, value)
+	}
+}
+
+// stdlibErrors maps the sentinel errors of this package
+// to the corresponding sentinel errors of net/http.
+var stdlibErrors = []struct {
+	err    error
+	stdErr error
+}{
+	{ErrNotSupported, http.ErrNotSupported},
+	{ErrHijacked, http.ErrHijacked},
+	{ErrBodyNotAllowed, http.ErrBodyNotAllowed},
+	{ErrContentLength, http.ErrContentLength},
+	{ErrAbortHandler, http.ErrAbortHandler},
+	{ErrHandlerTimeout, http.ErrHandlerTimeout},
+	{ErrUseLastResponse, http.ErrUseLastResponse},
+	{ErrBodyReadAfterClose, http.ErrBodyReadAfterClose},
+	{ErrServerClosed, http.ErrServerClosed},
+	{ErrNoCookie, http.ErrNoCookie},
+	{ErrNoLocation, http.ErrNoLocation},
+	{ErrMissingFile, http.ErrMissingFile},
+}
+
+// errorFromStdlib returns the sentinel error of this package matching
+// the net/http sentinel error wrapped by err, if any, or err otherwise.
+func errorFromStdlib(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, e := range stdlibErrors {
+		if errors.Is(err, e.stdErr) {
+			return e.err
+		}
+	}
+	return err
+}
+
+// errorToStdlib is the inverse of errorFromStdlib.
+func errorToStdlib(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, e := range stdlibErrors {
+		if errors.Is(err, e.err) {
+			return e.stdErr
+		}
+	}
+	return err
+}
+
+// cookieFromStdlib converts a net/http Cookie into a Cookie.
+func cookieFromStdlib(stdCookie *http.Cookie) *Cookie {
+	if stdCookie == nil {
+		return nil
+	}
+	cookie := &Cookie{
+		Name:       stdCookie.Name,
+		Value:      stdCookie.Value,
+		Path:       stdCookie.Path,
+		Domain:     stdCookie.Domain,
+		Expires:    stdCookie.Expires,
+		RawExpires: stdCookie.RawExpires,
+		MaxAge:     stdCookie.MaxAge,
+		Secure:     stdCookie.Secure,
+		HttpOnly:   stdCookie.HttpOnly,
+		SameSite:   SameSite(stdCookie.SameSite),
+		Raw:        stdCookie.Raw,
+		Unparsed:   stdCookie.Unparsed,
+	}
+	cookieVersionFieldsFromStdlib(cookie, stdCookie)
+	return cookie
+}
+
+// cookieToStdlib converts a Cookie into a net/http Cookie.
+func cookieToStdlib(cookie *Cookie) *http.Cookie {
+	if cookie == nil {
+		return nil
+	}
+	stdCookie := &http.Cookie{
+		Name:       cookie.Name,
+		Value:      cookie.Value,
+		Path:       cookie.Path,
+		Domain:     cookie.Domain,
+		Expires:    cookie.Expires,
+		RawExpires: cookie.RawExpires,
+		MaxAge:     cookie.MaxAge,
+		Secure:     cookie.Secure,
+		HttpOnly:   cookie.HttpOnly,
+		SameSite:   http.SameSite(cookie.SameSite),
+		Raw:        cookie.Raw,
+		Unparsed:   cookie.Unparsed,
+	}
+	cookieVersionFieldsToStdlib(cookie, stdCookie)
+	return stdCookie
+}
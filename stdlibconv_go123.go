@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package http
+
+import "net/http"
+
+// requestVersionFieldsFromStdlib converts the fields of stdReq
+// that depend on the Go version we're compiling with.
+func requestVersionFieldsFromStdlib(req *Request, stdReq *http.Request) {
+	if stdReq.Pattern == "" {
+		return
+	}
+	pat, err := parsePattern(stdReq.Pattern)
+	if err != nil {
+		return
+	}
+	req.pat = pat
+	req.matches = nil
+	for _, seg := range pat.segments {
+		if seg.wild && seg.s != "" {
+			req.matches = append(req.matches, stdReq.PathValue(seg.s))
+		}
+	}
+}
+
+// requestVersionFieldsToStdlib converts the fields of req
+// that depend on the Go version we're compiling with.
+func requestVersionFieldsToStdlib(req *Request, stdReq *http.Request) {
+	if req.pat != nil {
+		stdReq.Pattern = req.pat.String()
+	}
+	pathValuesToStdlib(req, stdReq)
+}
+
+// cookieVersionFieldsFromStdlib converts the fields of stdCookie
+// that depend on the Go version we're compiling with.
+func cookieVersionFieldsFromStdlib(cookie *Cookie, stdCookie *http.Cookie) {
+	cookie.Partitioned = stdCookie.Partitioned
+}
+
+// cookieVersionFieldsToStdlib converts the fields of cookie
+// that depend on the Go version we're compiling with.
+func cookieVersionFieldsToStdlib(cookie *Cookie, stdCookie *http.Cookie) {
+	stdCookie.Partitioned = cookie.Partitioned
+}
@@ -0,0 +1,30 @@
+//go:build !go1.23
+
+package http
+
+import "net/http"
+
+// requestVersionFieldsFromStdlib converts the fields of stdReq
+// that depend on the Go version we're compiling with.
+func requestVersionFieldsFromStdlib(req *Request, stdReq *http.Request) {
+	// Before Go 1.23 there is no way to know the pattern that
+	// matched stdReq, so we cannot convert its path values.
+}
+
+// requestVersionFieldsToStdlib converts the fields of req
+// that depend on the Go version we're compiling with.
+func requestVersionFieldsToStdlib(req *Request, stdReq *http.Request) {
+	pathValuesToStdlib(req, stdReq)
+}
+
+// cookieVersionFieldsFromStdlib converts the fields of stdCookie
+// that depend on the Go version we're compiling with.
+func cookieVersionFieldsFromStdlib(cookie *Cookie, stdCookie *http.Cookie) {
+	// Before Go 1.23 net/http does not parse the Partitioned attribute.
+}
+
+// cookieVersionFieldsToStdlib converts the fields of cookie
+// that depend on the Go version we're compiling with.
+func cookieVersionFieldsToStdlib(cookie *Cookie, stdCookie *http.Cookie) {
+	// Before Go 1.23 net/http cannot represent the Partitioned attribute.
+}
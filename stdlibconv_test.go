@@ -0,0 +1,211 @@
+package http_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	oohttp "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// fillExportedFields sets every exported field of the struct pointed
+// to by ptr to a non-zero value, failing if it does not know how to.
+func fillExportedFields(t *testing.T, ptr any) {
+	t.Helper()
+	rv := reflect.ValueOf(ptr).Elem()
+	rt := rv.Type()
+	readCloser := reflect.TypeOf((*io.ReadCloser)(nil)).Elem()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		switch {
+		case sf.Name == "Pattern" && sf.Type.Kind() == reflect.String:
+			fv.SetString("GET /{name}/")
+		case sf.Type == readCloser:
+			fv.Set(reflect.ValueOf(io.NopCloser(strings.NewReader("abc"))))
+		default:
+			switch sf.Type.Kind() {
+			case reflect.String:
+				fv.SetString("x")
+			case reflect.Int, reflect.Int64:
+				fv.SetInt(1)
+			case reflect.Bool:
+				fv.SetBool(true)
+			case reflect.Pointer:
+				fv.Set(reflect.New(sf.Type.Elem()))
+			case reflect.Map:
+				fv.Set(reflect.MakeMap(sf.Type))
+			case reflect.Slice:
+				fv.Set(reflect.MakeSlice(sf.Type, 1, 1))
+			case reflect.Chan:
+				ch := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, sf.Type.Elem()), 0)
+				fv.Set(ch.Convert(sf.Type))
+			case reflect.Func:
+				fv.Set(reflect.MakeFunc(sf.Type, func(args []reflect.Value) []reflect.Value {
+					panic("should not be called")
+				}))
+			default:
+				t.Fatalf("%s.%s: don't know how to fill a %s", rt.Name(), sf.Name, sf.Type)
+			}
+		}
+	}
+}
+
+// checkExportedFields fails if any exported field of the struct
+// pointed to by ptr, except the ones in skip, contains the zero value.
+func checkExportedFields(t *testing.T, ptr any, skip ...string) {
+	t.Helper()
+	rv := reflect.ValueOf(ptr).Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.IsExported() && !slices.Contains(skip, sf.Name) && rv.Field(i).IsZero() {
+			t.Errorf("field %s.%s was not converted", rt, sf.Name)
+		}
+	}
+}
+
+type stdlibConvCtxKey struct{}
+
+func TestStdlibConversion(t *testing.T) {
+	ctx := context.WithValue(context.Background(), stdlibConvCtxKey{}, "value")
+
+	t.Run("net/http Request -> Request -> net/http Request", func(t *testing.T) {
+		stdReq := (&http.Request{}).WithContext(ctx)
+		fillExportedFields(t, stdReq)
+		req := oohttp.RequestFromStdlib(stdReq)
+		checkExportedFields(t, req)
+		back := oohttp.RequestToStdlib(req)
+		checkExportedFields(t, back)
+		if back.Context().Value(stdlibConvCtxKey{}) != "value" {
+			t.Fatal("did not convert the context")
+		}
+	})
+
+	t.Run("Request -> net/http Request -> Request", func(t *testing.T) {
+		req := (&oohttp.Request{}).WithContext(ctx)
+		fillExportedFields(t, req)
+		stdReq := oohttp.RequestToStdlib(req)
+		// Our Request has no Pattern field: we only set the Pattern
+		// of stdReq when req has been routed by a ServeMux.
+		checkExportedFields(t, stdReq, "Pattern")
+		back := oohttp.RequestFromStdlib(stdReq)
+		checkExportedFields(t, back)
+		if back.Context().Value(stdlibConvCtxKey{}) != "value" {
+			t.Fatal("did not convert the context")
+		}
+	})
+
+	t.Run("net/http Response -> Response -> net/http Response", func(t *testing.T) {
+		stdResp := &http.Response{}
+		fillExportedFields(t, stdResp)
+		resp := oohttp.ResponseFromStdlib(stdResp)
+		checkExportedFields(t, resp)
+		checkExportedFields(t, oohttp.ResponseToStdlib(resp))
+	})
+
+	t.Run("Response -> net/http Response -> Response", func(t *testing.T) {
+		resp := &oohttp.Response{}
+		fillExportedFields(t, resp)
+		stdResp := oohttp.ResponseToStdlib(resp)
+		checkExportedFields(t, stdResp)
+		checkExportedFields(t, oohttp.ResponseFromStdlib(stdResp))
+	})
+
+	t.Run("we convert NoBody", func(t *testing.T) {
+		stdReq := &http.Request{Body: http.NoBody}
+		if oohttp.RequestFromStdlib(stdReq).Body != oohttp.NoBody {
+			t.Fatal("did not convert http.NoBody")
+		}
+		req := &oohttp.Request{Body: oohttp.NoBody}
+		if oohttp.RequestToStdlib(req).Body != http.NoBody {
+			t.Fatal("did not convert oohttp.NoBody")
+		}
+		resp := &oohttp.Response{Body: oohttp.NoBody}
+		if oohttp.ResponseToStdlib(resp).Body != http.NoBody {
+			t.Fatal("did not convert oohttp.NoBody")
+		}
+	})
+
+	t.Run("we preserve the redirect chain", func(t *testing.T) {
+		req0 := &oohttp.Request{Method: "GET"}
+		resp0 := &oohttp.Response{StatusCode: 302, Request: req0}
+		req1 := &oohttp.Request{Method: "GET", Response: resp0}
+		resp1 := &oohttp.Response{StatusCode: 200, Request: req1}
+		stdResp1 := oohttp.ResponseToStdlib(resp1)
+		stdReq1 := stdResp1.Request
+		if stdReq1 == nil || stdReq1.Response == nil || stdReq1.Response.StatusCode != 302 {
+			t.Fatal("did not convert the redirect chain")
+		}
+		if stdReq1.Response.Request == nil || stdReq1.Response.Request.Response != nil {
+			t.Fatal("did not convert the first request")
+		}
+		if back := oohttp.ResponseFromStdlib(stdResp1); back.Request.Response.Request.Method != "GET" {
+			t.Fatal("did not convert the redirect chain back")
+		}
+	})
+
+	t.Run("we preserve the path values", func(t *testing.T) {
+		mux := oohttp.NewServeMux()
+		var stdReq *http.Request
+		mux.HandleFunc("GET /{name}/", func(w oohttp.ResponseWriter, r *oohttp.Request) {
+			r.SetPathValue("other", "value")
+			stdReq = oohttp.RequestToStdlib(r)
+		})
+		req, _ := oohttp.NewRequest("GET", "http://example.com/foo/", nil)
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+		if stdReq == nil {
+			t.Fatal("handler not called")
+		}
+		if stdReq.PathValue("name") != "foo" || stdReq.PathValue("other") != "value" {
+			t.Fatal("did not convert the path values")
+		}
+	})
+
+	t.Run("we sync trailers set after reading the body", func(t *testing.T) {
+		resp := &oohttp.Response{}
+		resp.Body = &lateTrailerBody{
+			Reader: strings.NewReader("abc"),
+			setTrailer: func() {
+				resp.Trailer = oohttp.Header{"X-Late": {"value"}}
+			},
+		}
+		stdResp := oohttp.ResponseToStdlib(resp)
+		if stdResp.Trailer != nil {
+			t.Fatal("expected nil trailer")
+		}
+		if _, err := io.ReadAll(stdResp.Body); err != nil {
+			t.Fatal(err)
+		}
+		if stdResp.Trailer.Get("X-Late") != "value" {
+			t.Fatal("did not sync the trailer")
+		}
+	})
+}
+
+// lateTrailerBody calls setTrailer just before returning io.EOF,
+// like the Transport does when it reads the trailers.
+type lateTrailerBody struct {
+	io.Reader
+	setTrailer func()
+}
+
+func (b *lateTrailerBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		b.setTrailer()
+	}
+	return n, err
+}
+
+func (b *lateTrailerBody) Close() error {
+	return nil
+}
@@ -0,0 +1,253 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// This file is an ooni/oohttp extension. It allows mounting net/http
+// handlers (e.g., middleware) on a Server of this package and mounting
+// handlers of this package on a net/http server.
+
+// HandlerFromStdlib adapts a net/http Handler to be a Handler. The
+// returned Handler converts the request using RequestFromStdlib and
+// the ResponseWriter using ResponseWriterToStdlib. A handler panicking
+// with http.ErrAbortHandler is converted to a panic with ErrAbortHandler.
+//
+// HandlerFromStdlib(HandlerToStdlib(h)) returns h.
+func HandlerFromStdlib(stdHandler http.Handler) Handler {
+	if h, ok := stdHandler.(*handlerToStdlib); ok {
+		return h.h
+	}
+	return &handlerFromStdlib{stdHandler}
+}
+
+// HandlerToStdlib adapts a Handler to be a net/http Handler. It is
+// the inverse of HandlerFromStdlib.
+//
+// HandlerToStdlib(HandlerFromStdlib(h)) returns h.
+func HandlerToStdlib(h Handler) http.Handler {
+	if stdHandler, ok := h.(*handlerFromStdlib); ok {
+		return stdHandler.h
+	}
+	return &handlerToStdlib{h}
+}
+
+// handlerFromStdlib is the Handler returned by HandlerFromStdlib.
+type handlerFromStdlib struct {
+	h http.Handler
+}
+
+// ServeHTTP implements Handler.
+func (h *handlerFromStdlib) ServeHTTP(w ResponseWriter, r *Request) {
+	defer func() {
+		if v := recover(); v != nil {
+			if v == http.ErrAbortHandler {
+				v = ErrAbortHandler
+			}
+			panic(v)
+		}
+	}()
+	stdReq := RequestToStdlib(r)
+	if addr, ok := r.Context().Value(LocalAddrContextKey).(net.Addr); ok {
+		stdReq = stdReq.WithContext(context.WithValue(stdReq.Context(), http.LocalAddrContextKey, addr))
+	}
+	h.h.ServeHTTP(ResponseWriterToStdlib(w), stdReq)
+}
+
+// handlerToStdlib is the net/http Handler returned by HandlerToStdlib.
+type handlerToStdlib struct {
+	h Handler
+}
+
+// ServeHTTP implements http.Handler.
+func (h *handlerToStdlib) ServeHTTP(stdW http.ResponseWriter, stdReq *http.Request) {
+	defer func() {
+		if v := recover(); v != nil {
+			if v == ErrAbortHandler {
+				v = http.ErrAbortHandler
+			}
+			panic(v)
+		}
+	}()
+	req := RequestFromStdlib(stdReq)
+	if addr, ok := stdReq.Context().Value(http.LocalAddrContextKey).(net.Addr); ok {
+		req = req.WithContext(context.WithValue(req.Context(), LocalAddrContextKey, addr))
+	}
+	h.h.ServeHTTP(ResponseWriterFromStdlib(stdW), req)
+}
+
+// ResponseWriterFromStdlib adapts a net/http ResponseWriter to be
+// a ResponseWriter. The returned ResponseWriter implements Flusher,
+// Hijacker and Pusher, and it works with ResponseController, by
+// delegating to stdW through a net/http ResponseController. When
+// stdW does not support a feature, the corresponding method returns
+// an error matching ErrNotSupported.
+//
+// ResponseWriterFromStdlib(ResponseWriterToStdlib(w)) returns w.
+func ResponseWriterFromStdlib(stdW http.ResponseWriter) ResponseWriter {
+	if w, ok := stdW.(*responseWriterToStdlib); ok {
+		return w.w
+	}
+	return &responseWriterFromStdlib{w: stdW, rc: http.NewResponseController(stdW)}
+}
+
+// ResponseWriterToStdlib adapts a ResponseWriter to be a net/http
+// ResponseWriter. It is the inverse of ResponseWriterFromStdlib.
+//
+// ResponseWriterToStdlib(ResponseWriterFromStdlib(w)) returns w.
+func ResponseWriterToStdlib(w ResponseWriter) http.ResponseWriter {
+	if stdW, ok := w.(*responseWriterFromStdlib); ok {
+		return stdW.w
+	}
+	return &responseWriterToStdlib{w: w, rc: NewResponseController(w)}
+}
+
+// responseWriterFromStdlib is the ResponseWriter
+// returned by ResponseWriterFromStdlib.
+type responseWriterFromStdlib struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+var (
+	_ Flusher  = &responseWriterFromStdlib{}
+	_ Hijacker = &responseWriterFromStdlib{}
+	_ Pusher   = &responseWriterFromStdlib{}
+)
+
+// Header implements ResponseWriter.
+func (w *responseWriterFromStdlib) Header() Header {
+	return Header(w.w.Header())
+}
+
+// Write implements ResponseWriter.
+func (w *responseWriterFromStdlib) Write(data []byte) (int, error) {
+	n, err := w.w.Write(data)
+	return n, errorFromStdlib(err)
+}
+
+// WriteHeader implements ResponseWriter.
+func (w *responseWriterFromStdlib) WriteHeader(statusCode int) {
+	w.w.WriteHeader(statusCode)
+}
+
+// Flush implements Flusher.
+func (w *responseWriterFromStdlib) Flush() {
+	w.FlushError()
+}
+
+// FlushError is like Flush but returns an error.
+func (w *responseWriterFromStdlib) FlushError() error {
+	return errorFromStdlib(w.rc.Flush())
+}
+
+// Hijack implements Hijacker.
+func (w *responseWriterFromStdlib) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := w.rc.Hijack()
+	return conn, brw, errorFromStdlib(err)
+}
+
+// SetReadDeadline allows using ResponseController.SetReadDeadline.
+func (w *responseWriterFromStdlib) SetReadDeadline(deadline time.Time) error {
+	return errorFromStdlib(w.rc.SetReadDeadline(deadline))
+}
+
+// SetWriteDeadline allows using ResponseController.SetWriteDeadline.
+func (w *responseWriterFromStdlib) SetWriteDeadline(deadline time.Time) error {
+	return errorFromStdlib(w.rc.SetWriteDeadline(deadline))
+}
+
+// EnableFullDuplex allows using ResponseController.EnableFullDuplex.
+func (w *responseWriterFromStdlib) EnableFullDuplex() error {
+	return errorFromStdlib(w.rc.EnableFullDuplex())
+}
+
+// Push implements Pusher.
+func (w *responseWriterFromStdlib) Push(target string, opts *PushOptions) error {
+	pusher, ok := w.w.(http.Pusher)
+	if !ok {
+		return ErrNotSupported
+	}
+	var stdOpts *http.PushOptions
+	if opts != nil {
+		stdOpts = &http.PushOptions{Method: opts.Method, Header: http.Header(opts.Header)}
+	}
+	return errorFromStdlib(pusher.Push(target, stdOpts))
+}
+
+// responseWriterToStdlib is the net/http ResponseWriter
+// returned by ResponseWriterToStdlib.
+type responseWriterToStdlib struct {
+	w  ResponseWriter
+	rc *ResponseController
+}
+
+var (
+	_ http.Flusher  = &responseWriterToStdlib{}
+	_ http.Hijacker = &responseWriterToStdlib{}
+	_ http.Pusher   = &responseWriterToStdlib{}
+)
+
+// Header implements http.ResponseWriter.
+func (w *responseWriterToStdlib) Header() http.Header {
+	return http.Header(w.w.Header())
+}
+
+// Write implements http.ResponseWriter.
+func (w *responseWriterToStdlib) Write(data []byte) (int, error) {
+	n, err := w.w.Write(data)
+	return n, errorToStdlib(err)
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *responseWriterToStdlib) WriteHeader(statusCode int) {
+	w.w.WriteHeader(statusCode)
+}
+
+// Flush implements http.Flusher.
+func (w *responseWriterToStdlib) Flush() {
+	w.FlushError()
+}
+
+// FlushError is like Flush but returns an error.
+func (w *responseWriterToStdlib) FlushError() error {
+	return errorToStdlib(w.rc.Flush())
+}
+
+// Hijack implements http.Hijacker.
+func (w *responseWriterToStdlib) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := w.rc.Hijack()
+	return conn, brw, errorToStdlib(err)
+}
+
+// SetReadDeadline allows using http.ResponseController.SetReadDeadline.
+func (w *responseWriterToStdlib) SetReadDeadline(deadline time.Time) error {
+	return errorToStdlib(w.rc.SetReadDeadline(deadline))
+}
+
+// SetWriteDeadline allows using http.ResponseController.SetWriteDeadline.
+func (w *responseWriterToStdlib) SetWriteDeadline(deadline time.Time) error {
+	return errorToStdlib(w.rc.SetWriteDeadline(deadline))
+}
+
+// EnableFullDuplex allows using http.ResponseController.EnableFullDuplex.
+func (w *responseWriterToStdlib) EnableFullDuplex() error {
+	return errorToStdlib(w.rc.EnableFullDuplex())
+}
+
+// Push implements http.Pusher.
+func (w *responseWriterToStdlib) Push(target string, stdOpts *http.PushOptions) error {
+	pusher, ok := w.w.(Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+	var opts *PushOptions
+	if stdOpts != nil {
+		opts = &PushOptions{Method: stdOpts.Method, Header: Header(stdOpts.Header)}
+	}
+	return errorToStdlib(pusher.Push(target, opts))
+}
@@ -0,0 +1,123 @@
+package http_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	stdhttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/httptest"
+)
+
+func TestStdlibHandler(t *testing.T) {
+	t.Run("we can mount a net/http Handler on a Server", func(t *testing.T) {
+		srv := httptest.NewServer(oohttp.HandlerFromStdlib(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr); !ok {
+				t.Error("missing local address")
+			}
+			rc := http.NewResponseController(w)
+			if err := rc.SetWriteDeadline(time.Now().Add(time.Minute)); err != nil {
+				t.Error(err)
+			}
+			if err := rc.EnableFullDuplex(); err != nil {
+				t.Error(err)
+			}
+			w.Header().Set("X-Handler", "stdlib")
+			io.WriteString(w, r.URL.Path)
+			if err := rc.Flush(); err != nil {
+				t.Error(err)
+			}
+			if err := w.(http.Pusher).Push("/x", nil); !errors.Is(err, http.ErrNotSupported) {
+				t.Errorf("unexpected push error: %v", err)
+			}
+		})))
+		defer srv.Close()
+		resp, err := srv.Client().Get(srv.URL + "/path")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "/path" || resp.Header.Get("X-Handler") != "stdlib" {
+			t.Fatalf("unexpected response: %q %v", body, resp.Header)
+		}
+	})
+
+	t.Run("we can mount a Handler on a net/http Server", func(t *testing.T) {
+		srv := stdhttptest.NewServer(oohttp.HandlerToStdlib(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+			if _, ok := r.Context().Value(oohttp.LocalAddrContextKey).(net.Addr); !ok {
+				t.Error("missing local address")
+			}
+			if err := oohttp.NewResponseController(w).SetReadDeadline(time.Now().Add(time.Minute)); err != nil {
+				t.Error(err)
+			}
+			conn, brw, err := w.(oohttp.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer conn.Close()
+			brw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+			brw.Flush()
+		})))
+		defer srv.Close()
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "hijacked" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+	})
+
+	t.Run("we convert the ErrNotSupported errors", func(t *testing.T) {
+		rec := stdhttptest.NewRecorder()
+		w := oohttp.ResponseWriterFromStdlib(rec)
+		if _, _, err := w.(oohttp.Hijacker).Hijack(); !errors.Is(err, oohttp.ErrNotSupported) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := oohttp.NewResponseController(w).SetWriteDeadline(time.Time{}); !errors.Is(err, oohttp.ErrNotSupported) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		w.(oohttp.Flusher).Flush()
+		if !rec.Flushed {
+			t.Fatal("did not flush")
+		}
+	})
+
+	t.Run("we convert ErrAbortHandler panics", func(t *testing.T) {
+		h := oohttp.HandlerFromStdlib(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+		defer func() {
+			if v := recover(); v != oohttp.ErrAbortHandler {
+				t.Fatalf("unexpected panic value: %v", v)
+			}
+		}()
+		req, _ := oohttp.NewRequest("GET", "http://example.com/", nil)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	})
+
+	t.Run("we unwrap adapters instead of wrapping them again", func(t *testing.T) {
+		h := &oohttp.ServeMux{}
+		if oohttp.HandlerFromStdlib(oohttp.HandlerToStdlib(h)) != oohttp.Handler(h) {
+			t.Fatal("did not unwrap the Handler")
+		}
+		rec := stdhttptest.NewRecorder()
+		if oohttp.ResponseWriterToStdlib(oohttp.ResponseWriterFromStdlib(rec)) != http.ResponseWriter(rec) {
+			t.Fatal("did not unwrap the ResponseWriter")
+		}
+	})
+}
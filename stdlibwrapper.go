@@ -1,6 +1,9 @@
 package http
 
-import "net/http"
+import (
+	"net/http"
+	"net/url"
+)
 
 // StdlibTransport is an adapter for integrating net/http dependend code.
 // It looks like an http.RoundTripper but uses this fork internally.
@@ -10,56 +13,91 @@ type StdlibTransport struct {
 
 // RoundTrip implements the http.RoundTripper interface.
 func (txp *StdlibTransport) RoundTrip(stdReq *http.Request) (*http.Response, error) {
-	req := &Request{
-		Method:           stdReq.Method,
-		URL:              stdReq.URL,
-		Proto:            stdReq.Proto,
-		ProtoMajor:       stdReq.ProtoMajor,
-		ProtoMinor:       stdReq.ProtoMinor,
-		Header:           Header(stdReq.Header),
-		Body:             stdReq.Body,
-		GetBody:          stdReq.GetBody,
-		ContentLength:    stdReq.ContentLength,
-		TransferEncoding: stdReq.TransferEncoding,
-		Close:            stdReq.Close,
-		Host:             stdReq.Host,
-		Form:             stdReq.Form,
-		PostForm:         stdReq.PostForm,
-		MultipartForm:    stdReq.MultipartForm,
-		Trailer:          Header(stdReq.Trailer),
-		RemoteAddr:       stdReq.RemoteAddr,
-		RequestURI:       stdReq.RequestURI,
-		TLS:              stdReq.TLS,
-		Cancel:           stdReq.Cancel,
-		Response:         nil, // cannot assign this field
-		ctx:              stdReq.Context(),
+	return roundTripStdlib(txp.Transport, stdReq)
+}
+
+// roundTripStdlib performs a round trip of stdReq using rt.
+func roundTripStdlib(rt RoundTripper, stdReq *http.Request) (*http.Response, error) {
+	conv := newStdlibConverter()
+	req := conv.requestFromStdlib(stdReq)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
 	}
+	// Because conv remembers the conversions, resp.Request maps back to stdReq.
+	return conv.responseToStdlib(resp), nil
+}
 
-	// http.NoBody is a global var with oohttp.NoBody being its analogue
-	// this guards against undefined content length in case when stdReq.Body == http.NoBody
-	if req.Body == http.NoBody {
-		req.Body = NoBody
+// ClientToStdlib returns a net/http Client for integrating with code
+// that requires one, e.g., third-party SDKs. The returned Client uses
+// the Transport, CheckRedirect, Jar and Timeout of c. Because the
+// returned Client wraps those fields, subsequently modifying the
+// fields of c does not affect the returned Client.
+func ClientToStdlib(c *Client) *http.Client {
+	stdClient := &http.Client{
+		Transport: &stdlibRoundTripper{c.transport()},
+		Timeout:   c.Timeout,
+	}
+	if checkRedirect := c.CheckRedirect; checkRedirect != nil {
+		stdClient.CheckRedirect = func(stdReq *http.Request, stdVia []*http.Request) error {
+			conv := newStdlibConverter()
+			via := make([]*Request, 0, len(stdVia))
+			for _, r := range stdVia {
+				via = append(via, conv.requestFromStdlib(r))
+			}
+			return errorToStdlib(checkRedirect(conv.requestFromStdlib(stdReq), via))
+		}
 	}
+	if c.Jar != nil {
+		stdClient.Jar = &stdlibCookieJar{c.Jar}
+	}
+	return stdClient
+}
 
-	resp, err := txp.Transport.RoundTrip(req)
-	if err != nil {
-		return nil, err
+// stdlibRoundTripper adapts a RoundTripper to be an http.RoundTripper.
+type stdlibRoundTripper struct {
+	rt RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (txp *stdlibRoundTripper) RoundTrip(stdReq *http.Request) (*http.Response, error) {
+	return roundTripStdlib(txp.rt, stdReq)
+}
+
+// CloseIdleConnections allows http.Client.CloseIdleConnections to
+// close the idle connections of the wrapped RoundTripper.
+func (txp *stdlibRoundTripper) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if tr, ok := txp.rt.(closeIdler); ok {
+		tr.CloseIdleConnections()
+	}
+}
+
+// stdlibCookieJar adapts a CookieJar to be an http.CookieJar.
+type stdlibCookieJar struct {
+	jar CookieJar
+}
+
+// SetCookies implements http.CookieJar.
+func (j *stdlibCookieJar) SetCookies(u *url.URL, stdCookies []*http.Cookie) {
+	cookies := make([]*Cookie, 0, len(stdCookies))
+	for _, c := range stdCookies {
+		cookies = append(cookies, cookieFromStdlib(c))
+	}
+	j.jar.SetCookies(u, cookies)
+}
+
+// Cookies implements http.CookieJar.
+func (j *stdlibCookieJar) Cookies(u *url.URL) []*http.Cookie {
+	cookies := j.jar.Cookies(u)
+	if len(cookies) <= 0 {
+		return nil
 	}
-	stdResp := &http.Response{
-		Status:           resp.Status,
-		StatusCode:       resp.StatusCode,
-		Proto:            resp.Proto,
-		ProtoMinor:       resp.ProtoMinor,
-		ProtoMajor:       resp.ProtoMajor,
-		Header:           http.Header(resp.Header),
-		Body:             resp.Body,
-		ContentLength:    resp.ContentLength,
-		TransferEncoding: resp.TransferEncoding,
-		Close:            resp.Close,
-		Uncompressed:     resp.Uncompressed,
-		Trailer:          http.Header(resp.Trailer),
-		Request:          stdReq,
-		TLS:              resp.TLS,
+	stdCookies := make([]*http.Cookie, 0, len(cookies))
+	for _, c := range cookies {
+		stdCookies = append(stdCookies, cookieToStdlib(c))
 	}
-	return stdResp, nil
+	return stdCookies
 }
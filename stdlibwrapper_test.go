@@ -3,10 +3,14 @@ package http_test
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"net/url"
 	"testing"
 
 	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/cookiejar"
+	"github.com/ooni/oohttp/httptest"
 )
 
 func TestStdlibWrapper(t *testing.T) {
@@ -30,3 +34,105 @@ func TestStdlibWrapper(t *testing.T) {
 		}
 	})
 }
+
+func TestStdlibWrapperTrailersAndRequest(t *testing.T) {
+	for _, enableHTTP2 := range []bool{false, true} {
+		name := "with HTTP/1.1"
+		if enableHTTP2 {
+			name = "with HTTP/2"
+		}
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewUnstartedServer(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+				w.Write([]byte("hello"))
+				w.(oohttp.Flusher).Flush()
+				// Undeclared trailer, which arrives after we read the headers.
+				w.Header().Set(oohttp.TrailerPrefix+"X-Late", "value")
+			}))
+			srv.EnableHTTP2 = enableHTTP2
+			srv.StartTLS()
+			defer srv.Close()
+
+			txp := &oohttp.StdlibTransport{
+				Transport: srv.Client().Transport.(*oohttp.Transport),
+			}
+			stdReq, err := http.NewRequest("GET", srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stdResp, err := txp.RoundTrip(stdReq)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stdResp.Body.Close()
+			if stdResp.Request != stdReq {
+				t.Fatal("stdResp.Request is not the original request")
+			}
+			if _, err := io.ReadAll(stdResp.Body); err != nil {
+				t.Fatal(err)
+			}
+			if got := stdResp.Trailer.Get("X-Late"); got != "value" {
+				t.Fatalf("unexpected trailer: %v", stdResp.Trailer)
+			}
+		})
+	}
+}
+
+func TestClientToStdlib(t *testing.T) {
+	srv := httptest.NewServer(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+		if r.URL.Path == "/redirect" {
+			oohttp.SetCookie(w, &oohttp.Cookie{Name: "session", Value: "abc"})
+			oohttp.Redirect(w, r, "/final", oohttp.StatusFound)
+			return
+		}
+		if cookie, err := r.Cookie("session"); err == nil {
+			w.Write([]byte(cookie.Value))
+		}
+	}))
+	defer srv.Close()
+
+	t.Run("we use the Transport and the Jar", func(t *testing.T) {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := oohttp.ClientToStdlib(&oohttp.Client{Transport: srv.Client().Transport, Jar: jar})
+		resp, err := client.Get(srv.URL + "/redirect")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "abc" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+		u, _ := url.Parse(srv.URL)
+		if cookies := jar.Cookies(u); len(cookies) != 1 || cookies[0].Value != "abc" {
+			t.Fatalf("unexpected cookies: %v", cookies)
+		}
+	})
+
+	t.Run("we use CheckRedirect", func(t *testing.T) {
+		var via []*oohttp.Request
+		client := oohttp.ClientToStdlib(&oohttp.Client{
+			Transport: srv.Client().Transport,
+			CheckRedirect: func(req *oohttp.Request, v []*oohttp.Request) error {
+				via = v
+				return oohttp.ErrUseLastResponse
+			},
+		})
+		resp, err := client.Get(srv.URL + "/redirect")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusFound {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+		if len(via) != 1 || via[0].URL.Path != "/redirect" {
+			t.Fatalf("unexpected via: %v", via)
+		}
+	})
+}
@@ -42,3 +42,51 @@ func (t *Transport) tlsClientFactory(conn net.Conn, config *tls.Config) TLSConn
 	}
 	return TLSClientFactory(conn, config)
 }
+
+// TLSServerFactory is the factory used by the [Server] for wrapping
+// accepted connections into a TLSConn. By default, this will call the
+// tls.Server func. You'll need to override this factory if you want
+// to serve using a TLS library other than crypto/tls.
+var TLSServerFactory = func(conn net.Conn, config *tls.Config) TLSConn {
+	return tls.Server(conn, config)
+}
+
+// tlsServerFactory calls srv.TLSServerFactory if set, otherwise
+// it calls the oohttp.TLSServerFactory global factory.
+func (srv *Server) tlsServerFactory(conn net.Conn, config *tls.Config) TLSConn {
+	if srv.TLSServerFactory != nil {
+		return srv.TLSServerFactory(conn, config)
+	}
+	return TLSServerFactory(conn, config)
+}
+
+// NewTLSListener is like tls.NewListener except that it uses the
+// given factory for wrapping each accepted connection. When the
+// factory is nil, we use the oohttp.TLSServerFactory global factory.
+//
+// The config argument must be non-nil and must include at least one
+// certificate or else set GetCertificate, unless the factory does
+// not rely on the config for obtaining certificates.
+func NewTLSListener(inner net.Listener, config *tls.Config,
+	factory func(conn net.Conn, config *tls.Config) TLSConn) net.Listener {
+	if factory == nil {
+		factory = TLSServerFactory
+	}
+	return &tlsListener{Listener: inner, config: config, factory: factory}
+}
+
+// tlsListener is the net.Listener returned by NewTLSListener.
+type tlsListener struct {
+	net.Listener
+	config  *tls.Config
+	factory func(conn net.Conn, config *tls.Config) TLSConn
+}
+
+// Accept implements net.Listener.
+func (ln *tlsListener) Accept() (net.Conn, error) {
+	conn, err := ln.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return ln.factory(conn, ln.config), nil
+}
@@ -0,0 +1,120 @@
+package http_test
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/httptest"
+	"github.com/ooni/oohttp/internal/testcert"
+)
+
+// countingTLSServerFactory returns a TLS server factory wrapping
+// crypto/tls and counting the number of wrapped conns.
+func countingTLSServerFactory(count *atomic.Int64) func(net.Conn, *tls.Config) oohttp.TLSConn {
+	return func(conn net.Conn, config *tls.Config) oohttp.TLSConn {
+		count.Add(1)
+		return tls.Server(conn, config)
+	}
+}
+
+func TestTLSServerFactory(t *testing.T) {
+	for _, enableHTTP2 := range []bool{false, true} {
+		name := "with HTTP/1.1"
+		wantProto := 1
+		if enableHTTP2 {
+			name = "with HTTP/2"
+			wantProto = 2
+		}
+
+		t.Run("httptest uses the per-Server factory "+name, func(t *testing.T) {
+			if enableHTTP2 {
+				oohttp.CondSkipHTTP2(t)
+			}
+			var count atomic.Int64
+			srv := httptest.NewUnstartedServer(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {
+				io.WriteString(w, r.Proto)
+			}))
+			srv.EnableHTTP2 = enableHTTP2
+			srv.Config.TLSServerFactory = countingTLSServerFactory(&count)
+			srv.StartTLS()
+			defer srv.Close()
+
+			resp, err := srv.Client().Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if _, err := io.ReadAll(resp.Body); err != nil {
+				t.Fatal(err)
+			}
+			if resp.ProtoMajor != wantProto {
+				t.Fatal("unexpected protocol", resp.Proto)
+			}
+			if count.Load() != 1 {
+				t.Fatal("expected one wrapped conn, got", count.Load())
+			}
+		})
+	}
+
+	t.Run("ServeTLS uses the per-Server factory", func(t *testing.T) {
+		cert, err := tls.X509KeyPair(testcert.LocalhostCert, testcert.LocalhostKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var count atomic.Int64
+		srv := &oohttp.Server{
+			Handler:          oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {}),
+			TLSConfig:        &tls.Config{Certificates: []tls.Certificate{cert}},
+			TLSServerFactory: countingTLSServerFactory(&count),
+		}
+		go srv.ServeTLS(ln, "", "")
+		defer srv.Close()
+
+		txp := &oohttp.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+		defer txp.CloseIdleConnections()
+		clnt := &oohttp.Client{Transport: txp}
+		resp, err := clnt.Get("https://" + ln.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if count.Load() != 1 {
+			t.Fatal("expected one wrapped conn, got", count.Load())
+		}
+	})
+
+	t.Run("NewTLSListener defaults to the global factory", func(t *testing.T) {
+		cert, err := tls.X509KeyPair(testcert.LocalhostCert, testcert.LocalhostKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tlsLn := oohttp.NewTLSListener(ln, &tls.Config{Certificates: []tls.Certificate{cert}}, nil)
+		defer tlsLn.Close()
+		go func() {
+			conn, err := tls.Dial("tcp", ln.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+			if err == nil {
+				conn.Close()
+			}
+		}()
+		conn, err := tlsLn.Accept()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		if _, ok := conn.(*tls.Conn); !ok {
+			t.Fatalf("expected *tls.Conn, got %T", conn)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package tlsfactory
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"reflect"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// Conn is the interface implemented by the connections of TLS
+// libraries that mimic crypto/tls, e.g., refraction-networking/utls,
+// where S is the library's definition of the connection state.
+type Conn[S any] interface {
+	net.Conn
+
+	// ConnectionState returns the library's connection state.
+	ConnectionState() S
+
+	// Handshake performs the TLS handshake.
+	Handshake() error
+
+	// NetConn returns the underlying net.Conn.
+	NetConn() net.Conn
+}
+
+// NewConnAdapter adapts conn to the oohttp.TLSConn interface. The
+// ConnectionState method of the returned conn uses ConvertConnectionState.
+// If conn has a HandshakeContext method, the returned conn uses it,
+// otherwise it interrupts Handshake when the context is done by
+// setting an expired deadline on conn.
+func NewConnAdapter[S any](conn Conn[S]) oohttp.TLSConn {
+	return &connAdapter[S]{conn}
+}
+
+// connAdapter is the oohttp.TLSConn returned by NewConnAdapter.
+type connAdapter[S any] struct {
+	Conn[S]
+}
+
+// ConnectionState implements oohttp.TLSConn.
+func (c *connAdapter[S]) ConnectionState() tls.ConnectionState {
+	return ConvertConnectionState(c.Conn.ConnectionState())
+}
+
+// HandshakeContext implements oohttp.TLSConn.
+func (c *connAdapter[S]) HandshakeContext(ctx context.Context) error {
+	if hc, ok := c.Conn.(interface {
+		HandshakeContext(ctx context.Context) error
+	}); ok {
+		return hc.HandshakeContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	errch := make(chan error, 1)
+	go func() {
+		errch <- c.Conn.Handshake()
+	}()
+	select {
+	case err := <-errch:
+		return err
+	case <-ctx.Done():
+		// Unblock the handshake and wait for it to return, such that
+		// we do not leave a goroutine using the conn behind.
+		c.Conn.SetDeadline(time.Unix(1, 0))
+		<-errch
+		c.Conn.SetDeadline(time.Time{})
+		return ctx.Err()
+	}
+}
+
+var connectionStateType = reflect.TypeOf(tls.ConnectionState{})
+
+// ConvertConnectionState converts the connection state of a TLS library
+// mimicking crypto/tls into a tls.ConnectionState. The state argument
+// must be a struct or a pointer to a struct. We copy each field of state
+// having the same name of a field of tls.ConnectionState and a type that
+// is assignable or convertible to the type of such a field. We ignore
+// all the other fields. A nil pointer yields the zero value.
+func ConvertConnectionState(state any) tls.ConnectionState {
+	switch v := state.(type) {
+	case tls.ConnectionState:
+		return v
+	case *tls.ConnectionState:
+		if v == nil {
+			return tls.ConnectionState{}
+		}
+		return *v
+	}
+	var out tls.ConnectionState
+	src := reflect.ValueOf(state)
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return out
+		}
+		src = src.Elem()
+	}
+	if src.Kind() != reflect.Struct {
+		return out
+	}
+	dst := reflect.ValueOf(&out).Elem()
+	for i := 0; i < connectionStateType.NumField(); i++ {
+		df := connectionStateType.Field(i)
+		if !df.IsExported() {
+			continue
+		}
+		sf, found := src.Type().FieldByName(df.Name)
+		if !found || !sf.IsExported() || len(sf.Index) != 1 {
+			continue
+		}
+		sv := src.FieldByIndex(sf.Index)
+		switch {
+		case sf.Type.AssignableTo(df.Type):
+			dst.Field(i).Set(sv)
+		case sf.Type.ConvertibleTo(df.Type) && sf.Type.Kind() == df.Type.Kind():
+			dst.Field(i).Set(sv.Convert(df.Type))
+		}
+	}
+	return out
+}
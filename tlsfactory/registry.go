@@ -0,0 +1,116 @@
+package tlsfactory
+
+import (
+	"crypto/tls"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// registry contains the registered factories.
+var registry = struct {
+	mu        sync.Mutex
+	factories map[string]Factory
+}{
+	factories: map[string]Factory{
+		"go":                 StdlibClient,
+		"go-chrome-ciphers":  NewClientFactory(ChromeCiphersSpec),
+		"go-firefox-ciphers": NewClientFactory(FirefoxCiphersSpec),
+	},
+}
+
+// Register registers the factory with the given name, replacing any
+// factory previously registered with the same name. Names are case
+// insensitive. Register panics if factory is nil.
+func Register(name string, factory Factory) {
+	if factory == nil {
+		panic("tlsfactory: Register factory is nil")
+	}
+	registry.mu.Lock()
+	registry.factories[strings.ToLower(name)] = factory
+	registry.mu.Unlock()
+}
+
+// Lookup returns the factory registered with the given name. The
+// built-in factories are "go", which uses StdlibClient, along with
+// "go-chrome-ciphers" and "go-firefox-ciphers", which use crypto/tls
+// with ChromeCiphersSpec and FirefoxCiphersSpec. We do not register any
+// factory named after a browser, because crypto/tls cannot produce the
+// ClientHello of a browser. Register one based on a TLS library that
+// can, e.g., refraction-networking/utls, to use such names.
+func Lookup(name string) (Factory, bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	factory, found := registry.factories[strings.ToLower(name)]
+	return factory, found
+}
+
+// Names returns the sorted names of the registered factories.
+func Names() []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ChromeCiphersSpec configures crypto/tls to offer the cipher suites
+// and the curves that Chrome offers. The ClientHello is otherwise the
+// one of crypto/tls, which uses its own order of the extensions and of
+// the cipher suites and does not send GREASE values. Therefore, only
+// the JA4 cipher hash matches the one of Chrome.
+var ChromeCiphersSpec = &ClientHelloSpec{
+	MinVersion: tls.VersionTLS12,
+	MaxVersion: tls.VersionTLS13,
+	CipherSuites: []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+		tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+	},
+	CurvePreferences: []tls.CurveID{
+		tls.X25519,
+		tls.CurveP256,
+		tls.CurveP384,
+	},
+}
+
+// FirefoxCiphersSpec is like ChromeCiphersSpec but configures crypto/tls
+// to offer the cipher suites and the curves that Firefox offers.
+var FirefoxCiphersSpec = &ClientHelloSpec{
+	MinVersion: tls.VersionTLS12,
+	MaxVersion: tls.VersionTLS13,
+	CipherSuites: []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+		tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+	},
+	CurvePreferences: []tls.CurveID{
+		tls.X25519,
+		tls.CurveP256,
+		tls.CurveP384,
+		tls.CurveP521,
+	},
+}
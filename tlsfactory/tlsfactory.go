@@ -0,0 +1,93 @@
+// Package tlsfactory provides ready-made factories for the
+// TLSClientFactory field of oohttp.Transport, a registry of named
+// factories, and a generic adapter allowing to use the connections
+// of other TLS libraries as an oohttp.TLSConn.
+//
+// This package only depends on the standard library. Therefore, the
+// built-in factories only control the knobs exposed by crypto/tls,
+// that is, the versions, the cipher suites, and the curves. Notably,
+// crypto/tls chooses the order of the cipher suites and the order of
+// the extensions, and does not send GREASE values, so the built-in
+// factories do not produce the JA3 and JA4 fingerprints of any browser.
+// If you need to parrot a ClientHello byte by byte, wrap a library such
+// as refraction-networking/utls using NewConnAdapter and Register the
+// resulting factory.
+package tlsfactory
+
+import (
+	"crypto/tls"
+	"net"
+
+	oohttp "github.com/ooni/oohttp"
+)
+
+// Factory creates a client or server oohttp.TLSConn wrapping conn and
+// using the given config. A Factory is a valid value for the
+// TLSClientFactory field of oohttp.Transport.
+type Factory func(conn net.Conn, config *tls.Config) oohttp.TLSConn
+
+// StdlibClient is the Factory using tls.Client.
+func StdlibClient(conn net.Conn, config *tls.Config) oohttp.TLSConn {
+	return tls.Client(conn, config)
+}
+
+// ClientHelloSpec describes the ClientHello we send in terms of the
+// knobs exposed by crypto/tls. The zero value describes the ClientHello
+// sent by crypto/tls with the default configuration.
+type ClientHelloSpec struct {
+	// MinVersion is the minimum TLS version. If zero, we use
+	// the default of crypto/tls.
+	MinVersion uint16
+
+	// MaxVersion is the maximum TLS version. If zero, we use
+	// the default of crypto/tls.
+	MaxVersion uint16
+
+	// CipherSuites contains the TLS 1.0-1.2 cipher suites. The TLS 1.3
+	// cipher suites are not configurable. If empty, we use the default
+	// of crypto/tls. Note that crypto/tls ignores the order.
+	CipherSuites []uint16
+
+	// CurvePreferences contains the supported key exchange mechanisms.
+	// If empty, we use the default of crypto/tls.
+	CurvePreferences []tls.CurveID
+
+	// SessionTicketsDisabled disables the session ticket extension.
+	SessionTicketsDisabled bool
+}
+
+// Apply returns a clone of config where the fields controlling
+// the ClientHello have been configured according to spec. Fields
+// that are zero in spec are left untouched.
+func (spec *ClientHelloSpec) Apply(config *tls.Config) *tls.Config {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	config = config.Clone()
+	if spec.MinVersion != 0 {
+		config.MinVersion = spec.MinVersion
+	}
+	if spec.MaxVersion != 0 {
+		config.MaxVersion = spec.MaxVersion
+	}
+	if len(spec.CipherSuites) > 0 {
+		config.CipherSuites = append([]uint16{}, spec.CipherSuites...)
+	}
+	if len(spec.CurvePreferences) > 0 {
+		config.CurvePreferences = append([]tls.CurveID{}, spec.CurvePreferences...)
+	}
+	if spec.SessionTicketsDisabled {
+		config.SessionTicketsDisabled = true
+	}
+	return config
+}
+
+// NewClientFactory returns a client Factory using tls.Client with
+// a config configured according to spec using ClientHelloSpec.Apply.
+// We do not modify the NextProtos, because the oohttp.Transport uses
+// them to negotiate the HTTP version, and the ServerName.
+func NewClientFactory(spec *ClientHelloSpec) Factory {
+	return func(conn net.Conn, config *tls.Config) oohttp.TLSConn {
+		return tls.Client(conn, spec.Apply(config))
+	}
+}
@@ -0,0 +1,305 @@
+package tlsfactory_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	oohttp "github.com/ooni/oohttp"
+	"github.com/ooni/oohttp/httptest"
+	"github.com/ooni/oohttp/tlsfactory"
+)
+
+// captureClientHello returns a server whose TLS config saves
+// the received ClientHelloInfo into the returned channel.
+func captureClientHello(t *testing.T) (*httptest.Server, <-chan *tls.ClientHelloInfo) {
+	t.Helper()
+	hellos := make(chan *tls.ClientHelloInfo, 1)
+	srv := httptest.NewUnstartedServer(oohttp.HandlerFunc(func(w oohttp.ResponseWriter, r *oohttp.Request) {}))
+	srv.TLS = &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			select {
+			case hellos <- hello:
+			default:
+			}
+			return nil, nil
+		},
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv, hellos
+}
+
+func TestClientHelloSpec(t *testing.T) {
+	t.Run("Apply does not modify the original config", func(t *testing.T) {
+		config := &tls.Config{ServerName: "example.com", NextProtos: []string{"h2"}}
+		out := tlsfactory.ChromeCiphersSpec.Apply(config)
+		if config.CipherSuites != nil || config.CurvePreferences != nil {
+			t.Fatal("modified the original config")
+		}
+		if out.ServerName != "example.com" || !slices.Equal(out.NextProtos, []string{"h2"}) {
+			t.Fatal("did not preserve the other fields")
+		}
+		if !slices.Equal(out.CurvePreferences, tlsfactory.ChromeCiphersSpec.CurvePreferences) {
+			t.Fatal("did not apply the curves")
+		}
+	})
+
+	t.Run("the factory sends the configured ClientHello", func(t *testing.T) {
+		srv, hellos := captureClientHello(t)
+		spec := &tlsfactory.ClientHelloSpec{
+			MaxVersion:       tls.VersionTLS12,
+			CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			CurvePreferences: []tls.CurveID{tls.CurveP256},
+		}
+		txp := srv.Client().Transport.(*oohttp.Transport)
+		txp.TLSClientFactory = tlsfactory.NewClientFactory(spec)
+		resp, err := srv.Client().Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		hello := <-hellos
+		if !slices.Equal(hello.CipherSuites, spec.CipherSuites) {
+			t.Fatalf("unexpected cipher suites: %v", hello.CipherSuites)
+		}
+		if !slices.Equal(hello.SupportedCurves, spec.CurvePreferences) {
+			t.Fatalf("unexpected curves: %v", hello.SupportedCurves)
+		}
+		if resp.TLS.Version != tls.VersionTLS12 {
+			t.Fatalf("unexpected version: %x", resp.TLS.Version)
+		}
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("we have the built-in factories", func(t *testing.T) {
+		for _, name := range []string{"go", "go-chrome-ciphers", "go-firefox-ciphers"} {
+			if !slices.Contains(tlsfactory.Names(), name) {
+				t.Fatalf("missing %q", name)
+			}
+			if _, found := tlsfactory.Lookup(name); !found {
+				t.Fatalf("cannot lookup %q", name)
+			}
+		}
+	})
+
+	t.Run("we can register factories", func(t *testing.T) {
+		var called bool
+		tlsfactory.Register("Custom", func(conn net.Conn, config *tls.Config) oohttp.TLSConn {
+			called = true
+			return tls.Client(conn, config)
+		})
+		factory, found := tlsfactory.Lookup("CUSTOM")
+		if !found {
+			t.Fatal("cannot lookup the factory")
+		}
+		factory(nil, &tls.Config{})
+		if !called {
+			t.Fatal("did not return the registered factory")
+		}
+	})
+}
+
+// chromeJA3 and chromeJA4 are the fingerprints of a ClientHello sent by
+// Chrome, as published by the JA3 and JA4 projects. The digest of chromeJA3
+// is cd08e31494f9531f560d64c695473da9.
+const (
+	chromeJA3 = "771,4865-4866-4867-49195-49199-49196-49200-52393-52392-49171-49172-156-157-47-53," +
+		"0-23-65281-10-11-35-16-5-13-18-51-45-43-27-17513-21,29-23-24,0"
+	chromeJA4 = "t13d1516h2_8daaf6152771_e5627efa2ab1"
+)
+
+// firefoxJA4Ciphers is the JA4 cipher hash of the ClientHello of Firefox.
+const firefoxJA4Ciphers = "5b57614c22b0"
+
+func TestBuiltinFingerprints(t *testing.T) {
+	fs := httptest.NewFingerprintServer(nil)
+	defer fs.Close()
+
+	// fingerprint returns the fingerprint of a request using factory.
+	fingerprint := func(t *testing.T, factory tlsfactory.Factory) *httptest.Fingerprint {
+		t.Helper()
+		fs.Reset()
+		txp := fs.Client().Transport.(*oohttp.Transport).Clone()
+		defer txp.CloseIdleConnections()
+		txp.TLSClientFactory = factory
+		req, _ := oohttp.NewRequest("GET", fs.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		fps := fs.Fingerprints()
+		if len(fps) != 1 {
+			t.Fatalf("unexpected fingerprints: %v", fps)
+		}
+		return fps[0]
+	}
+	lookup := func(t *testing.T, name string) tlsfactory.Factory {
+		t.Helper()
+		factory, found := tlsfactory.Lookup(name)
+		if !found {
+			t.Fatalf("cannot lookup %q", name)
+		}
+		return factory
+	}
+	stdlib := fingerprint(t, tlsfactory.StdlibClient)
+
+	for _, tc := range []struct {
+		name       string
+		ja4Ciphers string
+	}{
+		{"go-chrome-ciphers", "8daaf6152771"},
+		{"go-firefox-ciphers", firefoxJA4Ciphers},
+	} {
+		t.Run(tc.name+" only changes the ciphers and curves of crypto/tls", func(t *testing.T) {
+			fp := fingerprint(t, lookup(t, tc.name))
+			// JA3 is "version,ciphers,extensions,curves,point formats"
+			// and JA4 is "a_ciphers_extensions".
+			ja3, stdJA3 := strings.Split(fp.JA3, ","), strings.Split(stdlib.JA3, ",")
+			ja4, stdJA4 := strings.Split(fp.JA4, "_"), strings.Split(stdlib.JA4, "_")
+			if len(ja3) != 5 || len(ja4) != 3 {
+				t.Fatalf("unexpected fingerprint: %s %s", fp.JA3, fp.JA4)
+			}
+			if ja3[2] != stdJA3[2] || ja4[2] != stdJA4[2] {
+				t.Fatalf("unexpected extensions: %s %s", fp.JA3, fp.JA4)
+			}
+			if ja4[1] != tc.ja4Ciphers {
+				t.Fatalf("unexpected JA4 cipher hash: %s", fp.JA4)
+			}
+		})
+	}
+
+	t.Run("go-chrome-ciphers does not have the fingerprint of Chrome", func(t *testing.T) {
+		fp := fingerprint(t, lookup(t, "go-chrome-ciphers"))
+		if fp.JA3 == chromeJA3 || fp.JA4 == chromeJA4 {
+			t.Fatalf("unexpected fingerprint: %s %s", fp.JA3, fp.JA4)
+		}
+		if !strings.HasPrefix(chromeJA4, "t13d1516h2_"+strings.Split(fp.JA4, "_")[1]) {
+			t.Fatalf("expected the cipher hash of Chrome: %s", fp.JA4)
+		}
+	})
+
+	t.Run("we do not register factories named after browsers", func(t *testing.T) {
+		for _, name := range []string{"chrome", "firefox"} {
+			if _, found := tlsfactory.Lookup(name); found {
+				t.Fatalf("unexpected factory %q", name)
+			}
+		}
+	})
+}
+
+// libraryState is the connection state of a fake TLS library.
+type libraryState struct {
+	Version            uint16
+	HandshakeComplete  bool
+	CipherSuite        uint16
+	NegotiatedProtocol string
+	ServerName         string
+	PeerCertificates   []any
+	Extra              string
+}
+
+// libraryConn is a conn of a fake TLS library, which
+// does not implement the HandshakeContext method.
+type libraryConn struct {
+	net.Conn
+	tc *tls.Conn
+}
+
+func (c *libraryConn) ConnectionState() *libraryState {
+	cs := c.tc.ConnectionState()
+	return &libraryState{
+		Version:            cs.Version,
+		HandshakeComplete:  cs.HandshakeComplete,
+		CipherSuite:        cs.CipherSuite,
+		NegotiatedProtocol: cs.NegotiatedProtocol,
+		ServerName:         cs.ServerName,
+		Extra:              "extra",
+	}
+}
+
+func (c *libraryConn) Handshake() error {
+	return c.tc.Handshake()
+}
+
+func (c *libraryConn) NetConn() net.Conn {
+	return c.tc.NetConn()
+}
+
+func newLibraryConn(conn net.Conn, config *tls.Config) *libraryConn {
+	tc := tls.Client(conn, config)
+	return &libraryConn{Conn: tc, tc: tc}
+}
+
+func TestConnAdapter(t *testing.T) {
+	t.Run("we can use the adapted conn with the Transport", func(t *testing.T) {
+		srv, _ := captureClientHello(t)
+		txp := srv.Client().Transport.(*oohttp.Transport)
+		txp.TLSClientFactory = func(conn net.Conn, config *tls.Config) oohttp.TLSConn {
+			return tlsfactory.NewConnAdapter(newLibraryConn(conn, config))
+		}
+		resp, err := srv.Client().Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.TLS == nil || resp.TLS.Version == 0 || resp.TLS.CipherSuite == 0 || !resp.TLS.HandshakeComplete {
+			t.Fatalf("unexpected state: %+v", resp.TLS)
+		}
+	})
+
+	t.Run("we interrupt the handshake when the context is done", func(t *testing.T) {
+		client, server := net.Pipe()
+		defer server.Close()
+		conn := tlsfactory.NewConnAdapter(newLibraryConn(client, &tls.Config{ServerName: "example.com"}))
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		go func() {
+			buf := make([]byte, 4096)
+			for {
+				if _, err := server.Read(buf); err != nil {
+					return
+				}
+			}
+		}()
+		if err := conn.HandshakeContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestConvertConnectionState(t *testing.T) {
+	t.Run("we copy the fields with the same name and type", func(t *testing.T) {
+		state := tlsfactory.ConvertConnectionState(libraryState{
+			Version:          tls.VersionTLS13,
+			ServerName:       "example.com",
+			PeerCertificates: []any{1},
+			Extra:            "extra",
+		})
+		if state.Version != tls.VersionTLS13 || state.ServerName != "example.com" {
+			t.Fatalf("unexpected state: %+v", state)
+		}
+		if state.PeerCertificates != nil {
+			t.Fatal("copied a field with incompatible type")
+		}
+	})
+
+	t.Run("we handle tls.ConnectionState and nil pointers", func(t *testing.T) {
+		in := tls.ConnectionState{Version: tls.VersionTLS12}
+		if out := tlsfactory.ConvertConnectionState(&in); out.Version != tls.VersionTLS12 {
+			t.Fatal("did not copy the state")
+		}
+		var nilState *libraryState
+		if out := tlsfactory.ConvertConnectionState(nilState); out.Version != 0 {
+			t.Fatal("expected the zero value")
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package http
+
+// This file is an ooni/oohttp extension. It allows choosing, on a per
+// request basis, the TLS client factory and the TLS configuration.
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync/atomic"
+)
+
+type tlsClientFactoryKey struct{}
+
+// tlsClientFactoryOverride is the value of tlsClientFactoryKey.
+type tlsClientFactoryOverride struct {
+	name    string
+	factory func(conn net.Conn, config *tls.Config) TLSConn
+}
+
+// WithTLSClientFactory returns a copy of ctx such that the Transport uses
+// factory, rather than its TLSClientFactory, when dialing a connection for
+// requests using the returned context. The name identifies the factory: the
+// Transport pools connections created using different names separately and
+// may reuse a connection created by any factory with the same name.
+//
+// The factory is not used for connecting to HTTPS proxies nor when using
+// DialTLS or DialTLSContext.
+func WithTLSClientFactory(ctx context.Context, name string,
+	factory func(conn net.Conn, config *tls.Config) TLSConn) context.Context {
+	return context.WithValue(ctx, tlsClientFactoryKey{}, &tlsClientFactoryOverride{name: name, factory: factory})
+}
+
+type tlsConfigKey struct{}
+
+// tlsConfigOverride is the value of tlsConfigKey.
+type tlsConfigOverride struct {
+	id     uint64 // unique for each call of WithTLSConfig
+	config *tls.Config
+}
+
+// tlsConfigOverrideCount counts the calls of WithTLSConfig.
+var tlsConfigOverrideCount atomic.Uint64
+
+// WithTLSConfig returns a copy of ctx such that the Transport uses config,
+// rather than its TLSClientConfig, when dialing a connection for requests
+// using the returned context. The Transport pools connections created for
+// each call of WithTLSConfig separately, so requests may only reuse them
+// when using the returned context or a context derived from it. Note that
+// config is used as is, so its NextProtos should contain "h2" only when the
+// Transport supports HTTP/2. The config must not be modified after this call.
+//
+// The config is not used for connecting to HTTPS proxies nor when using
+// DialTLS or DialTLSContext.
+func WithTLSConfig(ctx context.Context, config *tls.Config) context.Context {
+	return context.WithValue(ctx, tlsConfigKey{}, &tlsConfigOverride{
+		id:     tlsConfigOverrideCount.Add(1),
+		config: config,
+	})
+}
+
+// tlsClientFactoryFromContext returns the override set by
+// WithTLSClientFactory or nil.
+func tlsClientFactoryFromContext(ctx context.Context) *tlsClientFactoryOverride {
+	override, _ := ctx.Value(tlsClientFactoryKey{}).(*tlsClientFactoryOverride)
+	return override
+}
+
+// tlsConfigFromContext returns the config set by WithTLSConfig, or nil,
+// along with the ID identifying the call of WithTLSConfig.
+func tlsConfigFromContext(ctx context.Context) (*tls.Config, uint64) {
+	override, _ := ctx.Value(tlsConfigKey{}).(*tlsConfigOverride)
+	if override == nil {
+		return nil, 0
+	}
+	return override.config, override.id
+}
+
+// tlsClientConfig returns the config to clone when adding TLS to the
+// connection to the target of cm, or to the proxy when cm is nil.
+func (t *Transport) tlsClientConfig(cm *connectMethod) *tls.Config {
+	if cm != nil && cm.tlsConfig != nil {
+		return cm.tlsConfig
+	}
+	return t.TLSClientConfig
+}
+
+// tlsClientFactoryFor is like tlsClientFactory but honours the factory set
+// using WithTLSClientFactory for the target of cm, when cm is not nil.
+func (t *Transport) tlsClientFactoryFor(cm *connectMethod, conn net.Conn, config *tls.Config) TLSConn {
+	if cm != nil && cm.tlsFactory != nil && cm.tlsFactory.factory != nil {
+		return cm.tlsFactory.factory(conn, config)
+	}
+	return t.tlsClientFactory(conn, config)
+}
@@ -0,0 +1,94 @@
+package http_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+func TestTransportTLSOverrides(t *testing.T) {
+	srv := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	// get performs a GET request and returns the response
+	// and whether the request reused a connection.
+	get := func(t *testing.T, txp *Transport, ctx context.Context) (*Response, bool) {
+		t.Helper()
+		var reused bool
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+		})
+		req, _ := NewRequestWithContext(ctx, "GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp, reused
+	}
+
+	t.Run("we pool connections created by different factories separately", func(t *testing.T) {
+		CondSkipHTTP2(t)
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		calls := map[string]int{}
+		factory := func(name string) func(net.Conn, *tls.Config) TLSConn {
+			return func(conn net.Conn, config *tls.Config) TLSConn {
+				calls[name]++
+				return tls.Client(conn, config)
+			}
+		}
+		for idx, name := range []string{"a", "b", "a", "b"} {
+			ctx := WithTLSClientFactory(context.Background(), name, factory(name))
+			resp, reused := get(t, txp, ctx)
+			if reused != (idx >= 2) {
+				t.Fatalf("request %d: unexpected reused: %v", idx, reused)
+			}
+			if resp.ProtoMajor != 2 {
+				t.Fatalf("unexpected protocol: %s", resp.Proto)
+			}
+		}
+		if calls["a"] != 1 || calls["b"] != 1 {
+			t.Fatalf("unexpected calls: %v", calls)
+		}
+	})
+
+	t.Run("we use the TLS config of the request", func(t *testing.T) {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		config := txp.TLSClientConfig.Clone()
+		config.MaxVersion = tls.VersionTLS12
+		config.NextProtos = []string{"http/1.1"}
+		if resp, _ := get(t, txp, context.Background()); resp.TLS.Version != tls.VersionTLS13 {
+			t.Fatalf("unexpected version: %x", resp.TLS.Version)
+		}
+		resp, reused := get(t, txp, WithTLSConfig(context.Background(), config))
+		if reused {
+			t.Fatal("reused a connection created with another config")
+		}
+		if resp.TLS.Version != tls.VersionTLS12 || resp.ProtoMajor != 1 {
+			t.Fatalf("unexpected version or protocol: %x %s", resp.TLS.Version, resp.Proto)
+		}
+	})
+
+	t.Run("we pool connections created for each call of WithTLSConfig separately", func(t *testing.T) {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		defer txp.CloseIdleConnections()
+		config := txp.TLSClientConfig.Clone()
+		config.NextProtos = []string{"http/1.1"}
+		first := WithTLSConfig(context.Background(), config)
+		second := WithTLSConfig(context.Background(), config)
+		for idx, ctx := range []context.Context{first, second, first, second} {
+			if _, reused := get(t, txp, ctx); reused != (idx >= 2) {
+				t.Fatalf("request %d: unexpected reused: %v", idx, reused)
+			}
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+)
+
+// This file is an ooni/oohttp extension. It allows the Transport to
+// manage TLS session resumption for crypto/tls and for TLSConn adapters.
+
+// TLSSessionResumer is an optional interface that a TLSConn may implement
+// to support resuming TLS sessions using the Transport's TLSSessionCache.
+// A *tls.Conn does not need to implement this interface, since it uses the
+// ClientSessionCache of the tls.Config passed to the TLSClientFactory.
+//
+// The cache contains crypto/tls sessions. An adapter for a TLS library
+// that forks crypto/tls may convert sessions from and to its own types
+// using tls.ClientSessionState.ResumptionState, tls.NewResumptionState,
+// tls.SessionState.Bytes and tls.ParseSessionState.
+type TLSSessionResumer interface {
+	// SetClientSessionCache is called by the Transport before calling
+	// HandshakeContext. The conn should get the session to resume from
+	// cache and put new sessions into cache using the given key.
+	SetClientSessionCache(cache tls.ClientSessionCache, key string)
+}
+
+type withoutTLSSessionResumptionKey struct{}
+
+// WithoutTLSSessionResumption returns a copy of ctx such that the Transport
+// performs a full TLS handshake, rather than resuming a session stored in
+// its TLSSessionCache, when dialing a connection for a request using the
+// returned context. The Transport still stores the new session into the
+// cache. Note that the Transport may use an idle connection for the
+// request, thus performing no handshake at all. Set DisableKeepAlives or
+// call CloseIdleConnections to avoid this.
+func WithoutTLSSessionResumption(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutTLSSessionResumptionKey{}, true)
+}
+
+// tlsSessionCache returns the cache to use for a TLS handshake with the
+// given server name, or nil when the Transport has no TLSSessionCache.
+func (t *Transport) tlsSessionCache(ctx context.Context, serverName string) tls.ClientSessionCache {
+	if t.TLSSessionCache == nil {
+		return nil
+	}
+	noResume, _ := ctx.Value(withoutTLSSessionResumptionKey{}).(bool)
+	return &tlsSessionCache{cache: t.TLSSessionCache, key: serverName, noResume: noResume}
+}
+
+// tlsSessionCache wraps the Transport's TLSSessionCache such that we
+// always use the same key and optionally prevent resumption.
+type tlsSessionCache struct {
+	cache    tls.ClientSessionCache
+	key      string
+	noResume bool
+}
+
+// Get implements tls.ClientSessionCache.
+func (c *tlsSessionCache) Get(string) (*tls.ClientSessionState, bool) {
+	if c.noResume {
+		return nil, false
+	}
+	return c.cache.Get(c.key)
+}
+
+// Put implements tls.ClientSessionCache.
+func (c *tlsSessionCache) Put(_ string, cs *tls.ClientSessionState) {
+	c.cache.Put(c.key, cs)
+}
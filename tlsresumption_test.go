@@ -0,0 +1,93 @@
+package http_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+)
+
+// resumerConn is a TLSConn ignoring the ClientSessionCache of the
+// tls.Config and implementing TLSSessionResumer instead.
+type resumerConn struct {
+	*tls.Conn
+	config *tls.Config
+	key    string
+}
+
+func (c *resumerConn) SetClientSessionCache(cache tls.ClientSessionCache, key string) {
+	c.config.ClientSessionCache = cache
+	c.key = key
+}
+
+func TestTransportTLSSessionCache(t *testing.T) {
+	srv := httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	defer srv.Close()
+
+	// get performs a GET request and returns whether we resumed the session.
+	get := func(t *testing.T, txp *Transport, ctx context.Context) bool {
+		t.Helper()
+		req, _ := NewRequestWithContext(ctx, "GET", srv.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.TLS.DidResume
+	}
+
+	t.Run("with crypto/tls", func(t *testing.T) {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		txp.DisableKeepAlives = true
+		txp.TLSSessionCache = tls.NewLRUClientSessionCache(4)
+		ctx := context.Background()
+		if get(t, txp, ctx) {
+			t.Fatal("resumed the first session")
+		}
+		if !get(t, txp, ctx) {
+			t.Fatal("did not resume the session")
+		}
+		if get(t, txp, WithoutTLSSessionResumption(ctx)) {
+			t.Fatal("resumed the session without resumption")
+		}
+		if !get(t, txp, ctx) {
+			t.Fatal("did not resume the session")
+		}
+	})
+
+	t.Run("with a TLSSessionResumer", func(t *testing.T) {
+		var conns []*resumerConn
+		txp := srv.Client().Transport.(*Transport).Clone()
+		txp.DisableKeepAlives = true
+		txp.TLSSessionCache = tls.NewLRUClientSessionCache(4)
+		txp.TLSClientFactory = func(conn net.Conn, config *tls.Config) TLSConn {
+			config = config.Clone()
+			config.ClientSessionCache = nil
+			rc := &resumerConn{Conn: tls.Client(conn, config), config: config}
+			conns = append(conns, rc)
+			return rc
+		}
+		ctx := context.Background()
+		if get(t, txp, ctx) {
+			t.Fatal("resumed the first session")
+		}
+		if !get(t, txp, ctx) {
+			t.Fatal("did not resume the session")
+		}
+		if len(conns) != 2 || conns[0].key != "127.0.0.1" {
+			t.Fatalf("unexpected conns: %+v", conns)
+		}
+	})
+
+	t.Run("without a TLSSessionCache we do not resume", func(t *testing.T) {
+		txp := srv.Client().Transport.(*Transport).Clone()
+		txp.DisableKeepAlives = true
+		ctx := context.Background()
+		if get(t, txp, ctx) || get(t, txp, ctx) {
+			t.Fatal("resumed a session")
+		}
+	})
+}
@@ -0,0 +1,276 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"time"
+
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// This file is an ooni/oohttp extension. The stdlib invokes the network
+// related httptrace hooks from inside package net using internal/nettrace,
+// which we cannot use. Therefore, when using the default dialer, the
+// Transport resolves names and dials addresses itself, invoking the
+// DNSStart, DNSDone, ConnectStart and ConnectDone hooks directly. The
+// same happens when using a custom dialer along with a custom Resolver.
+
+// Resolver is the interface used by the Transport to resolve domain
+// names into IP addresses. A *net.Resolver is a valid Resolver. You
+// can implement this interface to use, e.g., DNS-over-HTTPS or a
+// static mapping from names to addresses.
+type Resolver interface {
+	// LookupIPAddr looks up host and returns its IPv4 and IPv6
+	// addresses. It must return an error or at least one address.
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// The ResolverFunc type is an adapter to allow the use of ordinary
+// functions as a Resolver. If f is a function with the appropriate
+// signature, ResolverFunc(f) is a Resolver that calls f.
+type ResolverFunc func(ctx context.Context, host string) ([]net.IPAddr, error)
+
+// LookupIPAddr calls f(ctx, host).
+func (f ResolverFunc) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return f(ctx, host)
+}
+
+var _ Resolver = net.DefaultResolver
+
+// resolver returns t.Resolver if set, otherwise net.DefaultResolver.
+func (t *Transport) resolver() Resolver {
+	if t.Resolver != nil {
+		return t.Resolver
+	}
+	return net.DefaultResolver
+}
+
+// dialFallbackDelay is how long to wait before spawning a fallback
+// connection attempt using the other address family, as specified
+// by RFC 6555. It is the same default delay used by net.Dialer.
+const dialFallbackDelay = 300 * time.Millisecond
+
+// dialTraced dials the given addr invoking the httptrace hooks. If addr
+// contains a domain name, we resolve it first, and then we try to
+// connect to the resolved addresses in "Happy Eyeballs" fashion.
+func (t *Transport) dialTraced(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+	}
+	if _, err := netip.ParseAddr(host); err == nil || host == "" {
+		return t.dialAddrTraced(ctx, network, addr)
+	}
+	addrs, err := t.lookupHostTraced(ctx, host)
+	if err != nil {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+	}
+	primaries, fallbacks := partitionDialAddrs(network, addrs, port)
+	if len(primaries) <= 0 {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: &net.AddrError{
+			Err: "no suitable address found", Addr: host}}
+	}
+	return t.dialParallelTraced(ctx, network, primaries, fallbacks)
+}
+
+// lookupHostTraced resolves host invoking the DNSStart and DNSDone hooks.
+func (t *Transport) lookupHostTraced(ctx context.Context, host string) ([]net.IPAddr, error) {
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.DNSStart != nil {
+		trace.DNSStart(httptrace.DNSStartInfo{Host: host})
+	}
+	addrs, err := t.resolver().LookupIPAddr(ctx, host)
+	if err == nil && len(addrs) <= 0 {
+		err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	if trace != nil && trace.DNSDone != nil {
+		trace.DNSDone(httptrace.DNSDoneInfo{Addrs: addrs, Err: err})
+	}
+	return addrs, err
+}
+
+// partitionDialAddrs returns the "host:port" endpoints to dial, suitable
+// for the given network, divided into primaries, which have the same
+// address family of the first address, and fallbacks.
+func partitionDialAddrs(network string, addrs []net.IPAddr, port string) (primaries, fallbacks []string) {
+	var primaryIs4 bool
+	for _, addr := range addrs {
+		is4 := addr.IP.To4() != nil
+		if (network == "tcp4" && !is4) || (network == "tcp6" && is4) {
+			continue
+		}
+		endpoint := net.JoinHostPort(addr.String(), port)
+		if len(primaries) <= 0 {
+			primaryIs4 = is4
+		}
+		if is4 == primaryIs4 {
+			primaries = append(primaries, endpoint)
+			continue
+		}
+		fallbacks = append(fallbacks, endpoint)
+	}
+	return
+}
+
+// dialParallelTraced races the dial of the primaries against the dial
+// of the fallbacks, which starts after dialFallbackDelay or as soon as
+// all the primaries fail. It returns the first established conn.
+func (t *Transport) dialParallelTraced(ctx context.Context, network string, primaries, fallbacks []string) (net.Conn, error) {
+	if len(fallbacks) <= 0 {
+		return t.dialSerialTraced(ctx, network, primaries)
+	}
+
+	type dialResult struct {
+		conn    net.Conn
+		err     error
+		primary bool
+	}
+	results := make(chan dialResult, 2)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	startRacer := func(primary bool) {
+		endpoints := primaries
+		if !primary {
+			endpoints = fallbacks
+		}
+		go func() {
+			conn, err := t.dialSerialTraced(ctx, network, endpoints)
+			results <- dialResult{conn: conn, err: err, primary: primary}
+		}()
+	}
+
+	startRacer(true)
+	timer := time.NewTimer(dialFallbackDelay)
+	defer timer.Stop()
+
+	var (
+		primaryErr      error
+		fallbackStarted bool
+		pending         = 1
+	)
+	for {
+		select {
+		case <-timer.C:
+			if !fallbackStarted {
+				fallbackStarted = true
+				pending++
+				startRacer(false)
+			}
+
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				// Cancel the other racer and close its conn, if any.
+				cancel()
+				go func() {
+					for ; pending > 0; pending-- {
+						if other := <-results; other.conn != nil {
+							other.conn.Close()
+						}
+					}
+				}()
+				return res.conn, nil
+			}
+			if res.primary {
+				primaryErr = res.err
+			}
+			if !fallbackStarted {
+				fallbackStarted = true
+				pending++
+				startRacer(false)
+				continue
+			}
+			if pending <= 0 {
+				if primaryErr != nil {
+					return nil, primaryErr
+				}
+				return nil, res.err
+			}
+		}
+	}
+}
+
+// dialSerialTraced tries to connect to each endpoint in sequence and
+// returns the first established conn or the first error.
+func (t *Transport) dialSerialTraced(ctx context.Context, network string, endpoints []string) (net.Conn, error) {
+	var firstErr error
+	for idx, endpoint := range endpoints {
+		select {
+		case <-ctx.Done():
+			return nil, &net.OpError{Op: "dial", Net: network, Err: ctx.Err()}
+		default:
+		}
+		// Like net.Dialer, we do not let a single attempt use all the
+		// remaining time. We release its context as soon as it ends.
+		dialCtx, cancel := ctx, context.CancelFunc(func() {})
+		if deadline, ok := ctx.Deadline(); ok {
+			partial, err := partialDialDeadline(time.Now(), deadline, len(endpoints)-idx)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = &net.OpError{Op: "dial", Net: network, Err: err}
+				}
+				break
+			}
+			if partial.Before(deadline) {
+				dialCtx, cancel = context.WithDeadline(ctx, partial)
+			}
+		}
+		conn, err := t.dialAddrTraced(dialCtx, network, endpoint)
+		cancel()
+		if err == nil {
+			return conn, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		firstErr = &net.OpError{Op: "dial", Net: network, Err: errors.New("no addresses to dial")}
+	}
+	return nil, firstErr
+}
+
+// partialDialDeadline returns the deadline to use for a single connection
+// attempt when multiple addresses are pending. This is what net.Dialer does.
+func partialDialDeadline(now, deadline time.Time, addrsRemaining int) (time.Time, error) {
+	const saneMinimum = 2 * time.Second
+	timeRemaining := deadline.Sub(now)
+	if timeRemaining <= 0 {
+		return time.Time{}, context.DeadlineExceeded
+	}
+	timeout := timeRemaining / time.Duration(addrsRemaining)
+	if timeout < saneMinimum {
+		if timeRemaining < saneMinimum {
+			timeout = timeRemaining
+		} else {
+			timeout = saneMinimum
+		}
+	}
+	return now.Add(timeout), nil
+}
+
+// dialAddrTraced dials the given addr invoking the ConnectStart
+// and ConnectDone hooks around the dial. It uses the custom dialer
+// if configured and the default dialer otherwise.
+func (t *Transport) dialAddrTraced(ctx context.Context, network, addr string) (net.Conn, error) {
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.ConnectStart != nil {
+		trace.ConnectStart(network, addr)
+	}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if t.DialContext != nil || t.Dial != nil {
+		conn, err = t.customDial(ctx, network, addr)
+	} else {
+		conn, err = zeroDialer.DialContext(ctx, network, addr)
+	}
+	if trace != nil && trace.ConnectDone != nil {
+		trace.ConnectDone(network, addr, err)
+	}
+	return conn, err
+}
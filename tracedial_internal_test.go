@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPartitionDialAddrs(t *testing.T) {
+	addrs := []net.IPAddr{
+		{IP: net.ParseIP("2001:db8::1")},
+		{IP: net.ParseIP("192.0.2.1")},
+		{IP: net.ParseIP("2001:db8::2")},
+		{IP: net.ParseIP("192.0.2.2")},
+	}
+	tests := []struct {
+		network   string
+		primaries []string
+		fallbacks []string
+	}{{
+		network:   "tcp",
+		primaries: []string{"[2001:db8::1]:443", "[2001:db8::2]:443"},
+		fallbacks: []string{"192.0.2.1:443", "192.0.2.2:443"},
+	}, {
+		network:   "tcp4",
+		primaries: []string{"192.0.2.1:443", "192.0.2.2:443"},
+	}, {
+		network:   "tcp6",
+		primaries: []string{"[2001:db8::1]:443", "[2001:db8::2]:443"},
+	}}
+	for _, tt := range tests {
+		primaries, fallbacks := partitionDialAddrs(tt.network, addrs, "443")
+		if !reflect.DeepEqual(primaries, tt.primaries) || !reflect.DeepEqual(fallbacks, tt.fallbacks) {
+			t.Errorf("%s: got %v, %v; want %v, %v", tt.network, primaries, fallbacks, tt.primaries, tt.fallbacks)
+		}
+	}
+}
+
+func TestPartialDialDeadline(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		deadline time.Time
+		addrs    int
+		want     time.Time
+		wantErr  bool
+	}{
+		{deadline: now.Add(-time.Second), addrs: 1, wantErr: true},
+		{deadline: now.Add(10 * time.Second), addrs: 1, want: now.Add(10 * time.Second)},
+		{deadline: now.Add(10 * time.Second), addrs: 2, want: now.Add(5 * time.Second)},
+		{deadline: now.Add(10 * time.Second), addrs: 10, want: now.Add(2 * time.Second)},
+		{deadline: now.Add(time.Second), addrs: 10, want: now.Add(time.Second)},
+	}
+	for _, tt := range tests {
+		got, err := partialDialDeadline(now, tt.deadline, tt.addrs)
+		if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+			t.Errorf("partialDialDeadline(%v, %d) = %v, %v; want %v", tt.deadline.Sub(now), tt.addrs, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/ooni/oohttp"
+	httptest "github.com/ooni/oohttp/httptest"
+	httptrace "github.com/ooni/oohttp/httptrace"
+)
+
+// netEventsRecorder records the network events emitted by httptrace.
+type netEventsRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *netEventsRecorder) add(event string) {
+	r.mu.Lock()
+	r.events = append(r.events, event)
+	r.mu.Unlock()
+}
+
+func (r *netEventsRecorder) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(info httptrace.DNSStartInfo) {
+			r.add("DNSStart " + info.Host)
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			if info.Err != nil {
+				r.add("DNSDone error")
+				return
+			}
+			var addrs []string
+			for _, addr := range info.Addrs {
+				addrs = append(addrs, addr.String())
+			}
+			r.add("DNSDone " + strings.Join(addrs, ","))
+		},
+		ConnectStart: func(network, addr string) {
+			r.add("ConnectStart " + network + " " + addr)
+		},
+		ConnectDone: func(network, addr string, err error) {
+			if err != nil {
+				r.add("ConnectDone " + network + " " + addr + " error")
+				return
+			}
+			r.add("ConnectDone " + network + " " + addr)
+		},
+	}
+}
+
+func (r *netEventsRecorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Join(r.events, "\n")
+}
+
+func TestTransportNetworkEventsTrace(t *testing.T) {
+	ts := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	defer ts.Close()
+	_, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("with an IP address we only trace the connect", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		txp := &Transport{}
+		defer txp.CloseIdleConnections()
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", ts.URL, nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		addr := ts.Listener.Addr().String()
+		want := "ConnectStart tcp " + addr + "\nConnectDone tcp " + addr
+		if got := rec.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("with a domain name we also trace the lookup", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		txp := &Transport{}
+		defer txp.CloseIdleConnections()
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", "http://localhost:"+port+"/", nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		got := rec.String()
+		if !strings.HasPrefix(got, "DNSStart localhost\nDNSDone ") {
+			t.Fatalf("unexpected events: %q", got)
+		}
+		wantDone := "ConnectDone tcp 127.0.0.1:" + port
+		if !strings.Contains(got, wantDone) {
+			t.Fatalf("unexpected events: %q", got)
+		}
+	})
+
+	t.Run("we trace lookup failures", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		txp := &Transport{}
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", "http://nonexistent.invalid/", nil)
+		if _, err := txp.RoundTrip(req); err == nil {
+			t.Fatal("expected an error")
+		}
+		want := "DNSStart nonexistent.invalid\nDNSDone error"
+		if got := rec.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("with a custom dialer we trace a single connect", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		txp := &Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return net.Dial(network, ts.Listener.Addr().String())
+			},
+		}
+		defer txp.CloseIdleConnections()
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", "http://example.com/", nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		want := "ConnectStart tcp example.com:80\nConnectDone tcp example.com:80"
+		if got := rec.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("we trace the dial to the proxy", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		proxyURL, _ := url.Parse("http://localhost:" + port)
+		txp := &Transport{Proxy: ProxyURL(proxyURL)}
+		defer txp.CloseIdleConnections()
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", "http://example.com/", nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if got := rec.String(); !strings.HasPrefix(got, "DNSStart localhost\n") {
+			t.Fatalf("unexpected events: %q", got)
+		}
+	})
+}
+
+func TestTransportResolver(t *testing.T) {
+	ts := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Write([]byte(r.Host))
+	}))
+	defer ts.Close()
+	_, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	staticResolver := ResolverFunc(func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		switch host {
+		case "www.example.com":
+			return []net.IPAddr{{IP: net.IPv4(127, 0, 0, 1)}}, nil
+		case "empty.example.com":
+			return nil, nil
+		default:
+			return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		}
+	})
+
+	t.Run("with the default dialer", func(t *testing.T) {
+		rec := &netEventsRecorder{}
+		txp := &Transport{Resolver: staticResolver}
+		defer txp.CloseIdleConnections()
+		ctx := httptrace.WithClientTrace(context.Background(), rec.trace())
+		req, _ := NewRequestWithContext(ctx, "GET", "http://www.example.com:"+port+"/", nil)
+		resp, err := txp.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		endpoint := "127.0.0.1:" + port
+		want := "DNSStart www.example.com\nDNSDone 127.0.0.1\n" +
+			"ConnectStart tcp " + endpoint + "\nConnectDone tcp " + endpoint
+		if got := rec.String(); got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("with a custom dialer", func(t *testing.T) {
+		var dialed []string
+		txp := &Transport{
+			Resolver: staticResolver,
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				dialed = append(dialed, addr)
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		}
+		defer txp.CloseIdleConnections()
+		resp, err := (&Client{Transport: txp}).Get("http://www.example.com:" + port + "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if len(dialed) != 1 || dialed[0] != "127.0.0.1:"+port {
+			t.Fatalf("unexpected dialed addresses: %v", dialed)
+		}
+	})
+
+	t.Run("with a proxy", func(t *testing.T) {
+		proxyURL, _ := url.Parse("http://www.example.com:" + port)
+		txp := &Transport{Resolver: staticResolver, Proxy: ProxyURL(proxyURL)}
+		defer txp.CloseIdleConnections()
+		resp, err := (&Client{Transport: txp}).Get("http://nonexistent.example.com/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	})
+
+	t.Run("with lookup errors", func(t *testing.T) {
+		txp := &Transport{Resolver: staticResolver}
+		for _, domain := range []string{"nonexistent.example.com", "empty.example.com"} {
+			_, err := (&Client{Transport: txp}).Get("http://" + domain + "/")
+			var dnsErr *net.DNSError
+			if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+				t.Fatalf("%s: unexpected error: %v", domain, err)
+			}
+		}
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"net/textproto"
 	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -114,7 +115,9 @@ type Transport struct {
 	//
 	// The proxy type is determined by the URL scheme. "http",
 	// "https", and "socks5" are supported. If the scheme is empty,
-	// "http" is assumed.
+	// "http" is assumed. As an ooni/oohttp extension, "socks5h",
+	// "socks4", and "socks4a" are also supported (see the socks
+	// package for more details).
 	//
 	// If the proxy URL contains a userinfo subcomponent,
 	// the proxy request will pass the username and password
@@ -197,6 +200,21 @@ type Transport struct {
 	// uncompressed.
 	DisableCompression bool
 
+	// ContentDecoders is an ooni/oohttp extension. If this field is not
+	// empty, when the Transport requests compression, it advertises the
+	// codings of these decoders rather than gzip only, and it uses them
+	// to transparently decode the responses, setting Uncompressed, as
+	// documented for DisableCompression. To mimic browsers, use, e.g.,
+	// GzipDecoder, DeflateDecoder, BrotliDecoder and ZstdDecoder.
+	ContentDecoders ContentDecoders
+
+	// AcceptEncoding is an ooni/oohttp extension. If this field is not
+	// empty, the Transport sends it as the Accept-Encoding header when it
+	// requests compression, e.g., "gzip, deflate, br, zstd", instead of
+	// the codings of the ContentDecoders. We only decode the responses
+	// using the codings of the ContentDecoders, or gzip if there are none.
+	AcceptEncoding string
+
 	// MaxIdleConns controls the maximum number of idle (keep-alive)
 	// connections across all hosts. Zero means no limit.
 	MaxIdleConns int
@@ -296,6 +314,101 @@ type Transport struct {
 	// DialTLSContext function, you'll completely bypass this
 	// per-Transport-or-global TLSClientFactory mechanism.)
 	TLSClientFactory func(conn net.Conn, config *tls.Config) TLSConn
+
+	// HTTP2Fingerprint is an ooni/oohttp extension. If this field is not
+	// nil, HTTP/2 client connections use it to choose the initial SETTINGS,
+	// WINDOW_UPDATE and PRIORITY frames and the order of the request
+	// pseudo-header fields. See HTTP2Fingerprint for more details.
+	HTTP2Fingerprint *HTTP2Fingerprint
+
+	// Resolver is an ooni/oohttp extension. If this field is not nil, the
+	// Transport uses it to resolve the domain names of the servers and of
+	// the proxies it connects to, and then dials the resolved addresses
+	// using Happy Eyeballs (RFC 6555). If DialContext or Dial are also
+	// set, the Transport calls them with each "ip:port" to dial. If
+	// Resolver is nil and there is no custom dialer, the Transport uses
+	// net.DefaultResolver. If Resolver is nil and there is a custom
+	// dialer, the Transport passes "host:port" to the custom dialer.
+	//
+	// When using a SOCKS5 or SOCKS4a proxy, the domain name of the target
+	// host is resolved by the proxy, hence Resolver is not used for it.
+	// When using a SOCKS4 proxy, Resolver resolves it, and the Transport
+	// invokes the DNSStart and DNSDone httptrace hooks around the lookup.
+	Resolver Resolver
+
+	// TLSSessionCache is an ooni/oohttp extension. If this field is not
+	// nil, the Transport uses it to store the TLS sessions it establishes
+	// and to resume them when connecting again to the same host, using the
+	// TLS server name as the key. This field takes precedence over the
+	// ClientSessionCache of TLSClientConfig. The Transport sets the cache
+	// into the tls.Config passed to the TLSClientFactory and, when the
+	// TLSConn implements TLSSessionResumer, it also passes the cache to
+	// its SetClientSessionCache method. Use WithoutTLSSessionResumption
+	// to force a full handshake when dialing for a given request.
+	TLSSessionCache tls.ClientSessionCache
+
+	// ConnObserver is an ooni/oohttp extension. If this field is not nil,
+	// the Transport wraps each connection it dials such that ConnObserver
+	// receives the bytes read and written at each layer: the dialed conn,
+	// the plaintext of the TLS connection to an HTTPS proxy, if any, and
+	// the plaintext of the TLS connection to the server. When using
+	// DialTLS or DialTLSContext, ConnObserver only sees the plaintext of
+	// the connections they return, using ConnLayerTLS.
+	ConnObserver ConnObserver
+
+	// HTTP2Proxy is an ooni/oohttp extension. If this field is not nil,
+	// the Transport offers HTTP/2 to HTTPS proxies when fetching https
+	// URLs and, if the proxy selects HTTP/2, it reaches the server using
+	// a tunnel opened by an HTTP/2 CONNECT request, multiplexing all the
+	// tunnels through the same proxy over one connection. Otherwise, the
+	// Transport uses an HTTP/1.1 CONNECT request as usual.
+	HTTP2Proxy *HTTP2Proxy
+
+	// ProxyChain is an ooni/oohttp extension. If this field is not nil,
+	// the Transport uses it instead of Proxy to obtain the proxies to use
+	// for a request, in the order in which we connect to them. We dial the
+	// first proxy and we reach each of the next proxies, and eventually
+	// the server, through the previous one, using SOCKS or an HTTP
+	// CONNECT request, depending on the proxy URL scheme, which must be
+	// one of the schemes supported by Proxy. Each proxy uses the
+	// credentials in its URL, and GetProxyConnectHeader and
+	// OnProxyConnectResponse are called for each HTTP CONNECT request. If
+	// the returned list is empty, we do not use any proxy. Connections are
+	// pooled by the entire chain.
+	ProxyChain func(*Request) ([]*url.URL, error)
+
+	// H2C is an ooni/oohttp extension. If this field is not H2COff, the
+	// Transport uses HTTP/2 over cleartext TCP (h2c) for http URLs, either
+	// assuming that the servers speak HTTP/2 (H2CPriorKnowledge) or asking
+	// them to upgrade HTTP/1.1 connections (H2CUpgrade). We never use h2c
+	// with HTTP proxies, while we use it through SOCKS proxies and tunnels.
+	H2C H2CMode
+
+	// HTTP3 is an ooni/oohttp extension. If this field is not nil, the
+	// Transport sends requests to the "h3" alternative services that https
+	// servers advertise using the Alt-Svc header using HTTP3.RoundTripper.
+	// When AltSvcCache is nil, we record the alternatives in a private cache.
+	HTTP3 *HTTP3
+
+	// AltSvcCache is an ooni/oohttp extension. If this field is not nil,
+	// the Transport records in it the alternative services (RFC 7838) that
+	// https servers advertise using the Alt-Svc header, and it sends the
+	// subsequent requests for the same origin to the first alternative it
+	// supports: "h3", if HTTP3 is not nil, and "h2" or "http/1.1", which it
+	// reaches over TCP. We keep the TLS server name and the Host header of
+	// the origin. If the alternative fails, we forget it and, if possible,
+	// we retry using the origin. We never use alternatives with proxies.
+	AltSvcCache *AltSvcCache
+
+	altSvcMu sync.Mutex
+	altSvc   *AltSvcCache // used when AltSvcCache is nil
+
+	h2cMu sync.Mutex
+	h2cT2 *http2Transport // lazily created by h2cTransport
+
+	h2proxyMu        sync.Mutex
+	h2proxyConns     map[string][]*http2ClientConn // by proxy URL
+	h2proxyTransport *http2Transport
 }
 
 // A cancelKey is the key of the reqCanceler map.
@@ -332,6 +445,8 @@ func (t *Transport) Clone() *Transport {
 		TLSHandshakeTimeout:    t.TLSHandshakeTimeout,
 		DisableKeepAlives:      t.DisableKeepAlives,
 		DisableCompression:     t.DisableCompression,
+		ContentDecoders:        slices.Clone(t.ContentDecoders), // oohttp ext
+		AcceptEncoding:         t.AcceptEncoding,                // oohttp ext
 		MaxIdleConns:           t.MaxIdleConns,
 		MaxIdleConnsPerHost:    t.MaxIdleConnsPerHost,
 		MaxConnsPerHost:        t.MaxConnsPerHost,
@@ -345,6 +460,15 @@ func (t *Transport) Clone() *Transport {
 		WriteBufferSize:        t.WriteBufferSize,
 		ReadBufferSize:         t.ReadBufferSize,
 		TLSClientFactory:       t.TLSClientFactory,
+		HTTP2Fingerprint:       t.HTTP2Fingerprint,
+		Resolver:               t.Resolver,
+		TLSSessionCache:        t.TLSSessionCache,
+		ConnObserver:           t.ConnObserver,
+		HTTP2Proxy:             t.HTTP2Proxy,
+		ProxyChain:             t.ProxyChain,
+		H2C:                    t.H2C,
+		HTTP3:                  t.HTTP3,
+		AltSvcCache:            t.AltSvcCache,
 	}
 	if t.TLSClientConfig != nil {
 		t2.TLSClientConfig = t.TLSClientConfig.Clone()
@@ -532,6 +656,9 @@ func (t *Transport) roundTrip(req *Request) (*Response, error) {
 	isHTTP := scheme == "http" || scheme == "https"
 	if isHTTP {
 		for k, vv := range req.Header {
+			if k == HeaderOrderKey {
+				continue
+			}
 			if !httpguts.ValidHeaderFieldName(k) {
 				req.closeBody()
 				return nil, fmt.Errorf("net/http: invalid header field name %q", k)
@@ -573,6 +700,15 @@ func (t *Transport) roundTrip(req *Request) (*Response, error) {
 		return nil, errors.New("http: no Host in request URL")
 	}
 
+	// oohttp ext: use the alternative services the server advertised.
+	if route, found := t.altSvcRouteFor(req); found {
+		resp, err := t.roundTripAltSvc(req, route)
+		if err == nil {
+			resp.Request = origReq
+		}
+		return resp, err
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -589,6 +725,14 @@ func (t *Transport) roundTrip(req *Request) (*Response, error) {
 			return nil, err
 		}
 
+		// oohttp ext: use a cached h2c connection, if any.
+		if resp, err := t.roundTripCachedH2C(req, &cm); !http2isNoCachedConnError(err) {
+			if err == nil {
+				resp.Request = origReq
+			}
+			return resp, err
+		}
+
 		// Get the cached or newly-created connection to either the
 		// host (for http or https), the http proxy, or the http proxy
 		// pre-CONNECTed to https server. In any case, we'll be ready
@@ -605,11 +749,14 @@ func (t *Transport) roundTrip(req *Request) (*Response, error) {
 			// HTTP/2 path.
 			t.setReqCanceler(cancelKey, nil) // not cancelable with CancelRequest
 			resp, err = pconn.alt.RoundTrip(req)
+		} else if t.shouldUpgradeH2C(&cm, pconn, req) { // oohttp ext
+			resp, err = t.upgradeH2C(treq, &cm, pconn)
 		} else {
 			resp, err = pconn.roundTrip(treq)
 		}
 		if err == nil {
 			resp.Request = origReq
+			t.recordAltSvc(req, resp) // oohttp ext
 			return resp, nil
 		}
 
@@ -797,6 +944,8 @@ func (t *Transport) CloseIdleConnections() {
 	if t2 := t.h2transport; t2 != nil {
 		t2.CloseIdleConnections()
 	}
+	t.closeIdleHTTP2ProxyConns() // oohttp ext
+	t.closeIdleH2CConns()        // oohttp ext
 }
 
 // CancelRequest cancels an in-flight request by closing its connection.
@@ -852,10 +1001,16 @@ func resetProxyConfig() {
 func (t *Transport) connectMethodForRequest(treq *transportRequest) (cm connectMethod, err error) {
 	cm.targetScheme = treq.URL.Scheme
 	cm.targetAddr = canonicalAddr(treq.URL)
-	if t.Proxy != nil {
+	if t.ProxyChain != nil { // oohttp ext
+		cm.proxyChain, cm.proxyURL, err = t.proxyChainForRequest(treq.Request)
+	} else if t.Proxy != nil {
 		cm.proxyURL, err = t.Proxy(treq.Request)
 	}
 	cm.onlyH1 = treq.requiresHTTP1()
+	cm.overrides = ConnOverridesFromContext(treq.Context())     // oohttp ext
+	cm.tlsFactory = tlsClientFactoryFromContext(treq.Context()) // oohttp ext
+	cm.tlsConfig, _ = tlsConfigFromContext(treq.Context())      // oohttp ext
+	cm.partition = connPoolPartition(treq.Context())            // oohttp ext
 	return cm, err
 }
 
@@ -1184,6 +1339,23 @@ func (t *Transport) replaceReqCanceler(key cancelKey, fn func(error)) bool {
 var zeroDialer net.Dialer
 
 func (t *Transport) dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	if (t.DialContext != nil || t.Dial != nil) && t.Resolver == nil {
+		// oohttp ext: we cannot observe what happens inside custom
+		// dialers, so we trace the dial as a single connect.
+		trace := httptrace.ContextClientTrace(ctx)
+		if trace != nil && trace.ConnectStart != nil {
+			trace.ConnectStart(network, addr)
+		}
+		c, err := t.customDial(ctx, network, addr)
+		if trace != nil && trace.ConnectDone != nil {
+			trace.ConnectDone(network, addr, err)
+		}
+		return c, err
+	}
+	return t.dialTraced(ctx, network, addr) // oohttp ext to support httptrace and Resolver
+}
+
+func (t *Transport) customDial(ctx context.Context, network, addr string) (net.Conn, error) {
 	if t.DialContext != nil {
 		c, err := t.DialContext(ctx, network, addr)
 		if c == nil && err == nil {
@@ -1191,14 +1363,11 @@ func (t *Transport) dial(ctx context.Context, network, addr string) (net.Conn, e
 		}
 		return c, err
 	}
-	if t.Dial != nil {
-		c, err := t.Dial(network, addr)
-		if c == nil && err == nil {
-			err = errors.New("net/http: Transport.Dial hook returned (nil, nil)")
-		}
-		return c, err
+	c, err := t.Dial(network, addr)
+	if c == nil && err == nil {
+		err = errors.New("net/http: Transport.Dial hook returned (nil, nil)")
 	}
-	return zeroDialer.DialContext(ctx, network, addr)
+	return c, err
 }
 
 // A wantConn records state about a wanted connection
@@ -1549,17 +1718,44 @@ func (t *Transport) decConnsPerHost(key connectMethodKey) {
 // Add TLS to a persistent connection, i.e. negotiate a TLS session. If pconn is already a TLS
 // tunnel, this function establishes a nested TLS session inside the encrypted channel.
 // The remote endpoint's name may be overridden by TLSClientConfig.ServerName.
-func (pconn *persistConn) addTLS(ctx context.Context, name string, trace *httptrace.ClientTrace) error {
+//
+// The cm argument is an oohttp extension. When adding TLS to the connection
+// to the target, rather than to the proxy, cm is not nil and we honour its
+// per-request overrides (see WithConnOverrides and WithTLSClientFactory).
+func (pconn *persistConn) addTLS(ctx context.Context, name string, cm *connectMethod, trace *httptrace.ClientTrace) error {
 	// Initiate TLS and check remote host name against certificate.
-	cfg := cloneTLSConfig(pconn.t.TLSClientConfig)
+	cfg := cloneTLSConfig(pconn.t.tlsClientConfig(cm)) // oohttp ext
 	if cfg.ServerName == "" {
 		cfg.ServerName = name
 	}
+	if cm != nil {
+		applyConnOverrides(cfg, name, &cm.overrides) // oohttp ext
+	}
 	if pconn.cacheKey.onlyH1 {
 		cfg.NextProtos = nil
 	}
+	if cm == nil {
+		cfg.NextProtos = pconn.t.proxyNextProtos(pconn.cacheKey, cfg.NextProtos) // oohttp ext
+	}
 	plainConn := pconn.conn
-	tlsConn := pconn.t.tlsClientFactory(plainConn, cfg) // oohttp ext to allow utls
+	sessionKey := cfg.ServerName
+	if sessionKey == "" {
+		// Like crypto/tls, use the remote address without a server name.
+		sessionKey = plainConn.RemoteAddr().String()
+	}
+	sessionCache := pconn.t.tlsSessionCache(ctx, sessionKey) // oohttp ext
+	if sessionCache != nil {
+		cfg.ClientSessionCache = sessionCache
+	}
+	tlsConn := pconn.t.tlsClientFactoryFor(cm, plainConn, cfg) // oohttp ext to allow utls
+	if resumer, ok := tlsConn.(TLSSessionResumer); ok && sessionCache != nil {
+		resumer.SetClientSessionCache(sessionCache, sessionKey)
+	}
+	layer := ConnLayerTLS
+	if cm == nil {
+		layer = ConnLayerProxyTLS
+	}
+	tlsConn = pconn.observeConn(tlsConn, layer).(TLSConn) // oohttp ext
 	errc := make(chan error, 2)
 	var timer *time.Timer // for canceling TLS handshake
 	if d := pconn.t.TLSHandshakeTimeout; d != 0 {
@@ -1620,12 +1816,19 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 		}
 		return err
 	}
-	if cm.scheme() == "https" && t.hasCustomTLSDialer() {
+	h2Tunnel, err := t.http2ProxyTunnel(ctx, &cm) // oohttp ext
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+	if h2Tunnel != nil {
+		pconn.conn = h2Tunnel
+	} else if cm.scheme() == "https" && t.hasCustomTLSDialer() {
 		var err error
 		pconn.conn, err = t.customDialTLS(ctx, "tcp", cm.addr())
 		if err != nil {
 			return nil, wrapErr(err)
 		}
+		pconn.conn = pconn.observeConn(pconn.conn, ConnLayerTLS) // oohttp ext
 		if tc, ok := pconn.conn.(TLSConn); ok {
 			// Handshake here, in case DialTLS didn't. TLSNextProto below
 			// depends on it for knowing the connection state.
@@ -1650,15 +1853,34 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 		if err != nil {
 			return nil, wrapErr(err)
 		}
-		pconn.conn = conn
+		pconn.conn = pconn.observeConn(conn, ConnLayerNet) // oohttp ext
 		if cm.scheme() == "https" {
+			firstTLSAddr := cm.addr()
+			var targetCM *connectMethod
+			if cm.proxyURL == nil {
+				// oohttp ext: cm.addr() may be ConnOverrides.DialAddr
+				firstTLSAddr, targetCM = cm.targetAddr, &cm
+			}
 			var firstTLSHost string
-			if firstTLSHost, _, err = net.SplitHostPort(cm.addr()); err != nil {
+			if firstTLSHost, _, err = net.SplitHostPort(firstTLSAddr); err != nil {
+				return nil, wrapErr(err)
+			}
+			if err = pconn.addTLS(ctx, firstTLSHost, targetCM, trace); err != nil {
 				return nil, wrapErr(err)
 			}
-			if err = pconn.addTLS(ctx, firstTLSHost, trace); err != nil {
+			if h2Tunnel, err = t.upgradeHTTP2Proxy(ctx, &cm, pconn); err != nil { // oohttp ext
 				return nil, wrapErr(err)
 			}
+			if h2Tunnel != nil {
+				pconn.conn = h2Tunnel
+			}
+		}
+	}
+
+	if len(cm.proxyChain) > 0 && h2Tunnel == nil { // oohttp ext
+		if err := t.dialProxyChain(ctx, &cm, pconn, trace); err != nil {
+			pconn.conn.Close()
+			return nil, wrapErr(err)
 		}
 	}
 
@@ -1666,21 +1888,11 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 	switch {
 	case cm.proxyURL == nil:
 		// Do nothing. Not using a proxy.
-	case cm.proxyURL.Scheme == "socks5":
+	case h2Tunnel != nil:
+		// oohttp ext: we already have a tunnel through an HTTP/2 proxy.
+	case isSOCKSProxy(cm.proxyURL): // oohttp ext: use the socks package
 		conn := pconn.conn
-		d := socksNewDialer("tcp", conn.RemoteAddr().String())
-		if u := cm.proxyURL.User; u != nil {
-			auth := &socksUsernamePassword{
-				Username: u.Username(),
-			}
-			auth.Password, _ = u.Password()
-			d.AuthMethods = []socksAuthMethod{
-				socksAuthMethodNotRequired,
-				socksAuthMethodUsernamePassword,
-			}
-			d.Authenticate = auth.Authenticate
-		}
-		if _, err := d.DialWithConn(ctx, conn, "tcp", cm.targetAddr); err != nil {
+		if err := t.connectThroughProxy(ctx, conn, cm.proxyURL, cm.tunnelAddr(), trace); err != nil {
 			conn.Close()
 			return nil, err
 		}
@@ -1693,99 +1905,24 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 		}
 	case cm.targetScheme == "https":
 		conn := pconn.conn
-		var hdr Header
-		if t.GetProxyConnectHeader != nil {
-			var err error
-			hdr, err = t.GetProxyConnectHeader(ctx, cm.proxyURL, cm.targetAddr)
-			if err != nil {
-				conn.Close()
-				return nil, err
-			}
-		} else {
-			hdr = t.ProxyConnectHeader
-		}
-		if hdr == nil {
-			hdr = make(Header)
-		}
-		if pa := cm.proxyAuth(); pa != "" {
-			hdr = hdr.Clone()
-			hdr.Set("Proxy-Authorization", pa)
-		}
-		connectReq := &Request{
-			Method: "CONNECT",
-			URL:    &url.URL{Opaque: cm.targetAddr},
-			Host:   cm.targetAddr,
-			Header: hdr,
-		}
-
-		// If there's no done channel (no deadline or cancellation
-		// from the caller possible), at least set some (long)
-		// timeout here. This will make sure we don't block forever
-		// and leak a goroutine if the connection stops replying
-		// after the TCP connect.
-		connectCtx := ctx
-		if ctx.Done() == nil {
-			newCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
-			defer cancel()
-			connectCtx = newCtx
-		}
-
-		didReadResponse := make(chan struct{}) // closed after CONNECT write+read is done or fails
-		var (
-			resp *Response
-			err  error // write or read error
-		)
-		// Write the CONNECT request & read the response.
-		go func() {
-			defer close(didReadResponse)
-			err = connectReq.Write(conn)
-			if err != nil {
-				return
-			}
-			// Okay to use and discard buffered reader here, because
-			// TLS server will not speak until spoken to.
-			br := bufio.NewReader(conn)
-			resp, err = ReadResponse(br, connectReq)
-		}()
-		select {
-		case <-connectCtx.Done():
-			conn.Close()
-			<-didReadResponse
-			return nil, connectCtx.Err()
-		case <-didReadResponse:
-			// resp or err now set
-		}
-		if err != nil {
+		if err := t.connectThroughProxy(ctx, conn, cm.proxyURL, cm.tunnelAddr(), trace); err != nil { // oohttp ext
 			conn.Close()
 			return nil, err
 		}
-
-		if t.OnProxyConnectResponse != nil {
-			err = t.OnProxyConnectResponse(ctx, cm.proxyURL, connectReq, resp)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if resp.StatusCode != 200 {
-			_, text, ok := strings.Cut(resp.Status, " ")
-			conn.Close()
-			if !ok {
-				return nil, errors.New("unknown status code")
-			}
-			return nil, errors.New(text)
-		}
 	}
 
 	if cm.proxyURL != nil && cm.targetScheme == "https" {
-		if err := pconn.addTLS(ctx, cm.tlsHost(), trace); err != nil {
+		if err := pconn.addTLS(ctx, cm.tlsHost(), &cm, trace); err != nil {
 			return nil, err
 		}
 	}
 
 	if s := pconn.tlsState; s != nil && s.NegotiatedProtocolIsMutual && s.NegotiatedProtocol != "" {
 		if next, ok := t.TLSNextProto[s.NegotiatedProtocol]; ok {
-			alt := next(cm.targetAddr, pconn.conn.(TLSConn))
+			alt, ok := t.upgradeH2Partition(&cm, s.NegotiatedProtocol, pconn.conn.(TLSConn)) // oohttp ext
+			if !ok {
+				alt = next(cm.targetAddr, pconn.conn.(TLSConn))
+			}
 			if e, ok := alt.(erringRoundTripper); ok {
 				// pconn.conn was closed by next (http2configureTransports.upgradeFn).
 				return nil, e.RoundTripErr()
@@ -1794,6 +1931,13 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 		}
 	}
 
+	if alt, err := t.dialH2CPriorKnowledge(&cm, pconn); err != nil || alt != nil { // oohttp ext
+		if err != nil {
+			return nil, err
+		}
+		return &persistConn{t: t, cacheKey: pconn.cacheKey, alt: alt}, nil
+	}
+
 	pconn.br = bufio.NewReaderSize(pconn, t.readBufferSize())
 	pconn.bw = bufio.NewWriterSize(persistConnWriter{pconn}, t.writeBufferSize())
 
@@ -1802,6 +1946,95 @@ func (t *Transport) dialConn(ctx context.Context, cm connectMethod) (pconn *pers
 	return pconn, nil
 }
 
+// dialHTTPConnect asks the HTTP proxy at proxyURL, which conn is connected
+// to, to connect to target using a CONNECT request. On failure, the caller
+// must close conn.
+//
+// oohttp ext: we factored this code out of dialConn to also use it for
+// the hops of Transport.ProxyChain.
+func (t *Transport) dialHTTPConnect(ctx context.Context, conn net.Conn, proxyURL *url.URL, target string) error {
+	var hdr Header
+	if t.GetProxyConnectHeader != nil {
+		var err error
+		hdr, err = t.GetProxyConnectHeader(ctx, proxyURL, target)
+		if err != nil {
+			return err
+		}
+	} else {
+		hdr = t.ProxyConnectHeader
+	}
+	if hdr == nil {
+		hdr = make(Header)
+	}
+	if pa := (&connectMethod{proxyURL: proxyURL}).proxyAuth(); pa != "" {
+		hdr = hdr.Clone()
+		hdr.Set("Proxy-Authorization", pa)
+	}
+	connectReq := &Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: hdr,
+	}
+
+	// If there's no done channel (no deadline or cancellation
+	// from the caller possible), at least set some (long)
+	// timeout here. This will make sure we don't block forever
+	// and leak a goroutine if the connection stops replying
+	// after the TCP connect.
+	connectCtx := ctx
+	if ctx.Done() == nil {
+		newCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+		defer cancel()
+		connectCtx = newCtx
+	}
+
+	didReadResponse := make(chan struct{}) // closed after CONNECT write+read is done or fails
+	var (
+		resp *Response
+		err  error // write or read error
+	)
+	// Write the CONNECT request & read the response.
+	go func() {
+		defer close(didReadResponse)
+		err = connectReq.Write(conn)
+		if err != nil {
+			return
+		}
+		// Okay to use and discard buffered reader here, because
+		// TLS server will not speak until spoken to.
+		br := bufio.NewReader(conn)
+		resp, err = ReadResponse(br, connectReq)
+	}()
+	select {
+	case <-connectCtx.Done():
+		conn.Close()
+		<-didReadResponse
+		return connectCtx.Err()
+	case <-didReadResponse:
+		// resp or err now set
+	}
+	if err != nil {
+		return err
+	}
+
+	if t.OnProxyConnectResponse != nil {
+		err = t.OnProxyConnectResponse(ctx, proxyURL, connectReq, resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	if resp.StatusCode != 200 {
+		_, text, ok := strings.Cut(resp.Status, " ")
+		if !ok {
+			return errors.New("unknown status code")
+		}
+		return errors.New(text)
+	}
+	return nil
+}
+
 // persistConnWriter is the io.Writer written to by pc.bw.
 // It accumulates the number of bytes written to the underlying conn,
 // so the retry logic can determine whether any bytes made it across
@@ -1854,6 +2087,12 @@ type connectMethod struct {
 	// be reused for different targetAddr values.
 	targetAddr string
 	onlyH1     bool // whether to disable HTTP/2 and force HTTP/1
+
+	proxyChain []*url.URL                // oohttp ext: proxies preceding proxyURL
+	overrides  ConnOverrides             // oohttp ext: see WithConnOverrides
+	tlsFactory *tlsClientFactoryOverride // oohttp ext: see WithTLSClientFactory
+	tlsConfig  *tls.Config               // oohttp ext: see WithTLSConfig
+	partition  string                    // oohttp ext: see connPoolPartition
 }
 
 func (cm *connectMethod) key() connectMethodKey {
@@ -1866,15 +2105,20 @@ func (cm *connectMethod) key() connectMethodKey {
 		}
 	}
 	return connectMethodKey{
-		proxy:  proxyStr,
-		scheme: cm.targetScheme,
-		addr:   targetAddr,
-		onlyH1: cm.onlyH1,
+		chain:     proxyChainKey(cm.proxyChain),
+		proxy:     proxyStr,
+		scheme:    cm.targetScheme,
+		addr:      targetAddr,
+		onlyH1:    cm.onlyH1,
+		partition: cm.partition,
 	}
 }
 
 // scheme returns the first hop scheme: http, https, or socks5
 func (cm *connectMethod) scheme() string {
+	if len(cm.proxyChain) > 0 {
+		return cm.proxyChain[0].Scheme // oohttp ext
+	}
 	if cm.proxyURL != nil {
 		return cm.proxyURL.Scheme
 	}
@@ -1883,10 +2127,13 @@ func (cm *connectMethod) scheme() string {
 
 // addr returns the first hop "host:port" to which we need to TCP connect.
 func (cm *connectMethod) addr() string {
+	if len(cm.proxyChain) > 0 {
+		return canonicalAddr(cm.proxyChain[0]) // oohttp ext
+	}
 	if cm.proxyURL != nil {
 		return canonicalAddr(cm.proxyURL)
 	}
-	return cm.targetAddr
+	return cm.tunnelAddr() // oohttp ext: honour ConnOverrides.DialAddr
 }
 
 // tlsHost returns the host name to match against the peer's
@@ -1905,15 +2152,22 @@ func (cm *connectMethod) tlsHost() string {
 type connectMethodKey struct {
 	proxy, scheme, addr string
 	onlyH1              bool
+	partition           string // oohttp ext: see connPoolPartition
+	chain               string // oohttp ext: proxies preceding proxy
 }
 
 func (k connectMethodKey) String() string {
-	// Only used by tests.
+	// Only used by tests and, in this fork, as the key of the h2c
+	// connections.
 	var h1 string
 	if k.onlyH1 {
 		h1 = ",h1"
 	}
-	return fmt.Sprintf("%s|%s%s|%s", k.proxy, k.scheme, h1, k.addr)
+	var chain string
+	if k.chain != "" {
+		chain = k.chain + ","
+	}
+	return fmt.Sprintf("%s%s|%s%s|%s%s", chain, k.proxy, k.scheme, h1, k.addr, k.partition)
 }
 
 // persistConn wraps a connection, usually a persistent one
@@ -1928,6 +2182,7 @@ type persistConn struct {
 	cacheKey  connectMethodKey
 	conn      net.Conn
 	tlsState  *tls.ConnectionState
+	connID    int64               // oohttp ext: see ConnEvent.ConnID
 	br        *bufio.Reader       // from conn
 	bw        *bufio.Writer       // to conn
 	nwrite    int64               // bytes written
@@ -2242,7 +2497,9 @@ func (pc *persistConn) readLoop() {
 		}
 
 		resp.Body = body
-		if rc.addedGzip && ascii.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		if rc.addedGzip && len(pc.t.ContentDecoders) > 0 { // oohttp ext
+			pc.t.ContentDecoders.Decode(resp)
+		} else if rc.addedGzip && ascii.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
 			resp.Body = &gzipReader{body: body}
 			resp.Header.Del("Content-Encoding")
 			resp.Header.Del("Content-Length")
@@ -2624,7 +2881,7 @@ func (pc *persistConn) roundTrip(req *transportRequest) (resp *Response, err err
 		// auto-decoding a portion of a gzipped document will just fail
 		// anyway. See https://golang.org/issue/8923
 		requestedGzip = true
-		req.extraHeaders().Set("Accept-Encoding", "gzip")
+		req.extraHeaders().Set("Accept-Encoding", pc.t.acceptEncoding()) // oohttp ext
 	}
 
 	var continueCh chan struct{}
@@ -2779,6 +3036,10 @@ var portMap = map[string]string{
 	"http":   "80",
 	"https":  "443",
 	"socks5": "1080",
+	// oohttp ext: the other SOCKS proxies we support
+	"socks5h": "1080",
+	"socks4":  "1080",
+	"socks4a": "1080",
 }
 
 func idnaASCIIFromURL(url *url.URL) string {
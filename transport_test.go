@@ -5871,6 +5871,17 @@ func TestTransportClone(t *testing.T) {
 		ReadBufferSize:   1,
 		WriteBufferSize:  1,
 		TLSClientFactory: TLSClientFactory, // set to the global one
+		HTTP2Fingerprint: &HTTP2Fingerprint{},
+		Resolver:         net.DefaultResolver,
+		TLSSessionCache:  tls.NewLRUClientSessionCache(1),
+		ConnObserver:     ConnObserverFunc(func(*ConnEvent) {}),
+		HTTP2Proxy:       &HTTP2Proxy{},
+		ProxyChain:       func(*Request) ([]*url.URL, error) { return nil, nil },
+		H2C:              H2CUpgrade,
+		HTTP3:            &HTTP3{},
+		AltSvcCache:      &AltSvcCache{},
+		ContentDecoders:  ContentDecoders{GzipDecoder},
+		AcceptEncoding:   "gzip, br",
 	}
 	tr2 := tr.Clone()
 	rv := reflect.ValueOf(tr2).Elem()